@@ -22,17 +22,20 @@ package blockchain
 
 import (
 	"context"
-	"maps"
-	"slices"
-	"strconv"
+	"fmt"
 	"time"
 
 	"github.com/berachain/beacon-kit/primitives/math"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
 )
 
-// defaultRetryInterval processes a deposit event.
+// defaultRetryInterval is the interval at which gaps in fetched deposits are
+// filled.
 const defaultRetryInterval = 20 * time.Second
 
+// depositFetcher fetches the deposits of the EL blocks up to blockNum, minus
+// the follow distance. To avoid delaying finalization it fetches at most one
+// range of blocks, leaving larger gaps to the catchup fetcher.
 func (s *Service) depositFetcher(
 	ctx context.Context,
 	blockNum math.U64,
@@ -45,55 +48,99 @@ func (s *Service) depositFetcher(
 		)
 		return
 	}
+	s.depositStream.setHead(blockNum)
+	target := blockNum - s.eth1FollowDistance
+	s.depositTarget.Store(target.Unwrap())
 
-	s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+	// The catchup fetcher is already fetching, possibly past target.
+	if !s.depositFetchMu.TryLock() {
+		return
+	}
+	defer s.depositFetchMu.Unlock()
+
+	// Failures are logged here and retried by the catchup fetcher.
+	_, _ = s.fetchAndStoreDeposits(ctx, target)
 }
 
-// fetchAndStoreDeposits processes all deposits at a particular EL block height.
-// TODO: This could be optimized to process a contiguous range of blocks simultaneously to minimize EL RPC calls.
+// fetchAndStoreDeposits stores the deposits of the range of EL blocks after the
+// last indexed block, up to target and spanning at most depositFetchMaxSpan
+// blocks, then advances the last indexed block. If no block has been indexed
+// yet, only the deposits of target are fetched. Deposits are taken from the
+// deposit stream when it covers the range, and read from the EL otherwise. It
+// returns the last indexed block. The caller must hold depositFetchMu.
 func (s *Service) fetchAndStoreDeposits(
 	ctx context.Context,
-	blockNum math.U64,
-) {
-	blockNumStr := strconv.FormatUint(blockNum.Unwrap(), 10)
-	deposits, err := s.depositContract.ReadDeposits(ctx, blockNum, blockNum)
+	target math.U64,
+) (math.U64, error) {
+	depositStore := s.storageBackend.DepositStore()
+	last, found, err := depositStore.GetLastIndexedBlock(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get last indexed deposit block", "error", err)
+		return 0, err
+	}
+	lastIndexed := math.U64(last)
+	if !found {
+		lastIndexed = target - 1
+	}
+	if lastIndexed >= target {
+		return lastIndexed, nil
+	}
+
+	from := lastIndexed + 1
+	to := min(target, lastIndexed+s.depositFetchMaxSpan)
+	blockRange := fmt.Sprintf("%d-%d", from, to)
+
+	batches, streamed := s.depositStream.read(from, to)
+	if !streamed {
+		batches, err = s.depositContract.ReadDeposits(ctx, from, to)
+	}
 	if err != nil {
-		s.logger.Error("Failed to read deposits", "error", err)
+		s.logger.Error("Failed to read deposits", "from", from, "to", to, "error", err)
 		s.metrics.sink.IncrementCounter(
 			"beacon_kit.execution.deposit.failed_to_get_block_logs",
-			"block_num",
-			blockNumStr,
+			"block_range",
+			blockRange,
 		)
-		s.failedBlocksMu.Lock()
-		s.failedBlocks[blockNum] = struct{}{}
-		s.failedBlocksMu.Unlock()
-		return
+		return lastIndexed, err
 	}
 
-	if len(deposits) > 0 {
+	if len(batches) > 0 {
+		numDeposits := 0
+		for _, batch := range batches {
+			numDeposits += len(batch.Deposits)
+		}
 		s.logger.Info(
 			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
+			"from", from, "to", to, "deposits", numDeposits,
 		)
 	}
 
-	if err = s.storageBackend.DepositStore().EnqueueDeposits(ctx, deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
-		s.metrics.sink.IncrementCounter(
-			"beacon_kit.execution.deposit.failed_to_enqueue_deposits",
-			"block_num",
-			blockNumStr,
-		)
-		s.failedBlocksMu.Lock()
-		s.failedBlocks[blockNum] = struct{}{}
-		s.failedBlocksMu.Unlock()
-		return
+	// Each batch is stored with the EL block that emitted it, so that the
+	// deposits can be re-validated against the canonical chain before
+	// inclusion.
+	for _, batch := range batches {
+		block := depositstorecommon.Block{Number: batch.BlockNumber, Hash: batch.BlockHash}
+		if err = depositStore.EnqueueBlockDeposits(ctx, block, batch.Deposits); err != nil {
+			s.logger.Error("Failed to store deposits", "error", err)
+			s.metrics.sink.IncrementCounter(
+				"beacon_kit.execution.deposit.failed_to_enqueue_deposits",
+				"block_range",
+				blockRange,
+			)
+			return lastIndexed, err
+		}
+	}
+
+	if err = depositStore.SetLastIndexedBlock(ctx, to.Unwrap()); err != nil {
+		s.logger.Error("Failed to store last indexed deposit block", "error", err)
+		return lastIndexed, err
 	}
-	s.failedBlocksMu.Lock()
-	delete(s.failedBlocks, blockNum)
-	s.failedBlocksMu.Unlock()
+	return to, nil
 }
 
+// depositCatchupFetcher periodically fetches the deposits of all EL blocks
+// between the persisted checkpoint and the latest target. After a restart this
+// resumes from the checkpoint, so that no deposits are missed.
 func (s *Service) depositCatchupFetcher(ctx context.Context) {
 	ticker := time.NewTicker(defaultRetryInterval)
 	defer ticker.Stop()
@@ -102,24 +149,32 @@ func (s *Service) depositCatchupFetcher(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			s.failedBlocksMu.RLock()
-			failedBlks := slices.Collect(maps.Keys(s.failedBlocks))
-			s.failedBlocksMu.RUnlock()
-			if len(failedBlks) == 0 {
+			target := math.U64(s.depositTarget.Load())
+			if target == 0 {
 				continue
 			}
+			s.catchupDeposits(ctx, target)
+		}
+	}
+}
+
+// catchupDeposits fetches ranges of deposits until target is indexed, the
+// context is cancelled or a fetch fails.
+func (s *Service) catchupDeposits(ctx context.Context, target math.U64) {
+	s.depositFetchMu.Lock()
+	defer s.depositFetchMu.Unlock()
+
+	for ctx.Err() == nil {
+		lastIndexed, err := s.fetchAndStoreDeposits(ctx, target)
+		if err != nil {
 			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				failedBlks,
+				"Failed to catch up deposits, retrying...",
+				"last_indexed_block", lastIndexed, "target", target,
 			)
-
-			// Fetch deposits for blocks that failed to be processed.
-			// TODO: This can be optimized to process all the blocks queried at once by utilizing log query ranges
-			// for contiguous ranges of blocks
-			for _, blockNum := range failedBlks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
-			}
+			return
+		}
+		if lastIndexed >= target {
+			return
 		}
 	}
 }
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
+)
+
+// VerifyDeposits re-validates that the EL blocks which emitted the stored
+// deposits [startIndex, startIndex+count) are still canonical. The follow
+// distance should make this a formality; if the EL has nonetheless reorged
+// past it, the deposits from the first orphaned one onward are rolled back so
+// that they are fetched again from the canonical chain, and an error is
+// logged. Deposits with no recorded block, such as genesis deposits, are not
+// checked. If the EL cannot be queried, the check is skipped with a warning
+// rather than failing the proposal, and is retried after the next block.
+func (s *Service) VerifyDeposits(
+	ctx context.Context,
+	startIndex uint64,
+	count uint64,
+) error {
+	depositStore := s.storageBackend.DepositStore()
+	canonical := make(map[math.U64]bool)
+	for idx := startIndex; idx < startIndex+count; idx++ {
+		block, found, err := depositStore.GetDepositBlock(ctx, idx)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		ok, checked := canonical[block.Number]
+		if !checked {
+			hash, err := s.depositContract.CanonicalBlockHash(ctx, block.Number)
+			if err != nil {
+				s.logger.Warn(
+					"Failed to get canonical hash of deposit EL block, skipping deposit verification",
+					"block_number", block.Number.Base10(), "error", err,
+				)
+				return nil
+			}
+			ok = hash == block.Hash
+			canonical[block.Number] = ok
+		}
+		if !ok {
+			return s.rollbackDeposits(ctx, idx, block)
+		}
+	}
+	return nil
+}
+
+// rollbackDeposits removes the deposits from index onward, the first of which
+// was emitted by the orphaned EL block, and rewinds the last indexed block so
+// that they are fetched again. It runs on the proposal path, so rather than
+// waiting for an ongoing deposit fetch it skips the rollback, which is retried
+// after the next block.
+func (s *Service) rollbackDeposits(
+	ctx context.Context,
+	index uint64,
+	orphaned depositstorecommon.Block,
+) error {
+	if !s.depositFetchMu.TryLock() {
+		s.logger.Warn(
+			"Deposits are being fetched, deferring rollback of orphaned deposits",
+			"first_deposit_index", index,
+			"orphaned_block_number", orphaned.Number.Base10(),
+		)
+		return nil
+	}
+	defer s.depositFetchMu.Unlock()
+
+	// The deposits may have been rolled back and fetched again since they were
+	// checked.
+	depositStore := s.storageBackend.DepositStore()
+	block, found, err := depositStore.GetDepositBlock(ctx, index)
+	if err != nil {
+		return err
+	}
+	if !found || block != orphaned {
+		return nil
+	}
+
+	// Fetch again from the block after the one that emitted the previous
+	// deposit, which must precede the first orphaned deposit on any chain.
+	rewindTo := orphaned.Number - 1
+	if index > 0 {
+		var prev depositstorecommon.Block
+		prev, found, err = depositStore.GetDepositBlock(ctx, index-1)
+		if err != nil {
+			return err
+		}
+		if found && prev.Number < rewindTo {
+			rewindTo = prev.Number
+		}
+	}
+
+	last, found, err := depositStore.GetLastIndexedBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if found {
+		rewindTo = min(rewindTo, math.U64(last))
+	}
+
+	if err = depositStore.RollbackDeposits(ctx, index, rewindTo.Unwrap()); err != nil {
+		s.logger.Error("Failed to roll back orphaned deposits", "error", err)
+		return err
+	}
+
+	s.logger.Error(
+		"Execution layer reorged past the follow distance, rolled back orphaned deposits",
+		"first_deposit_index", index,
+		"orphaned_block_number", orphaned.Number.Base10(),
+		"orphaned_block_hash", orphaned.Hash,
+		"refetch_from_block", (rewindTo + 1).Base10(),
+	)
+	s.metrics.sink.IncrementCounter(
+		"beacon_kit.execution.deposit.reorged_deposits_rolled_back",
+		"block_number",
+		orphaned.Number.Base10(),
+	)
+	return nil
+}
+
+// verifyPendingDeposits re-validates the deposits that may be included in the
+// next block, so that every node drops orphaned deposits, not just proposers.
+// Failures are logged, since the proposer verifies the deposits again before
+// including them.
+func (s *Service) verifyPendingDeposits(ctx context.Context, st *statedb.StateDB) {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		s.logger.Warn("Failed to load eth1 deposit index", "error", err)
+		return
+	}
+	if err = s.VerifyDeposits(ctx, depositIndex, s.chainSpec.MaxDepositsPerBlock()); err != nil {
+		s.logger.Warn("Failed to verify pending deposits", "error", err)
+	}
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package blockchain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
+	"github.com/stretchr/testify/require"
+)
+
+// reorgDepositBlocks are the EL blocks emitting the stored deposits 0 to 5.
+//
+//nolint:gochecknoglobals // test fixture
+var reorgDepositBlocks = []math.U64{10, 10, 20, 30, 30, 40}
+
+// seedReorgDeposits stores the deposits of reorgDepositBlocks, as fetched from
+// contract, and indexes the EL chain up to block 50.
+func seedReorgDeposits(
+	t *testing.T,
+	depStore depositstore.StoreManager,
+	contract *fakeDepositContract,
+) {
+	t.Helper()
+	ctx := context.Background()
+	for idx, number := range reorgDepositBlocks {
+		block := depositstorecommon.Block{Number: number, Hash: contract.blockHash(number)}
+		require.NoError(t, depStore.EnqueueBlockDeposits(ctx, block, []*ctypes.Deposit{{
+			Pubkey: [48]byte{byte(idx)},
+			Amount: 32e9,
+			Index:  uint64(idx),
+		}}))
+	}
+	require.NoError(t, depStore.SetLastIndexedBlock(ctx, 50))
+}
+
+func storedDeposits(t *testing.T, depStore depositstore.StoreManager) int {
+	t.Helper()
+	deposits, _, err := depStore.GetDepositsByIndex(context.Background(), 0, 10)
+	require.NoError(t, err)
+	return len(deposits)
+}
+
+func TestVerifyDeposits_Reorg(t *testing.T) {
+	t.Parallel()
+
+	// Deposits 2 to 4 are verified, emitted by blocks 20 and 30.
+	const startIndex, count = 2, 3
+	tests := []struct {
+		name     string
+		orphaned math.U64
+		// wantDeposits is the number of deposits left in the store.
+		wantDeposits int
+		// wantLastIndexed is the last indexed block after verification.
+		wantLastIndexed math.U64
+	}{
+		{
+			name:            "at the first verified block",
+			orphaned:        20,
+			wantDeposits:    2,
+			wantLastIndexed: 10,
+		},
+		{
+			name:            "within the verified range",
+			orphaned:        30,
+			wantDeposits:    3,
+			wantLastIndexed: 20,
+		},
+		{
+			name:            "below the verified range",
+			orphaned:        10,
+			wantDeposits:    len(reorgDepositBlocks),
+			wantLastIndexed: 50,
+		},
+		{
+			name:            "above the verified range",
+			orphaned:        40,
+			wantDeposits:    len(reorgDepositBlocks),
+			wantLastIndexed: 50,
+		},
+		{
+			name:            "above the stored deposits",
+			orphaned:        45,
+			wantDeposits:    len(reorgDepositBlocks),
+			wantLastIndexed: 50,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			contract := newFakeDepositContract()
+			chain, depStore, _ := setupDepositTests(t, contract, 10)
+			seedReorgDeposits(t, depStore, contract)
+
+			contract.hashes[tt.orphaned] = common.ExecutionHash{0xff}
+			require.NoError(t, chain.VerifyDeposits(context.Background(), startIndex, count))
+
+			require.Equal(t, tt.wantDeposits, storedDeposits(t, depStore))
+			require.Equal(t, tt.wantLastIndexed, lastIndexedBlock(t, depStore))
+		})
+	}
+}
+
+func TestVerifyDeposits_CanonicalChain(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	chain, depStore, _ := setupDepositTests(t, contract, 10)
+	seedReorgDeposits(t, depStore, contract)
+
+	require.NoError(t, chain.VerifyDeposits(context.Background(), 0, uint64(len(reorgDepositBlocks))))
+
+	// Each block is queried once, however many deposits it emitted.
+	require.Equal(t, 4, contract.hashCalls)
+	require.Equal(t, len(reorgDepositBlocks), storedDeposits(t, depStore))
+}
+
+func TestVerifyDeposits_SkipsOnRPCError(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	chain, depStore, _ := setupDepositTests(t, contract, 10)
+	seedReorgDeposits(t, depStore, contract)
+
+	contract.err = errors.New("rpc unavailable")
+	require.NoError(t, chain.VerifyDeposits(context.Background(), 0, uint64(len(reorgDepositBlocks))))
+
+	require.Equal(t, 1, contract.hashCalls)
+	require.Equal(t, len(reorgDepositBlocks), storedDeposits(t, depStore))
+	require.Equal(t, math.U64(50), lastIndexedBlock(t, depStore))
+}
+
+func TestVerifyDeposits_DefersRollbackWhileFetching(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	chain, depStore, _ := setupDepositTests(t, contract, 10)
+	seedReorgDeposits(t, depStore, contract)
+	contract.hashes[20] = common.ExecutionHash{0xff}
+
+	unlock := chain.LockDepositFetch()
+	require.NoError(t, chain.VerifyDeposits(context.Background(), 0, uint64(len(reorgDepositBlocks))))
+	unlock()
+	require.Equal(t, len(reorgDepositBlocks), storedDeposits(t, depStore))
+
+	// The rollback happens on the next verification.
+	require.NoError(t, chain.VerifyDeposits(context.Background(), 0, uint64(len(reorgDepositBlocks))))
+	require.Equal(t, 2, storedDeposits(t, depStore))
+	require.Equal(t, math.U64(10), lastIndexedBlock(t, depStore))
+}
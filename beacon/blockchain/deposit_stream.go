@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/execution/deposit"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// depositStreamBufferSize is the number of streamed deposits buffered before
+// the subscription blocks.
+const depositStreamBufferSize = 64
+
+// depositStream holds the deposits streamed from the deposit contract, so
+// that the EL blocks the subscription covers are not polled for deposits.
+//
+// The logs of an EL block are notified when it becomes canonical, i.e. on the
+// forkchoice update of the beacon block carrying it. With a non-zero follow
+// distance, the deposits of a block are fetched at least a block after that,
+// by which point its logs have been received.
+type depositStream struct {
+	// mu protects the fields below for concurrent access.
+	mu sync.Mutex
+	// live is true while the subscription is active.
+	live bool
+	// since is the first EL block whose deposits are all streamed and not
+	// yet read, zero until the first EL head after subscribing is known.
+	since math.U64
+	// batches are the streamed deposits by EL block hash.
+	batches map[common.ExecutionHash]*deposit.Batch
+}
+
+// reset drops the streamed deposits, marking the subscription as live or not.
+func (ds *depositStream) reset(live bool) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	ds.live = live
+	ds.since = 0
+	ds.batches = make(map[common.ExecutionHash]*deposit.Batch)
+}
+
+// setHead records that the EL block at number is canonical. The deposits of
+// every later block are streamed if the subscription was live before it.
+func (ds *depositStream) setHead(number math.U64) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.live && ds.since == 0 {
+		ds.since = number + 1
+	}
+}
+
+// add records a streamed deposit, or drops it if its block left the
+// canonical chain.
+func (ds *depositStream) add(log *deposit.Log) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if !ds.live {
+		return
+	}
+	batch, ok := ds.batches[log.BlockHash]
+	if log.Removed {
+		if !ok {
+			return
+		}
+		batch.Deposits = slices.DeleteFunc(batch.Deposits, func(d *ctypes.Deposit) bool {
+			return d.Index == log.Deposit.Index
+		})
+		if len(batch.Deposits) == 0 {
+			delete(ds.batches, log.BlockHash)
+		}
+		return
+	}
+	if !ok {
+		batch = &deposit.Batch{BlockNumber: log.BlockNumber, BlockHash: log.BlockHash}
+		ds.batches[log.BlockHash] = batch
+	}
+	batch.Deposits = append(batch.Deposits, log.Deposit)
+}
+
+// read returns the streamed deposits of the EL blocks [from, to], grouped
+// by block in block and index order, and whether the stream covers them.
+// Deposits of blocks up to to are dropped, so a range read again, e.g. after
+// a rollback, is not covered.
+func (ds *depositStream) read(from, to math.U64) ([]*deposit.Batch, bool) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if !ds.live || ds.since == 0 || from < ds.since {
+		return nil, false
+	}
+
+	batches := make([]*deposit.Batch, 0)
+	for hash, batch := range ds.batches {
+		if batch.BlockNumber > to {
+			continue
+		}
+		delete(ds.batches, hash)
+		if batch.BlockNumber >= from {
+			batches = append(batches, batch)
+		}
+	}
+	ds.since = to + 1
+
+	slices.SortFunc(batches, func(a, b *deposit.Batch) int {
+		return cmp.Compare(a.BlockNumber, b.BlockNumber)
+	})
+	for i, batch := range batches {
+		// Two canonical blocks at the same number mean a reorg was missed.
+		if i > 0 && batches[i-1].BlockNumber == batch.BlockNumber {
+			return nil, false
+		}
+		slices.SortFunc(batch.Deposits, func(a, b *ctypes.Deposit) int {
+			return cmp.Compare(a.Index, b.Index)
+		})
+	}
+	return batches, true
+}
+
+// streamDeposits subscribes to the deposits of the deposit contract until
+// ctx is cancelled, resubscribing after failures. Deposits are polled while
+// the subscription is down, and only polled if the EL connection does not
+// support subscriptions.
+func (s *Service) streamDeposits(ctx context.Context) {
+	for {
+		logs := make(chan *deposit.Log, depositStreamBufferSize)
+		sub, err := s.depositContract.WatchDeposits(ctx, logs)
+		if errors.Is(err, ethclient.ErrSubscriptionsNotSupported) {
+			s.logger.Info("Deposit streaming requires an IPC or WebSocket EL connection, polling deposits")
+			return
+		}
+		if err == nil {
+			s.logger.Info("Streaming deposits from the execution layer")
+			s.depositStream.reset(true)
+			err = s.consumeDeposits(ctx, sub.Err(), logs)
+			sub.Unsubscribe()
+			s.depositStream.reset(false)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		s.logger.Warn(
+			"Deposit subscription failed, polling deposits until resubscribed",
+			"error", err,
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(defaultRetryInterval):
+		}
+	}
+}
+
+// consumeDeposits records the streamed deposits until the subscription fails
+// or ctx is cancelled.
+func (s *Service) consumeDeposits(
+	ctx context.Context,
+	errs <-chan error,
+	logs <-chan *deposit.Log,
+) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errs:
+			return err
+		case log := <-logs:
+			s.depositStream.add(log)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package blockchain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/execution/deposit"
+	bemocks "github.com/berachain/beacon-kit/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDepositContract serves the deposits of an in-memory EL chain, in which
+// each block emits the deposits listed for it.
+type fakeDepositContract struct {
+	// deposits are the indexes of the deposits emitted by each block.
+	deposits map[math.U64][]uint64
+	// hashes are the hashes of the canonical blocks. Blocks not listed have
+	// the hash derived from their number.
+	hashes map[math.U64]common.ExecutionHash
+	// err, if set, is returned by every call.
+	err error
+
+	// readRanges are the block ranges passed to ReadDeposits.
+	readRanges [][2]math.U64
+	// hashCalls is the number of calls to CanonicalBlockHash.
+	hashCalls int
+}
+
+func newFakeDepositContract() *fakeDepositContract {
+	return &fakeDepositContract{
+		deposits: make(map[math.U64][]uint64),
+		hashes:   make(map[math.U64]common.ExecutionHash),
+	}
+}
+
+func (c *fakeDepositContract) blockHash(number math.U64) common.ExecutionHash {
+	if hash, ok := c.hashes[number]; ok {
+		return hash
+	}
+	return common.ExecutionHash{byte(number), byte(number >> 8), 0x01}
+}
+
+func (c *fakeDepositContract) ReadDeposits(
+	_ context.Context,
+	fromBlock math.U64,
+	toBlock math.U64,
+) ([]*deposit.Batch, error) {
+	c.readRanges = append(c.readRanges, [2]math.U64{fromBlock, toBlock})
+	if c.err != nil {
+		return nil, c.err
+	}
+	var batches []*deposit.Batch
+	for number := fromBlock; number <= toBlock; number++ {
+		indexes, ok := c.deposits[number]
+		if !ok {
+			continue
+		}
+		batch := &deposit.Batch{BlockNumber: number, BlockHash: c.blockHash(number)}
+		for _, idx := range indexes {
+			batch.Deposits = append(batch.Deposits, &ctypes.Deposit{
+				Pubkey: [48]byte{byte(idx)},
+				Amount: 32e9,
+				Index:  idx,
+			})
+		}
+		batches = append(batches, batch)
+	}
+	return batches, nil
+}
+
+func (c *fakeDepositContract) WatchDeposits(
+	context.Context,
+	chan<- *deposit.Log,
+) (event.Subscription, error) {
+	return nil, ethclient.ErrSubscriptionsNotSupported
+}
+
+func (c *fakeDepositContract) CanonicalBlockHash(
+	_ context.Context,
+	number math.U64,
+) (common.ExecutionHash, error) {
+	c.hashCalls++
+	if c.err != nil {
+		return common.ExecutionHash{}, c.err
+	}
+	return c.blockHash(number), nil
+}
+
+// setupDepositTests returns a service fetching deposits from contract, at most
+// maxSpan blocks at a time, into an empty deposit store.
+func setupDepositTests(
+	t *testing.T,
+	contract deposit.Contract,
+	maxSpan uint64,
+) (*blockchain.Service, depositstore.StoreManager, math.U64) {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	_, _, depStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+
+	sb := bemocks.NewStorageBackend(t)
+	sb.EXPECT().DepositStore().Return(depStore).Maybe()
+
+	chain := blockchain.NewService(
+		sb,
+		nil, // blockchain.BlobProcessor unused in this test
+		contract,
+		log.NewNopLogger(),
+		cs,
+		nil, // blockchain.ExecutionEngine unused in this test
+		nil, // blockchain.LocalBuilder unused in this test
+		nil, // blockchain.StateProcessor unused in this test
+		nil, // blockchain.EventPublisher unused in this test
+		metrics.NewNoOpTelemetrySink(),
+		maxSpan,
+		false, // optimistic payload builds unused in this test
+		false, // blob archive mode unused in this test
+	)
+	return chain, depStore, math.U64(cs.Eth1FollowDistance())
+}
+
+func lastIndexedBlock(t *testing.T, depStore depositstore.StoreManager) math.U64 {
+	t.Helper()
+	last, found, err := depStore.GetLastIndexedBlock(context.Background())
+	require.NoError(t, err)
+	require.True(t, found)
+	return math.U64(last)
+}
+
+func TestDepositFetcher_FirstFetchOnlyReadsTarget(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	contract.deposits[90] = []uint64{0}
+	contract.deposits[100] = []uint64{1}
+	chain, depStore, followDistance := setupDepositTests(t, contract, 10)
+
+	chain.DepositFetcher(context.Background(), 100+followDistance)
+
+	require.Equal(t, [][2]math.U64{{100, 100}}, contract.readRanges)
+	require.Equal(t, math.U64(100), lastIndexedBlock(t, depStore))
+	block, found, err := depStore.GetDepositBlock(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, math.U64(100), block.Number)
+}
+
+func TestDepositFetcher_ResumesFromLastIndexedBlock(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	contract.deposits[52] = []uint64{0, 1}
+	contract.deposits[58] = []uint64{2}
+	chain, depStore, followDistance := setupDepositTests(t, contract, 10)
+	require.NoError(t, depStore.SetLastIndexedBlock(context.Background(), 50))
+
+	// The fetcher reads a single range of at most the max span.
+	chain.DepositFetcher(context.Background(), 80+followDistance)
+	require.Equal(t, [][2]math.U64{{51, 60}}, contract.readRanges)
+	require.Equal(t, math.U64(60), lastIndexedBlock(t, depStore))
+
+	deposits, _, err := depStore.GetDepositsByIndex(context.Background(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 3)
+
+	// Later fetches resume after the last indexed block.
+	chain.DepositFetcher(context.Background(), 80+followDistance)
+	require.Equal(t, [][2]math.U64{{51, 60}, {61, 70}}, contract.readRanges)
+	require.Equal(t, math.U64(70), lastIndexedBlock(t, depStore))
+}
+
+func TestDepositFetcher_SkipsWhileCatchupRuns(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	chain, depStore, followDistance := setupDepositTests(t, contract, 10)
+	require.NoError(t, depStore.SetLastIndexedBlock(context.Background(), 50))
+
+	unlock := chain.LockDepositFetch()
+	chain.DepositFetcher(context.Background(), 80+followDistance)
+	unlock()
+
+	require.Empty(t, contract.readRanges)
+	require.Equal(t, math.U64(50), lastIndexedBlock(t, depStore))
+}
+
+func TestCatchupDeposits_BatchesByMaxSpan(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	contract.deposits[105] = []uint64{0}
+	contract.deposits[120] = []uint64{1, 2}
+	contract.deposits[135] = []uint64{3}
+	chain, depStore, _ := setupDepositTests(t, contract, 10)
+	require.NoError(t, depStore.SetLastIndexedBlock(context.Background(), 100))
+
+	chain.CatchupDeposits(context.Background(), 135)
+
+	require.Equal(t, [][2]math.U64{
+		{101, 110}, {111, 120}, {121, 130}, {131, 135},
+	}, contract.readRanges)
+	require.Equal(t, math.U64(135), lastIndexedBlock(t, depStore))
+	deposits, _, err := depStore.GetDepositsByIndex(context.Background(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 4)
+
+	// Nothing is left to fetch.
+	chain.CatchupDeposits(context.Background(), 135)
+	require.Len(t, contract.readRanges, 4)
+}
+
+func TestCatchupDeposits_StopsOnError(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	contract.err = errors.New("rpc unavailable")
+	chain, depStore, _ := setupDepositTests(t, contract, 10)
+	require.NoError(t, depStore.SetLastIndexedBlock(context.Background(), 100))
+
+	chain.CatchupDeposits(context.Background(), 135)
+
+	require.Equal(t, [][2]math.U64{{101, 110}}, contract.readRanges)
+	require.Equal(t, math.U64(100), lastIndexedBlock(t, depStore))
+}
+
+func TestDepositFetcher_ReadsStreamedDeposits(t *testing.T) {
+	t.Parallel()
+	contract := newFakeDepositContract()
+	chain, depStore, followDistance := setupDepositTests(t, contract, 10)
+	require.NoError(t, depStore.SetLastIndexedBlock(context.Background(), 50))
+	streamed := func(number math.U64, hash byte, idx uint64, removed bool) *deposit.Log {
+		return &deposit.Log{
+			BlockNumber: number,
+			BlockHash:   common.ExecutionHash{hash},
+			Deposit:     &ctypes.Deposit{Pubkey: [48]byte{byte(idx)}, Amount: 32e9, Index: idx},
+			Removed:     removed,
+		}
+	}
+
+	// Blocks up to the EL head known once the subscription is live are
+	// polled.
+	chain.StartDepositStream()
+	chain.DepositFetcher(context.Background(), 60+followDistance)
+	chain.DepositFetcher(context.Background(), 70+followDistance)
+	require.Equal(t, [][2]math.U64{{51, 60}, {61, 70}}, contract.readRanges)
+
+	// Later blocks are read from the stream, without the deposits of blocks
+	// that left the canonical chain.
+	chain.AddStreamedDeposit(streamed(75, 0x01, 1, false))
+	chain.AddStreamedDeposit(streamed(72, 0x02, 0, false))
+	chain.AddStreamedDeposit(streamed(75, 0x01, 2, false))
+	chain.AddStreamedDeposit(streamed(78, 0x03, 3, false))
+	chain.AddStreamedDeposit(streamed(78, 0x03, 3, true))
+	chain.AddStreamedDeposit(streamed(78, 0x04, 3, false))
+	chain.DepositFetcher(context.Background(), 80+followDistance)
+	require.Len(t, contract.readRanges, 2)
+	require.Equal(t, math.U64(80), lastIndexedBlock(t, depStore))
+
+	deposits, _, err := depStore.GetDepositsByIndex(context.Background(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 4)
+	for idx, number := range []math.U64{72, 75, 75, 78} {
+		require.Equal(t, uint64(idx), deposits[idx].Index)
+		block, found, err := depStore.GetDepositBlock(context.Background(), uint64(idx))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, number, block.Number)
+	}
+	block, _, err := depStore.GetDepositBlock(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, common.ExecutionHash{0x04}, block.Hash)
+
+	// Ranges read again, e.g. after a rollback, are polled.
+	require.NoError(t, depStore.SetLastIndexedBlock(context.Background(), 70))
+	chain.DepositFetcher(context.Background(), 80+followDistance)
+	require.Equal(t, [][2]math.U64{{51, 60}, {61, 70}, {71, 80}}, contract.readRanges)
+}
@@ -40,4 +40,7 @@ var (
 	ErrSidecarCommitmentMismatch = errors.New("sidecars commitments mismatch")
 	// ErrSidecarSignatureMismatch indicates that the sidecar signature is invalid.
 	ErrSidecarSignatureMismatch = errors.New("sidecar signature mismatch")
+	// ErrSnapshotStoresMismatch indicates that the beacon-side stores carried by a state sync snapshot
+	// do not match the beacon state restored from it.
+	ErrSnapshotStoresMismatch = errors.New("snapshot stores mismatch")
 )
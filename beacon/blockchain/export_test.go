@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/execution/deposit"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// DepositFetcher exposes depositFetcher to the tests.
+func (s *Service) DepositFetcher(ctx context.Context, blockNum math.U64) {
+	s.depositFetcher(ctx, blockNum)
+}
+
+// CatchupDeposits exposes catchupDeposits to the tests.
+func (s *Service) CatchupDeposits(ctx context.Context, target math.U64) {
+	s.catchupDeposits(ctx, target)
+}
+
+// StartDepositStream marks the deposit stream live, as a new subscription
+// does.
+func (s *Service) StartDepositStream() {
+	s.depositStream.reset(true)
+}
+
+// AddStreamedDeposit records a deposit received from the subscription.
+func (s *Service) AddStreamedDeposit(log *deposit.Log) {
+	s.depositStream.add(log)
+}
+
+// LockDepositFetch acquires the deposit fetch lock, as the catchup fetcher
+// does, and returns the function releasing it.
+func (s *Service) LockDepositFetch() func() {
+	s.depositFetchMu.Lock()
+	return s.depositFetchMu.Unlock
+}
@@ -80,7 +80,7 @@ func (s *Service) FinalizeBlock(
 	}
 
 	// STEP 4: Post Finalizations cleanups.
-	return valUpdates, s.PostFinalizeBlockOps(ctx, blk)
+	return valUpdates, s.PostFinalizeBlockOps(ctx, signedBlk)
 }
 
 func (s *Service) FinalizeSidecars(
@@ -109,6 +109,7 @@ func (s *Service) FinalizeSidecars(
 		) {
 			return ErrDataNotAvailable
 		}
+		s.eventPublisher.PublishBlobSidecars(blobs)
 		return nil
 	}
 
@@ -122,24 +123,26 @@ func (s *Service) FinalizeSidecars(
 	return nil
 }
 
-func (s *Service) PostFinalizeBlockOps(ctx sdk.Context, blk *ctypes.BeaconBlock) error {
+func (s *Service) PostFinalizeBlockOps(ctx sdk.Context, signedBlk *ctypes.SignedBeaconBlock) error {
 	// TODO: consider extracting LatestExecutionPayloadHeader instead of using state here
 	st := s.storageBackend.StateFromContext(ctx)
+	blk := signedBlk.GetBeaconBlock()
 
-	// Fetch and store the deposit for the block.
+	// Drop pending deposits orphaned by an EL reorg, then fetch and store the
+	// deposits for the block.
+	s.verifyPendingDeposits(ctx, st)
 	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
 	s.depositFetcher(ctx, blockNum)
 
-	// Store the finalized block in the KVStore.
-	//
-	// TODO: Store full SignedBeaconBlock with all data in storage
+	// Store the finalized block in the block store.
 	slot := blk.GetSlot()
-	if err := s.storageBackend.BlockStore().Set(blk); err != nil {
+	if err := s.storageBackend.BlockStore().Set(signedBlk); err != nil {
 		s.logger.Error(
 			"failed to store block", "slot", slot, "error", err,
 		)
 		return err
 	}
+	s.eventPublisher.PublishFinalizedBlock(st, blk)
 
 	// Prune the availability and deposit store.
 	if err := s.processPruning(ctx, blk); err != nil {
@@ -150,9 +153,36 @@ func (s *Service) PostFinalizeBlockOps(ctx sdk.Context, blk *ctypes.BeaconBlock)
 		return fmt.Errorf("sendPostBlockFCU failed: %w", err)
 	}
 
+	if s.eventPublisher.WantsPayloadAttributes() {
+		s.publishPayloadAttributes(ctx, st, blk)
+	}
 	return nil
 }
 
+// publishPayloadAttributes publishes the attributes the payload of the next
+// block will be built with. The block timestamp stands in for the consensus
+// time of the next proposal, which is not known yet.
+func (s *Service) publishPayloadAttributes(
+	ctx context.Context,
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+) {
+	// state copy makes sure that preFetchBuildData does not affect state
+	nextBlockData, err := s.preFetchBuildData(st.Copy(ctx), blk.GetTimestamp())
+	if err != nil {
+		s.logger.Warn(
+			"Failed pre fetching data for payload attributes event",
+			"slot", blk.GetSlot().Base10(),
+			"err", err,
+		)
+		return
+	}
+	s.eventPublisher.PublishPayloadAttributes(
+		nextBlockData,
+		blk.GetBody().GetExecutionPayload().GetNumber(),
+	)
+}
+
 // finalizeBeaconBlock receives an incoming beacon block, it first validates
 // and then processes the block.
 func (s *Service) finalizeBeaconBlock(
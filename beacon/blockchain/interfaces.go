@@ -115,7 +115,24 @@ type StorageBackend interface {
 	// DepositStore retrieves the deposit store.
 	DepositStore() deposit.StoreManager
 	// BlockStore retrieves the block store.
-	BlockStore() *block.KVStore[*ctypes.BeaconBlock]
+	BlockStore() *block.KVStore
+}
+
+// EventPublisher publishes chain events to node API subscribers.
+type EventPublisher interface {
+	// PublishFinalizedBlock publishes the events of a newly finalized block.
+	PublishFinalizedBlock(st *statedb.StateDB, blk *ctypes.BeaconBlock)
+	// PublishBlobSidecars publishes the events of newly available sidecars.
+	PublishBlobSidecars(sidecars datypes.BlobSidecars)
+	// WantsPayloadAttributes returns true if anyone listens for the
+	// attributes of the next payload.
+	WantsPayloadAttributes() bool
+	// PublishPayloadAttributes publishes the attributes the next payload
+	// will be built with.
+	PublishPayloadAttributes(
+		data *builder.RequestPayloadData,
+		parentBlockNumber math.U64,
+	)
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -157,7 +174,15 @@ type BlockchainI interface {
 	) (transition.ValidatorUpdates, error)
 	PostFinalizeBlockOps(
 		sdk.Context,
-		*ctypes.BeaconBlock,
+		*ctypes.SignedBeaconBlock,
+	) error
+	SnapshotStores(
+		ctx sdk.Context,
+		write func([]byte) error,
+	) error
+	RestoreStores(
+		ctx sdk.Context,
+		payloads [][]byte,
 	) error
 }
 
@@ -193,4 +218,5 @@ type ServiceChainSpec interface {
 	EpochsPerHistoricalVector() uint64
 	SlotToEpoch(slot math.Slot) math.Epoch
 	Eth1FollowDistance() uint64
+	MaxDepositsPerBlock() uint64
 }
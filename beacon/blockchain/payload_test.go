@@ -284,8 +284,11 @@ func setupOptimisticPayloadTests(t *testing.T, cs chain.Spec, optimisticPayloadB
 		eng,
 		b,
 		sp,
+		nil, // blockchain.EventPublisher unused in this test
 		ts,
+		1, // deposit fetch max span unused in this test
 		optimisticPayloadBuilds,
+		false, // blob archive mode unused in this test
 	)
 	return chain, st, cms, ctx, sp, b, sb, eng, depStore
 }
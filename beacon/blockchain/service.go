@@ -23,6 +23,7 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/log"
@@ -40,11 +41,16 @@ type Service struct {
 	depositContract deposit.Contract
 	// eth1FollowDistance is the follow distance for Ethereum 1.0 blocks.
 	eth1FollowDistance math.U64
-	// failedBlocksMu protects failedBlocks for concurrent access.
-	failedBlocksMu sync.RWMutex
-	// failedBlocks is a map of blocks that failed to be processed
-	// and should be retried.
-	failedBlocks map[math.U64]struct{}
+	// depositFetchMaxSpan is the maximum number of EL blocks whose deposits
+	// are read in a single call.
+	depositFetchMaxSpan math.U64
+	// depositFetchMu serializes deposit fetching, which advances the last
+	// indexed block checkpoint of the deposit store.
+	depositFetchMu sync.Mutex
+	// depositTarget is the latest EL block whose deposits should be fetched.
+	depositTarget atomic.Uint64
+	// depositStream holds the deposits streamed from the deposit contract.
+	depositStream depositStream
 	// logger is used for logging messages in the service.
 	logger log.Logger
 	// chainSpec holds the chain specifications.
@@ -57,11 +63,16 @@ type Service struct {
 	localBuilder LocalBuilder
 	// stateProcessor is the state processor for beacon blocks and states.
 	stateProcessor StateProcessor
+	// eventPublisher publishes chain events to node API subscribers.
+	eventPublisher EventPublisher
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
+	// blobArchiveMode disables the pruning of the blob sidecars past the
+	// data availability period.
+	blobArchiveMode bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
 }
@@ -76,22 +87,27 @@ func NewService(
 	executionEngine ExecutionEngine,
 	localBuilder LocalBuilder,
 	stateProcessor StateProcessor,
+	eventPublisher EventPublisher,
 	telemetrySink TelemetrySink,
+	depositFetchMaxSpan uint64,
 	optimisticPayloadBuilds bool,
+	blobArchiveMode bool,
 ) *Service {
 	return &Service{
 		storageBackend:          storageBackend,
 		blobProcessor:           blobProcessor,
 		depositContract:         depositContract,
 		eth1FollowDistance:      math.U64(chainSpec.Eth1FollowDistance()),
-		failedBlocks:            make(map[math.Slot]struct{}),
+		depositFetchMaxSpan:     math.U64(max(depositFetchMaxSpan, 1)),
 		logger:                  logger,
 		chainSpec:               chainSpec,
 		executionEngine:         executionEngine,
 		localBuilder:            localBuilder,
 		stateProcessor:          stateProcessor,
+		eventPublisher:          eventPublisher,
 		metrics:                 newChainMetrics(telemetrySink),
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
+		blobArchiveMode:         blobArchiveMode,
 		forceStartupSyncOnce:    new(sync.Once),
 	}
 }
@@ -103,13 +119,20 @@ func (s *Service) Name() string {
 
 // Start starts the blockchain service.
 func (s *Service) Start(ctx context.Context) error {
-	// Catchup deposits for failed blocks. TODO: remove.
+	// Fill gaps in fetched deposits, resuming from the persisted checkpoint.
 	go s.depositCatchupFetcher(ctx)
 
+	// Stream deposits instead of polling them where the EL connection allows
+	// it. Without a follow distance, the logs of a block may not have been
+	// received when its deposits are fetched, so they are always polled.
+	if s.eth1FollowDistance > 0 {
+		go s.streamDeposits(ctx)
+	}
+
 	return nil
 }
 
-// Stop stops the blockchain service and closes the deposit store.
+// Stop stops the blockchain service and closes the deposit and block stores.
 func (s *Service) Stop() error {
 	s.logger.Info("Stopping blockchain service")
 
@@ -118,6 +141,11 @@ func (s *Service) Stop() error {
 		s.logger.Error("failed to close deposit store", "err", err)
 	}
 
+	err = s.storageBackend.BlockStore().Close()
+	if err != nil {
+		s.logger.Error("failed to close block store", "err", err)
+	}
+
 	return nil
 }
 
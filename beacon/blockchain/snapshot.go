@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// forkVersionSize is the size of the fork version prefixed to the block of a
+// snapshot.
+const forkVersionSize = 4
+
+// SnapshotStores writes the beacon-side stores kept beside the beacon state of
+// ctx, which a node restored from a state sync snapshot of that state needs to
+// resume with block sync. The first payload is the block at the slot of the
+// state, prefixed by its fork version. The second is the EL block that emitted
+// the last deposit processed by the state, empty if unknown. Each of the
+// following payloads is one of the deposits processed by the state, in order.
+func (s *Service) SnapshotStores(ctx sdk.Context, write func([]byte) error) error {
+	st := s.storageBackend.StateFromContext(ctx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	blk, err := s.storageBackend.BlockStore().GetBlockBySlot(slot)
+	if err != nil {
+		return fmt.Errorf("failed to load block at slot %d: %w", slot, err)
+	}
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	forkVersion := blk.GetForkVersion()
+	if err = write(append(forkVersion[:], bz...)); err != nil {
+		return err
+	}
+
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	depositStore := s.storageBackend.DepositStore()
+	deposits, _, err := depositStore.GetDepositsByIndex(ctx, constants.FirstDepositIndex, depositIndex)
+	if err != nil {
+		return err
+	}
+	if uint64(len(deposits)) != depositIndex {
+		return fmt.Errorf("deposit store holds %d of %d deposits", len(deposits), depositIndex)
+	}
+
+	var lastBlock []byte
+	if depositIndex > 0 {
+		block, found, errBlock := depositStore.GetDepositBlock(ctx, depositIndex-1)
+		if errBlock != nil {
+			return errBlock
+		}
+		if found {
+			if lastBlock, err = block.MarshalBinary(); err != nil {
+				return err
+			}
+		}
+	}
+	if err = write(lastBlock); err != nil {
+		return err
+	}
+
+	for _, deposit := range deposits {
+		if bz, err = deposit.MarshalSSZ(); err != nil {
+			return err
+		}
+		if err = write(bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreStores seeds the deposit store and the block store from the payloads
+// written by SnapshotStores, once the beacon state of ctx has been restored
+// from the same snapshot. The payloads are not covered by the app hash, so the
+// block is checked against the latest block header of the state and the
+// deposits against its deposit root before anything is written.
+func (s *Service) RestoreStores(ctx sdk.Context, payloads [][]byte) error {
+	if len(payloads) < 2 { //nolint:mnd // block and last deposit block.
+		return fmt.Errorf("%w: got %d payloads", ErrSnapshotStoresMismatch, len(payloads))
+	}
+	st := s.storageBackend.StateFromContext(ctx)
+
+	blk, err := decodeSnapshotBlock(payloads[0])
+	if err != nil {
+		return err
+	}
+	blockRoot, err := latestBlockRoot(st)
+	if err != nil {
+		return err
+	}
+	if root := blk.GetBeaconBlock().HashTreeRoot(); root != blockRoot {
+		return fmt.Errorf(
+			"%w: block has root %s, state has %s", ErrSnapshotStoresMismatch, root, blockRoot,
+		)
+	}
+
+	var lastBlock *depositstorecommon.Block
+	if len(payloads[1]) > 0 {
+		lastBlock = new(depositstorecommon.Block)
+		if err = lastBlock.UnmarshalBinary(payloads[1]); err != nil {
+			return err
+		}
+	}
+
+	deposits := make(ctypes.Deposits, 0, len(payloads)-2)
+	for _, bz := range payloads[2:] {
+		deposit := new(ctypes.Deposit)
+		if err = ssz.Unmarshal(bz, deposit); err != nil {
+			return err
+		}
+		deposits = append(deposits, deposit)
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+	if uint64(len(deposits)) != depositIndex {
+		return fmt.Errorf(
+			"%w: got %d deposits, state has %d", ErrSnapshotStoresMismatch, len(deposits), depositIndex,
+		)
+	}
+	if root := deposits.HashTreeRoot(); root != eth1Data.DepositRoot {
+		return fmt.Errorf(
+			"%w: deposits have root %s, state has %s", ErrSnapshotStoresMismatch, root, eth1Data.DepositRoot,
+		)
+	}
+
+	// The deposits after the last processed one are fetched again from the
+	// EL, starting at the block that emitted it.
+	depositStore := s.storageBackend.DepositStore()
+	if err = depositStore.EnqueueDeposits(ctx, deposits); err != nil {
+		return err
+	}
+	if lastBlock != nil && lastBlock.Number > 0 {
+		if err = depositStore.SetLastIndexedBlock(ctx, lastBlock.Number.Unwrap()-1); err != nil {
+			return err
+		}
+	}
+	return s.storageBackend.BlockStore().Set(blk)
+}
+
+// decodeSnapshotBlock decodes a block prefixed by its fork version.
+func decodeSnapshotBlock(bz []byte) (*ctypes.SignedBeaconBlock, error) {
+	if len(bz) < forkVersionSize {
+		return nil, fmt.Errorf("%w: block too short: %d bytes", ErrSnapshotStoresMismatch, len(bz))
+	}
+	blk, err := ctypes.NewEmptySignedBeaconBlockWithVersion(common.Version(bz[:forkVersionSize]))
+	if err != nil {
+		return nil, err
+	}
+	return blk, ssz.Unmarshal(bz[forkVersionSize:], blk)
+}
+
+// latestBlockRoot returns the root of the latest block processed by the
+// state, filling in the state root of its header if not set yet.
+func latestBlockRoot(st *statedb.StateDB) (common.Root, error) {
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	if header.GetStateRoot() == (common.Root{}) {
+		header.SetStateRoot(st.HashTreeRoot())
+	}
+	return header.HashTreeRoot(), nil
+}
@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package blockchain_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	bemocks "github.com/berachain/beacon-kit/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/common"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/block"
+	depositstore "github.com/berachain/beacon-kit/storage/deposit"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// snapshotFixture is a beacon state with processed deposits and the block it
+// was committed with, along with the stores a node snapshotting or restoring
+// it would keep beside it.
+type snapshotFixture struct {
+	ctx          sdk.Context
+	st           *statedb.StateDB
+	deposits     ctypes.Deposits
+	blk          *ctypes.SignedBeaconBlock
+	depositStore depositstore.StoreManager
+	blockStore   *block.KVStore
+	chain        *blockchain.Service
+}
+
+// newSnapshotFixture returns a genesis state with four deposits, emitted by
+// EL block 7, and the block at slot 3 as its latest block. If seeded, the
+// stores hold the deposits and the block, as on the node taking a snapshot.
+func newSnapshotFixture(t *testing.T, seeded bool) *snapshotFixture {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	sp, st, depStore, _, cms, _ := statetransition.SetupTestState(t, cs)
+
+	deposits := make(ctypes.Deposits, 0, 4)
+	for i := range 4 {
+		deposits = append(deposits, &ctypes.Deposit{
+			Pubkey: [48]byte{byte(i + 1)},
+			Amount: cs.MaxEffectiveBalance(),
+			Credentials: ctypes.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{byte(i + 1)},
+			),
+			Index: uint64(i),
+		})
+	}
+	header := &ctypes.ExecutionPayloadHeader{
+		Versionable: ctypes.NewVersionable(cs.GenesisForkVersion()),
+	}
+	_, err = sp.InitializeBeaconStateFromEth1(st, deposits, header, cs.GenesisForkVersion())
+	require.NoError(t, err)
+
+	// The state root of the latest block header is only filled in at the
+	// next slot, so the block commits to the state with it unset.
+	beaconBlk, err := ctypes.NewBeaconBlockWithVersion(3, 1, common.Root{0x01}, cs.GenesisForkVersion())
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(3))
+	require.NoError(t, st.SetLatestBlockHeader(beaconBlk.GetHeader()))
+	beaconBlk.SetStateRoot(st.HashTreeRoot())
+	blk := &ctypes.SignedBeaconBlock{BeaconBlock: beaconBlk, Signature: [96]byte{0x02}}
+
+	f := &snapshotFixture{
+		ctx:          sdk.NewContext(cms.CacheMultiStore(), false, log.NewNopLogger()),
+		st:           st,
+		deposits:     deposits,
+		blk:          blk,
+		depositStore: depStore,
+		blockStore:   block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 1000, false),
+	}
+	if seeded {
+		elBlock := depositstorecommon.Block{Number: 7, Hash: common.ExecutionHash{0x07}}
+		require.NoError(t, depStore.EnqueueBlockDeposits(context.Background(), elBlock, deposits))
+		require.NoError(t, f.blockStore.Set(blk))
+	}
+
+	sb := bemocks.NewStorageBackend(t)
+	sb.EXPECT().StateFromContext(mock.Anything).Return(st).Maybe()
+	sb.EXPECT().DepositStore().Return(depStore).Maybe()
+	sb.EXPECT().BlockStore().Return(f.blockStore).Maybe()
+	f.chain = blockchain.NewService(
+		sb,
+		nil, // blockchain.BlobProcessor unused in this test
+		nil, // deposit.Contract unused in this test
+		log.NewNopLogger(),
+		cs,
+		nil, // blockchain.ExecutionEngine unused in this test
+		nil, // blockchain.LocalBuilder unused in this test
+		nil, // blockchain.StateProcessor unused in this test
+		nil, // blockchain.EventPublisher unused in this test
+		metrics.NewNoOpTelemetrySink(),
+		1,
+		false, // optimistic payload builds unused in this test
+		false, // blob archive mode unused in this test
+	)
+	return f
+}
+
+// snapshotStores returns the payloads the fixture writes to a snapshot.
+func (f *snapshotFixture) snapshotStores(t *testing.T) [][]byte {
+	t.Helper()
+	var payloads [][]byte
+	require.NoError(t, f.chain.SnapshotStores(f.ctx, func(bz []byte) error {
+		payloads = append(payloads, bz)
+		return nil
+	}))
+	return payloads
+}
+
+func TestSnapshotStores_RoundTrip(t *testing.T) {
+	t.Parallel()
+	source := newSnapshotFixture(t, true)
+	payloads := source.snapshotStores(t)
+	// Block, last deposit block, then one payload per deposit.
+	require.Len(t, payloads, 2+len(source.deposits))
+
+	target := newSnapshotFixture(t, false)
+	require.NoError(t, target.chain.RestoreStores(target.ctx, payloads))
+
+	stored, err := target.blockStore.GetBlockBySlot(3)
+	require.NoError(t, err)
+	require.Equal(t, source.blk.HashTreeRoot(), stored.HashTreeRoot())
+
+	ctx := context.Background()
+	got, _, err := target.depositStore.GetDepositsByIndex(ctx, 0, uint64(len(source.deposits)))
+	require.NoError(t, err)
+	require.Equal(t, source.deposits.HashTreeRoot(), got.HashTreeRoot())
+
+	// Deposits are fetched again from the block that emitted the last one.
+	last, found, err := target.depositStore.GetLastIndexedBlock(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(6), last)
+}
+
+func TestSnapshotStores_MissingBlock(t *testing.T) {
+	t.Parallel()
+	f := newSnapshotFixture(t, false)
+	require.Error(t, f.chain.SnapshotStores(f.ctx, func([]byte) error { return nil }))
+}
+
+func TestRestoreStores_Mismatch(t *testing.T) {
+	t.Parallel()
+	source := newSnapshotFixture(t, true)
+	payloads := source.snapshotStores(t)
+
+	tests := []struct {
+		name   string
+		tamper func(payloads [][]byte) [][]byte
+	}{
+		{
+			name:   "truncated",
+			tamper: func(payloads [][]byte) [][]byte { return payloads[:1] },
+		},
+		{
+			name: "other block",
+			tamper: func(payloads [][]byte) [][]byte {
+				blk := *source.blk
+				blk.Signature = [96]byte{0x03}
+				beaconBlk := *blk.BeaconBlock
+				beaconBlk.ProposerIndex++
+				blk.BeaconBlock = &beaconBlk
+				bz, err := blk.MarshalSSZ()
+				require.NoError(t, err)
+				forkVersion := blk.GetForkVersion()
+				payloads[0] = append(forkVersion[:], bz...)
+				return payloads
+			},
+		},
+		{
+			name:   "missing deposit",
+			tamper: func(payloads [][]byte) [][]byte { return payloads[:len(payloads)-1] },
+		},
+		{
+			name: "other deposit",
+			tamper: func(payloads [][]byte) [][]byte {
+				deposit := *source.deposits[0]
+				deposit.Amount++
+				bz, err := deposit.MarshalSSZ()
+				require.NoError(t, err)
+				payloads[2] = bz
+				return payloads
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			target := newSnapshotFixture(t, false)
+			err := target.chain.RestoreStores(target.ctx, tc.tamper(slices.Clone(payloads)))
+			require.ErrorIs(t, err, blockchain.ErrSnapshotStoresMismatch)
+
+			// Nothing is seeded.
+			_, err = target.blockStore.GetBlockBySlot(3)
+			require.Error(t, err)
+			_, found, err := target.depositStore.GetLastIndexedBlock(context.Background())
+			require.NoError(t, err)
+			require.False(t, found)
+		})
+	}
+}
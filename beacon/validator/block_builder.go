@@ -28,8 +28,10 @@ import (
 	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/constants"
@@ -79,11 +81,49 @@ func (s *Service) BuildBlockAndSidecars(
 	}
 
 	// Get the payload for the block.
-	envelope, err := s.retrieveExecutionPayload(ctx, st, parentBlockRoot, slotData)
+	envelope, bid, err := s.retrieveExecutionPayload(ctx, st, parentBlockRoot, slotData)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed retrieving execution payload: %w", err)
 	}
 
+	// Propose the payload of an external builder if it outbids the local
+	// payload. The block is built on a copy of the state, so that we can fall
+	// back to the local payload if the bid cannot be used. Once the signed
+	// blinded block is submitted to the relay, no other block is proposed.
+	if bid != nil {
+		signedBlk, sidecars, bidErr := s.buildBlockWithBid(
+			ctx, st.Copy(ctx), slotData, parentBlockRoot, envelope, bid,
+		)
+		if bidErr == nil {
+			s.metrics.proposedBuilderPayload(bid.Relay())
+			s.logger.Info(
+				"Beacon block successfully built with builder payload",
+				"slot", blkSlot.Base10(),
+				"relay", bid.Relay(),
+				"value", bid.Message.Value,
+				"state_root", signedBlk.GetStateRoot(),
+				"duration", time.Since(startTime).String(),
+			)
+			return marshalBlockAndSidecars(signedBlk, sidecars)
+		}
+		s.metrics.failedToUseBuilderPayload(bid.Relay(), bidErr)
+		if errors.Is(bidErr, ErrBlindedBlockSubmitted) {
+			s.logger.Error(
+				"Builder payload of the submitted blinded block cannot be used, not proposing at this slot",
+				"slot", blkSlot.Base10(),
+				"relay", bid.Relay(),
+				"error", bidErr,
+			)
+			return nil, nil, bidErr
+		}
+		s.logger.Warn(
+			"Failed building block with builder payload, falling back to local payload",
+			"slot", blkSlot.Base10(),
+			"relay", bid.Relay(),
+			"error", bidErr,
+		)
+	}
+
 	// We introduce hard forks with the expectation that the first block proposed after the
 	// hard fork timestamp is when new rules apply. When building blocks, we provide the Execution
 	// Layer client with a timestamp, and it will create its payload based on that timestamp. We
@@ -146,6 +186,13 @@ func (s *Service) BuildBlockAndSidecars(
 		"duration", time.Since(startTime).String(),
 	)
 
+	return marshalBlockAndSidecars(signedBlk, sidecars)
+}
+
+// marshalBlockAndSidecars marshals an outgoing block and its sidecars.
+func marshalBlockAndSidecars(
+	signedBlk *ctypes.SignedBeaconBlock, sidecars datypes.BlobSidecars,
+) ([]byte, []byte, error) {
 	signedBlkBytes, bbErr := signedBlk.MarshalSSZ()
 	if bbErr != nil {
 		return nil, nil, bbErr
@@ -196,26 +243,90 @@ func (s *Service) buildForkData(st *statedb.StateDB, timestamp math.U64) (*ctype
 func (s *Service) buildRandaoReveal(
 	forkData *ctypes.ForkData, slot math.Slot,
 ) (crypto.BLSSignature, error) {
-	signingRoot := forkData.ComputeRandaoSigningRoot(
-		s.chainSpec.DomainTypeRandao(),
-		s.chainSpec.SlotToEpoch(slot),
-	)
-	signature, err := s.signer.Sign(signingRoot[:])
+	epoch := s.chainSpec.SlotToEpoch(slot)
+	signingRoot := forkData.ComputeRandaoSigningRoot(s.chainSpec.DomainTypeRandao(), epoch)
+	signature, err := crypto.SignRequest(s.signer, &crypto.SigningRequest{
+		Type:                  crypto.SigningTypeRandaoReveal,
+		SigningRoot:           signingRoot,
+		ForkVersion:           forkData.CurrentVersion,
+		GenesisValidatorsRoot: forkData.GenesisValidatorsRoot,
+		Epoch:                 epoch,
+	})
 	if err != nil {
 		return signature, fmt.Errorf("block building failed randao checks: %w", err)
 	}
 	return signature, nil
 }
 
-// retrieveExecutionPayload retrieves the execution payload for the block.
+// retrieveExecutionPayload retrieves the local execution payload for the
+// block and, if external builders are enabled, requests a bid for the payload
+// in parallel. The bid is only returned if it is valid for the block and
+// outbids the local payload.
 func (s *Service) retrieveExecutionPayload(
 	ctx context.Context,
 	st *statedb.StateDB,
 	parentBlockRoot common.Root,
 	slotData *types.SlotData,
+) (ctypes.BuiltExecutionPayloadEnv, *relay.Bid, error) {
+	if !s.relays.Enabled() {
+		envelope, err := s.retrieveLocalPayload(ctx, st, parentBlockRoot, slotData)
+		return envelope, nil, err
+	}
+
+	// Like the local payload, the payload of the builder must be built on top
+	// of the latest finalized payload.
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bids := make(chan *relay.Bid, 1)
+	go func() {
+		bid, bidErr := s.relays.GetBid(
+			ctx, slotData.GetSlot(), lph.GetBlockHash(), s.signer.PublicKey(),
+		)
+		if bidErr != nil && !errors.Is(bidErr, relay.ErrNoBid) {
+			s.logger.Warn("Failed requesting builder bid", "error", bidErr)
+		}
+		bids <- bid
+	}()
+
+	envelope, err := s.retrieveLocalPayload(ctx, st, parentBlockRoot, slotData)
+	bid := <-bids
+	if err != nil || bid == nil {
+		return envelope, nil, err
+	}
+
+	if err = s.validateBid(st, slotData, lph, envelope, bid); err != nil {
+		s.logger.Warn(
+			"Discarding builder bid", "relay", bid.Relay(), "error", err,
+		)
+		return envelope, nil, nil
+	}
+	localValue := envelope.GetBlockValue()
+	if localValue == nil {
+		localValue = math.NewU256(0)
+	}
+	if !s.relays.Outbids(bid, localValue) {
+		s.logger.Info(
+			"Local payload outbids builder bid",
+			"relay", bid.Relay(),
+			"bid_value", bid.Message.Value,
+			"local_value", localValue,
+		)
+		return envelope, nil, nil
+	}
+	return envelope, bid, nil
+}
+
+// retrieveLocalPayload retrieves the execution payload for the block from
+// the local builder.
+func (s *Service) retrieveLocalPayload(
+	ctx context.Context,
+	st *statedb.StateDB,
+	parentBlockRoot common.Root,
+	slotData *types.SlotData,
 ) (ctypes.BuiltExecutionPayloadEnv, error) {
-	// TODO: Add external block builders to this flow.
-	//
 	// Get the payload for the block.
 	slot := slotData.GetSlot()
 	envelope, err := s.localPayloadBuilder.RetrievePayload(ctx, slot, parentBlockRoot)
@@ -323,6 +434,14 @@ func (s *Service) buildBlockBody(
 		return fmt.Errorf("failed loading eth1 deposit index: %w", err)
 	}
 
+	// Drop pending deposits whose EL blocks are no longer canonical, rather
+	// than proposing them.
+	if err = s.depositVerifier.VerifyDeposits(
+		ctx, depositIndex, s.chainSpec.MaxDepositsPerBlock(),
+	); err != nil {
+		return fmt.Errorf("failed verifying pending deposits: %w", err)
+	}
+
 	// Grab all previous deposits from genesis up to the current index + max deposits per block.
 	deposits, localDepositRoot, err := s.sb.DepositStore().GetDepositsByIndex(
 		ctx,
@@ -348,7 +467,8 @@ func (s *Service) buildBlockBody(
 	body.SetDeposits(deposits[depositIndex:])
 
 	// Set the graffiti on the block body.
-	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
+	graffitiText := s.proposers.Graffiti(s.signer.PublicKey())
+	sizedGraffiti := bytes.ExtendToSize([]byte(graffitiText), bytes.B32Size)
 	graffiti, err := bytes.ToBytes32(sizedGraffiti)
 	if err != nil {
 		return fmt.Errorf("failed processing graffiti: %w", err)
@@ -419,8 +539,22 @@ func (s *Service) computeStateRoot(
 	startTime := time.Now()
 	defer s.metrics.measureStateRootComputationTime(startTime)
 
-	// TODO: Think about how this would affect the proposer when
-	// the payload in their block has come from a remote builder.
+	if err := s.processOutgoingBlock(ctx, proposerAddress, consensusTime, st, blk); err != nil {
+		return common.Root{}, err
+	}
+
+	return st.HashTreeRoot(), nil
+}
+
+// processOutgoingBlock applies an outgoing block to the state, without
+// verifying it.
+func (s *Service) processOutgoingBlock(
+	ctx context.Context,
+	proposerAddress []byte,
+	consensusTime math.U64,
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+) error {
 	txCtx := transition.NewTransitionCtx(
 		ctx,
 		consensusTime,
@@ -431,9 +565,6 @@ func (s *Service) computeStateRoot(
 		WithVerifyResult(false).
 		WithMeterGas(false)
 
-	if _, err := s.stateProcessor.Transition(txCtx, st, blk); err != nil {
-		return common.Root{}, err
-	}
-
-	return st.HashTreeRoot(), nil
+	_, err := s.stateProcessor.Transition(txCtx, st, blk)
+	return err
 }
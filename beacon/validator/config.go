@@ -32,7 +32,8 @@ const (
 // Config is the validator configuration.
 type Config struct {
 	// Graffiti is the string that will be included in the
-	// graffiti field of the beacon block.
+	// graffiti field of the beacon block, unless set for the validator in
+	// the proposer config file.
 	Graffiti string `mapstructure:"graffiti"`
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
@@ -32,4 +32,13 @@ var (
 	// ErrDepositStoreIncomplete is an error for when the deposit store has not returned
 	// the expected amount of deposits. Could be due to pruning when it should not be enabled.
 	ErrDepositStoreIncomplete = errors.New("deposits from deposit store incomplete")
+
+	// ErrInvalidBuilderBid is an error for when the bid of an external builder
+	// does not fit the block being built.
+	ErrInvalidBuilderBid = errors.New("invalid builder bid")
+
+	// ErrBlindedBlockSubmitted is an error for when the payload of a signed
+	// blinded block submitted to the relay of an external builder cannot be
+	// used, in which case no other block is proposed at its slot.
+	ErrBlindedBlockSubmitted = errors.New("signed blinded block submitted to relay")
 )
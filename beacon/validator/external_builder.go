@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// relayRegistrationInterval is the interval at which this node renews its
+// registration with the relays of external builders.
+const relayRegistrationInterval = 5 * time.Minute
+
+// validateBid checks that the payload of the bid can stand in for the local
+// payload of the block, so that the block passes the checks of the other
+// validators once the payload is revealed.
+func (s *Service) validateBid(
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	lph *ctypes.ExecutionPayloadHeader,
+	envelope ctypes.BuiltExecutionPayloadEnv,
+	bid *relay.Bid,
+) error {
+	header := bid.Message.Header
+	if header.GetParentHash() != lph.GetBlockHash() {
+		return errors.Wrapf(
+			ErrInvalidBuilderBid, "parent hash %s, expected %s",
+			header.GetParentHash(), lph.GetBlockHash(),
+		)
+	}
+
+	if err := payloadtime.Verify(
+		slotData.GetConsensusTime(), lph.GetTimestamp(), header.GetTimestamp(),
+	); err != nil {
+		return errors.Wrap(ErrInvalidBuilderBid, err.Error())
+	}
+
+	// The state has been prepared for the fork of the local payload.
+	payload := envelope.GetExecutionPayload()
+	if !version.Equals(header.GetForkVersion(), payload.GetForkVersion()) {
+		return errors.Wrapf(
+			ErrInvalidBuilderBid, "fork version %s, expected %s",
+			header.GetForkVersion(), payload.GetForkVersion(),
+		)
+	}
+
+	epoch := s.chainSpec.SlotToEpoch(slotData.GetSlot())
+	prevRandao, err := st.GetRandaoMixAtIndex(
+		epoch.Unwrap() % s.chainSpec.EpochsPerHistoricalVector(),
+	)
+	if err != nil {
+		return err
+	}
+	if header.GetPrevRandao() != prevRandao {
+		return errors.Wrapf(
+			ErrInvalidBuilderBid, "prev randao %s, expected %s",
+			header.GetPrevRandao(), prevRandao,
+		)
+	}
+
+	// The local payload carries the expected withdrawals.
+	withdrawalsRoot := engineprimitives.Withdrawals(payload.GetWithdrawals()).HashTreeRoot()
+	if header.GetWithdrawalsRoot() != withdrawalsRoot {
+		return errors.Wrapf(
+			ErrInvalidBuilderBid, "withdrawals root %s, expected %s",
+			header.GetWithdrawalsRoot(), withdrawalsRoot,
+		)
+	}
+
+	if n := uint64(len(bid.Message.BlobKzgCommitments)); n > s.chainSpec.MaxBlobsPerBlock() {
+		return errors.Wrapf(
+			ErrInvalidBuilderBid, "%d blobs, expected at most %d",
+			n, s.chainSpec.MaxBlobsPerBlock(),
+		)
+	}
+	return nil
+}
+
+// buildBlockWithBid builds a block carrying the payload of the bid. The
+// block is built and signed blinded, before its payload is revealed by the
+// relay of the bid. st is modified, so callers should pass a copy of the
+// state to be able to fall back to the local payload.
+//
+// Once the signed blinded block is submitted to the relay, it may be
+// published by the relay or the builder, so the failures to reveal or use its
+// payload are returned as ErrBlindedBlockSubmitted, and no other block must be
+// proposed at the slot.
+func (s *Service) buildBlockWithBid(
+	ctx context.Context,
+	st *statedb.StateDB,
+	slotData *types.SlotData,
+	parentBlockRoot common.Root,
+	localEnvelope ctypes.BuiltExecutionPayloadEnv,
+	bid *relay.Bid,
+) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
+	header := bid.Message.Header
+	forkData, err := s.buildForkData(st, header.GetTimestamp())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blk, err := s.getEmptyBeaconBlockForSlot(
+		st, slotData.GetSlot(), forkData.CurrentVersion, parentBlockRoot,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reveal, err := s.buildRandaoReveal(forkData, slotData.GetSlot())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The block is built with a payload standing in for the payload of the
+	// bid, carrying the same withdrawals as the local payload.
+	envelope, err := newBidEnvelope(bid, localEnvelope.GetExecutionPayload().GetWithdrawals())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = s.buildBlockBody(ctx, st, blk, reveal, envelope); err != nil {
+		return nil, nil, err
+	}
+
+	stateRoot, err := s.computeBlindedStateRoot(
+		ctx, slotData.GetProposerAddress(), slotData.GetConsensusTime(), st, blk, header,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	blk.SetStateRoot(stateRoot)
+
+	blinded := ctypes.NewBlindedBeaconBlock(blk, header)
+	signedBlinded, err := ctypes.SignBlindedBeaconBlock(blinded, forkData, s.chainSpec, s.signer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Until the signed blinded block is submitted it has not left the node,
+	// and the local block may still replace it in a later round.
+	if err = s.sealBlindedBlock(blinded, forkData); err != nil {
+		return nil, nil, err
+	}
+	signedBlk, sidecars, err := s.revealBlock(ctx, bid, signedBlinded)
+	if err != nil {
+		return nil, nil, errors.Join(ErrBlindedBlockSubmitted, err)
+	}
+	return signedBlk, sidecars, nil
+}
+
+// sealBlindedBlock makes the blinded block the only block signed at its slot
+// in the slashing protection history of the signer, if any.
+func (s *Service) sealBlindedBlock(
+	blk *ctypes.BlindedBeaconBlock, forkData *ctypes.ForkData,
+) error {
+	sealer, ok := s.signer.(BlockSealer)
+	if !ok {
+		return nil
+	}
+	signingRoot := ctypes.ComputeSigningRoot(
+		blk, forkData.ComputeDomain(s.chainSpec.DomainTypeProposer()),
+	)
+	return sealer.SealBlock(blk.Slot, signingRoot)
+}
+
+// revealBlock retrieves the payload of the signed blinded block from the
+// relay of the bid, returning the unblinded block and its sidecars.
+func (s *Service) revealBlock(
+	ctx context.Context,
+	bid *relay.Bid,
+	signedBlinded *ctypes.SignedBlindedBeaconBlock,
+) (*ctypes.SignedBeaconBlock, datypes.BlobSidecars, error) {
+	payload, blobsBundle, err := s.relays.GetPayload(ctx, bid, signedBlinded)
+	if err != nil {
+		return nil, nil, err
+	}
+	signedBlk, err := signedBlinded.Unblind(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	sidecars, err := s.blobFactory.BuildSidecars(signedBlk, blobsBundle)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signedBlk, sidecars, nil
+}
+
+// computeBlindedStateRoot computes the state root of an outgoing block built
+// with a payload standing in for the payload of the given header.
+//
+// The state transition only records the header of the payload and the root
+// of the block body, which are patched to those of the blinded block. The
+// state root thus matches the one computed by the other validators once the
+// payload is revealed.
+func (s *Service) computeBlindedStateRoot(
+	ctx context.Context,
+	proposerAddress []byte,
+	consensusTime math.U64,
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+	header *ctypes.ExecutionPayloadHeader,
+) (common.Root, error) {
+	startTime := time.Now()
+	defer s.metrics.measureStateRootComputationTime(startTime)
+
+	if err := s.processOutgoingBlock(ctx, proposerAddress, consensusTime, st, blk); err != nil {
+		return common.Root{}, err
+	}
+
+	if err := st.SetLatestExecutionPayloadHeader(header); err != nil {
+		return common.Root{}, err
+	}
+	latestHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return common.Root{}, err
+	}
+	latestHeader.SetBodyRoot(ctypes.NewBlindedBeaconBlock(blk, header).Body.HashTreeRoot())
+	if err = st.SetLatestBlockHeader(latestHeader); err != nil {
+		return common.Root{}, err
+	}
+
+	return st.HashTreeRoot(), nil
+}
+
+// registerWithRelays periodically registers this node with the relays of
+// external builders, until ctx is done.
+func (s *Service) registerWithRelays(ctx context.Context) {
+	ticker := time.NewTicker(relayRegistrationInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.registerWithRelaysOnce(ctx); err != nil {
+			s.logger.Warn("Failed registering with relays", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// registerWithRelaysOnce signs and submits the registration of this node to
+// the relays of external builders.
+func (s *Service) registerWithRelaysOnce(ctx context.Context) error {
+	reg, err := ctypes.NewSignedValidatorRegistration(
+		s.relays.NewRegistration(s.signer.PublicKey(), time.Now()),
+		s.chainSpec.GenesisForkVersion(),
+		s.chainSpec.DomainTypeApplicationMask(),
+		s.signer,
+	)
+	if err != nil {
+		return err
+	}
+	return s.relays.RegisterValidator(ctx, reg)
+}
+
+// bidEnvelope is the envelope of a payload standing in for the payload of a
+// bid, until it is revealed. The stand-in payload carries the fields of the
+// header of the bid, but no transactions.
+type bidEnvelope struct {
+	payload     *ctypes.ExecutionPayload
+	value       *math.U256
+	blobsBundle *engineprimitives.BlobsBundleV1
+	requests    []ctypes.EncodedExecutionRequest
+}
+
+// newBidEnvelope returns the envelope of a payload standing in for the
+// payload of bid, carrying the given withdrawals.
+func newBidEnvelope(
+	bid *relay.Bid, withdrawals []*engineprimitives.Withdrawal,
+) (*bidEnvelope, error) {
+	header := bid.Message.Header
+	env := &bidEnvelope{
+		payload: &ctypes.ExecutionPayload{
+			Versionable:   ctypes.NewVersionable(header.GetForkVersion()),
+			ParentHash:    header.ParentHash,
+			FeeRecipient:  header.FeeRecipient,
+			StateRoot:     header.StateRoot,
+			ReceiptsRoot:  header.ReceiptsRoot,
+			LogsBloom:     header.LogsBloom,
+			Random:        header.Random,
+			Number:        header.Number,
+			GasLimit:      header.GasLimit,
+			GasUsed:       header.GasUsed,
+			Timestamp:     header.Timestamp,
+			ExtraData:     header.ExtraData,
+			BaseFeePerGas: header.BaseFeePerGas,
+			BlockHash:     header.BlockHash,
+			Transactions:  engineprimitives.Transactions{},
+			Withdrawals:   withdrawals,
+			BlobGasUsed:   header.BlobGasUsed,
+			ExcessBlobGas: header.ExcessBlobGas,
+		},
+		value: bid.Message.Value,
+		blobsBundle: &engineprimitives.BlobsBundleV1{
+			Commitments: bid.Message.BlobKzgCommitments,
+			Proofs:      []eip4844.KZGProof{},
+			Blobs:       []*eip4844.Blob{},
+		},
+	}
+	if version.IsBefore(header.GetForkVersion(), version.Electra()) {
+		return env, nil
+	}
+
+	var err error
+	if env.requests, err = ctypes.GetExecutionRequestsList(bid.Message.ExecutionRequests); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// GetExecutionPayload returns the stand-in payload.
+func (e *bidEnvelope) GetExecutionPayload() *ctypes.ExecutionPayload {
+	return e.payload
+}
+
+// GetBlockValue returns the value of the bid.
+func (e *bidEnvelope) GetBlockValue() *math.U256 {
+	return e.value
+}
+
+// GetBlobsBundle returns a blobs bundle carrying only the commitments of the
+// bid.
+func (e *bidEnvelope) GetBlobsBundle() engineprimitives.BlobsBundle {
+	return e.blobsBundle
+}
+
+// GetEncodedExecutionRequests returns the execution requests of the bid.
+func (e *bidEnvelope) GetEncodedExecutionRequests() []ctypes.EncodedExecutionRequest {
+	return e.requests
+}
+
+// ShouldOverrideBuilder returns false.
+func (e *bidEnvelope) ShouldOverrideBuilder() bool {
+	return false
+}
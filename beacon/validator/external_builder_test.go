@@ -0,0 +1,554 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/validator"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/da/blob"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	blssigner "github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	"github.com/berachain/beacon-kit/storage/slashing"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPubkey      = crypto.BLSPubkey{0x01}
+	testGenesisHash = common.ExecutionHash{0x0a}
+)
+
+// TestBuildBlockWithBid checks that blocks built with the payload of a bid
+// pass the state transition of the other validators once unblinded, that the
+// local payload is proposed whenever the bid cannot be used, and that no block
+// is proposed once the signed blinded block has been submitted to the relay.
+func TestBuildBlockWithBid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		// modify modifies the bid and the relay before building the block.
+		modify func(env *testEnv)
+		// builder is whether the block carries the payload of the bid.
+		builder bool
+		// revealed is whether the payload of the bid is requested.
+		revealed bool
+		// wantErr is the error returned instead of a block.
+		wantErr error
+	}{
+		{
+			name:     "builder payload",
+			modify:   func(*testEnv) {},
+			builder:  true,
+			revealed: true,
+		},
+		{
+			name: "builder payload with blobs",
+			modify: func(env *testEnv) {
+				env.setBidBlobs(1)
+			},
+			builder:  true,
+			revealed: true,
+		},
+		{
+			name: "payload not revealed",
+			modify: func(env *testEnv) {
+				env.relays.revealErr = relay.ErrInvalidReveal
+			},
+			revealed: true,
+			wantErr:  validator.ErrBlindedBlockSubmitted,
+		},
+		{
+			name: "revealed payload does not match the bid",
+			modify: func(env *testEnv) {
+				env.relays.payload.GasUsed++
+			},
+			revealed: true,
+			wantErr:  validator.ErrBlindedBlockSubmitted,
+		},
+		{
+			name: "invalid bid",
+			modify: func(env *testEnv) {
+				env.relays.bid.Message.Header.ParentHash = common.ExecutionHash{0xff}
+			},
+		},
+		{
+			name: "bid outbid by local payload",
+			modify: func(env *testEnv) {
+				env.relays.bid.Message.Value = math.NewU256(0)
+			},
+		},
+		{
+			name: "no bid",
+			modify: func(env *testEnv) {
+				env.relays.bid = nil
+				env.relays.bidErr = relay.ErrNoBid
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			env := newTestEnv(t)
+			tt.modify(env)
+
+			blkBz, sidecarsBz, err := env.svc.BuildBlockAndSidecars(env.sdkCtx, env.slotData())
+			require.Equal(t, tt.revealed, env.relays.revealed)
+
+			// The submitted blinded block is the only block signed at the
+			// slot, even in later rounds.
+			gvr, errGVR := env.genesis.GetGenesisValidatorsRoot()
+			require.NoError(t, errGVR)
+			errLater := env.protection.CheckAndRecordBlock(
+				testPubkey, env.slot, common.Root{0xff}, gvr,
+			)
+			if tt.revealed {
+				require.ErrorIs(t, errLater, slashing.ErrSlashableBlock)
+			} else {
+				require.NoError(t, errLater)
+			}
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			blk, err := ctypes.NewEmptySignedBeaconBlockWithVersion(env.fork)
+			require.NoError(t, err)
+			require.NoError(t, ssz.Unmarshal(blkBz, blk))
+			want := env.local
+			if tt.builder {
+				want = env.relays.payload
+			}
+			payload := blk.GetBody().GetExecutionPayload()
+			require.Equal(t, want.GetBlockHash(), payload.GetBlockHash())
+			require.Equal(t, want.HashTreeRoot(), payload.HashTreeRoot())
+
+			var sidecars datypes.BlobSidecars
+			require.NoError(t, ssz.Unmarshal(sidecarsBz, &sidecars))
+			require.Len(t, sidecars, len(blk.GetBody().GetBlobKzgCommitments()))
+
+			// The state root of the block is the one computed by the other
+			// validators processing the block.
+			env.process(env.genesis.Copy(env.sdkCtx), blk)
+		})
+	}
+}
+
+func TestValidateBid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		modify func(env *testEnv, header *ctypes.ExecutionPayloadHeader)
+		err    error
+	}{
+		{
+			name:   "valid",
+			modify: func(*testEnv, *ctypes.ExecutionPayloadHeader) {},
+		},
+		{
+			name: "parent hash",
+			modify: func(_ *testEnv, header *ctypes.ExecutionPayloadHeader) {
+				header.ParentHash = common.ExecutionHash{0xff}
+			},
+			err: validator.ErrInvalidBuilderBid,
+		},
+		{
+			name: "timestamp too far in the future",
+			modify: func(_ *testEnv, header *ctypes.ExecutionPayloadHeader) {
+				header.Timestamp += 10
+			},
+			err: validator.ErrInvalidBuilderBid,
+		},
+		{
+			name: "fork version",
+			modify: func(env *testEnv, header *ctypes.ExecutionPayloadHeader) {
+				fork := version.Deneb()
+				if version.Equals(env.fork, fork) {
+					fork = version.Electra()
+				}
+				header.Versionable = ctypes.NewVersionable(fork)
+			},
+			err: validator.ErrInvalidBuilderBid,
+		},
+		{
+			name: "prev randao",
+			modify: func(_ *testEnv, header *ctypes.ExecutionPayloadHeader) {
+				header.Random = common.Bytes32{0xff}
+			},
+			err: validator.ErrInvalidBuilderBid,
+		},
+		{
+			name: "withdrawals root",
+			modify: func(_ *testEnv, header *ctypes.ExecutionPayloadHeader) {
+				header.WithdrawalsRoot = common.Root{0xff}
+			},
+			err: validator.ErrInvalidBuilderBid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			env := newTestEnv(t)
+			_, err := env.sp.ProcessSlots(env.st, env.slot)
+			require.NoError(t, err)
+			lph, err := env.st.GetLatestExecutionPayloadHeader()
+			require.NoError(t, err)
+
+			tt.modify(env, env.relays.bid.Message.Header)
+			err = env.svc.ValidateBid(
+				env.st, env.slotData(), lph, env.builder.envelope, env.relays.bid,
+			)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("too many blobs", func(t *testing.T) {
+		t.Parallel()
+		env := newTestEnv(t)
+		_, err := env.sp.ProcessSlots(env.st, env.slot)
+		require.NoError(t, err)
+		lph, err := env.st.GetLatestExecutionPayloadHeader()
+		require.NoError(t, err)
+
+		env.setBidBlobs(env.cs.MaxBlobsPerBlock() + 1)
+		err = env.svc.ValidateBid(
+			env.st, env.slotData(), lph, env.builder.envelope, env.relays.bid,
+		)
+		require.ErrorIs(t, err, validator.ErrInvalidBuilderBid)
+	})
+}
+
+// testEnv is a validator proposing the block following genesis, with a local
+// payload and a bid for a more valuable payload.
+type testEnv struct {
+	t  *testing.T
+	cs chain.Spec
+	sp *statetransition.TestStateProcessorT
+	// genesis is the genesis state, which st is a copy of.
+	genesis *statedb.StateDB
+	// st is the state of the validator.
+	st     *statedb.StateDB
+	sdkCtx sdk.Context
+	svc    *validator.Service
+	fork   common.Version
+	slot   math.Slot
+	time   math.U64
+
+	// local is the payload of the local builder.
+	local   *ctypes.ExecutionPayload
+	builder *localBuilder
+	relays  *fakeRelays
+	// protection is the slashing protection history of the signer.
+	protection *slashing.Store
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	sp, st, depStore, ctx, _, _ := statetransition.SetupTestState(t, cs)
+	sdkCtx, ok := ctx.ConsensusCtx().(sdk.Context)
+	require.True(t, ok)
+
+	genesisTime := math.U64(time.Now().Unix())
+	fork := cs.ActiveForkVersionForTimestamp(genesisTime)
+	deposits := ctypes.Deposits{{
+		Pubkey:      testPubkey,
+		Credentials: ctypes.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x01}),
+		Amount:      cs.MaxEffectiveBalance(),
+		Index:       0,
+	}}
+	genesisHeader := &ctypes.ExecutionPayloadHeader{
+		Versionable:   ctypes.NewVersionable(fork),
+		Timestamp:     genesisTime,
+		BlockHash:     testGenesisHash,
+		BaseFeePerGas: math.NewU256(0),
+	}
+	_, err = sp.InitializeBeaconStateFromEth1(st, deposits, genesisHeader, fork)
+	require.NoError(t, err)
+	require.NoError(t, depStore.EnqueueDeposits(sdkCtx, deposits))
+
+	env := &testEnv{
+		t:       t,
+		cs:      cs,
+		sp:      sp,
+		genesis: st,
+		st:      st.Copy(sdkCtx),
+		sdkCtx:  sdkCtx,
+		fork:    fork,
+		slot:    1,
+		time:    genesisTime + 1,
+	}
+	env.local = env.newPayload(common.ExecutionHash{0x0b})
+	requests, err := ctypes.GetExecutionRequestsList(&ctypes.ExecutionRequests{})
+	require.NoError(t, err)
+	env.builder = &localBuilder{envelope: &envelope{
+		payload:     env.local,
+		value:       math.NewU256(1),
+		blobsBundle: &engineprimitives.BlobsBundleV1{},
+		requests:    requests,
+	}}
+
+	bidPayload := env.newPayload(common.ExecutionHash{0x0c})
+	bidPayload.FeeRecipient = common.ExecutionAddress{0xbb}
+	bidPayload.Transactions = engineprimitives.Transactions{{0x01}}
+	env.relays = &fakeRelays{payload: bidPayload, blobsBundle: &engineprimitives.BlobsBundleV1{}}
+	env.setBid()
+	env.protection = slashing.NewStore(dbm.NewMemDB())
+
+	env.svc = validator.NewService(
+		&validator.Config{},
+		noop.NewLogger[any](),
+		cs,
+		&storageBackend{st: env.st, depStore: depStore},
+		depositVerifier{},
+		sp,
+		blssigner.NewProtectedSigner(signer{}, env.protection),
+		blob.NewSidecarFactory(metrics.NewNoOpTelemetrySink()),
+		env.builder,
+		env.relays,
+		proposers{},
+		metrics.NewNoOpTelemetrySink(),
+	)
+	return env
+}
+
+// newPayload returns a payload for the block following genesis.
+func (e *testEnv) newPayload(blockHash common.ExecutionHash) *ctypes.ExecutionPayload {
+	e.t.Helper()
+	st := e.genesis.Copy(e.sdkCtx)
+	_, err := e.sp.ProcessSlots(st, e.slot)
+	require.NoError(e.t, err)
+	withdrawals, _, err := st.ExpectedWithdrawals(e.time)
+	require.NoError(e.t, err)
+	prevRandao, err := st.GetRandaoMixAtIndex(
+		e.cs.SlotToEpoch(e.slot).Unwrap() % e.cs.EpochsPerHistoricalVector(),
+	)
+	require.NoError(e.t, err)
+
+	payload := &ctypes.ExecutionPayload{
+		Versionable:   ctypes.NewVersionable(e.fork),
+		ParentHash:    testGenesisHash,
+		Random:        prevRandao,
+		Number:        1,
+		GasLimit:      30_000_000,
+		Timestamp:     e.time,
+		ExtraData:     []byte{},
+		BaseFeePerGas: math.NewU256(1),
+		BlockHash:     blockHash,
+		Transactions:  engineprimitives.Transactions{},
+		Withdrawals:   withdrawals,
+	}
+	return payload
+}
+
+// setBid sets the bid of the relays for their payload.
+func (e *testEnv) setBid() {
+	e.t.Helper()
+	header, err := e.relays.payload.ToHeader()
+	require.NoError(e.t, err)
+	e.relays.bid = relay.NewBid(&ctypes.SignedBuilderBid{
+		Message: &ctypes.BuilderBid{
+			Versionable:        ctypes.NewVersionable(e.fork),
+			Header:             header,
+			BlobKzgCommitments: e.relays.blobsBundle.Commitments,
+			ExecutionRequests:  &ctypes.ExecutionRequests{},
+			Value:              math.NewU256(100),
+			Pubkey:             crypto.BLSPubkey{0xbb},
+		},
+	}, e.relayClient())
+}
+
+// setBidBlobs sets n blobs in the payload of the relays and in their bid.
+func (e *testEnv) setBidBlobs(n uint64) {
+	e.t.Helper()
+	bundle := &engineprimitives.BlobsBundleV1{
+		Commitments: make([]eip4844.KZGCommitment, n),
+		Proofs:      make([]eip4844.KZGProof, n),
+		Blobs:       make([]*eip4844.Blob, n),
+	}
+	for i := range n {
+		bundle.Commitments[i] = eip4844.KZGCommitment{byte(i + 1)}
+		bundle.Blobs[i] = &eip4844.Blob{}
+	}
+	e.relays.blobsBundle = bundle
+	e.setBid()
+}
+
+func (e *testEnv) relayClient() *relay.Client {
+	e.t.Helper()
+	client, err := relay.NewClient("http://relay.test", e.cs)
+	require.NoError(e.t, err)
+	return client
+}
+
+func (e *testEnv) slotData() *types.SlotData {
+	return types.NewSlotData(
+		e.slot, nil, nil, statetransition.DummyProposerAddr, time.Unix(int64(e.time.Unwrap()), 0),
+	)
+}
+
+// process applies blk to st as the other validators do, checking the state
+// root of the block.
+func (e *testEnv) process(st *statedb.StateDB, blk *ctypes.SignedBeaconBlock) {
+	e.t.Helper()
+	_, err := e.sp.ProcessSlots(st, e.slot)
+	require.NoError(e.t, err)
+	txCtx := transition.NewTransitionCtx(e.sdkCtx, e.time, statetransition.DummyProposerAddr).
+		WithVerifyPayload(false).
+		WithVerifyRandao(false).
+		WithVerifyResult(true).
+		WithMeterGas(false)
+	_, err = e.sp.Transition(txCtx, st, blk.GetBeaconBlock())
+	require.NoError(e.t, err)
+}
+
+// envelope is the envelope of a local payload.
+type envelope struct {
+	payload     *ctypes.ExecutionPayload
+	value       *math.U256
+	blobsBundle *engineprimitives.BlobsBundleV1
+	requests    []ctypes.EncodedExecutionRequest
+}
+
+func (e *envelope) GetExecutionPayload() *ctypes.ExecutionPayload { return e.payload }
+
+func (e *envelope) GetBlockValue() *math.U256 { return e.value }
+
+func (e *envelope) GetBlobsBundle() engineprimitives.BlobsBundle { return e.blobsBundle }
+
+func (e *envelope) GetEncodedExecutionRequests() []ctypes.EncodedExecutionRequest {
+	return e.requests
+}
+
+func (e *envelope) ShouldOverrideBuilder() bool { return false }
+
+// localBuilder is a local builder serving a fixed payload.
+type localBuilder struct {
+	envelope *envelope
+}
+
+func (b *localBuilder) Enabled() bool { return true }
+
+func (b *localBuilder) RetrievePayload(
+	context.Context, math.Slot, common.Root,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	return b.envelope, nil
+}
+
+func (b *localBuilder) RequestPayloadSync(
+	context.Context, *builder.RequestPayloadData,
+) (ctypes.BuiltExecutionPayloadEnv, error) {
+	return nil, errors.New("unexpected payload request")
+}
+
+// fakeRelays serve a fixed bid and reveal its payload.
+type fakeRelays struct {
+	bid         *relay.Bid
+	bidErr      error
+	payload     *ctypes.ExecutionPayload
+	blobsBundle *engineprimitives.BlobsBundleV1
+	revealErr   error
+	revealed    bool
+}
+
+func (r *fakeRelays) Enabled() bool { return true }
+
+func (r *fakeRelays) GetBid(
+	context.Context, math.Slot, common.ExecutionHash, crypto.BLSPubkey,
+) (*relay.Bid, error) {
+	return r.bid, r.bidErr
+}
+
+func (r *fakeRelays) Outbids(bid *relay.Bid, localValue *math.U256) bool {
+	return bid.Message.Value.Gt(localValue)
+}
+
+func (r *fakeRelays) GetPayload(
+	context.Context, *relay.Bid, *ctypes.SignedBlindedBeaconBlock,
+) (*ctypes.ExecutionPayload, engineprimitives.BlobsBundle, error) {
+	r.revealed = true
+	if r.revealErr != nil {
+		return nil, nil, r.revealErr
+	}
+	return r.payload, r.blobsBundle, nil
+}
+
+func (r *fakeRelays) NewRegistration(crypto.BLSPubkey, time.Time) *ctypes.ValidatorRegistration {
+	return nil
+}
+
+func (r *fakeRelays) RegisterValidator(context.Context, *ctypes.SignedValidatorRegistration) error {
+	return nil
+}
+
+type storageBackend struct {
+	st       *statedb.StateDB
+	depStore deposit.StoreManager
+}
+
+func (b *storageBackend) DepositStore() deposit.StoreManager { return b.depStore }
+
+func (b *storageBackend) StateFromContext(context.Context) *statedb.StateDB { return b.st }
+
+type depositVerifier struct{}
+
+func (depositVerifier) VerifyDeposits(context.Context, uint64, uint64) error { return nil }
+
+type proposers struct{}
+
+func (proposers) Graffiti(crypto.BLSPubkey) string { return "" }
+
+// signer signs with empty signatures, which are not verified by the tests.
+type signer struct{}
+
+func (signer) PublicKey() crypto.BLSPubkey { return testPubkey }
+
+func (signer) Sign([]byte) (crypto.BLSSignature, error) { return crypto.BLSSignature{}, nil }
+
+func (signer) VerifySignature(crypto.BLSPubkey, []byte, crypto.BLSSignature) error { return nil }
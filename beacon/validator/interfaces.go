@@ -29,7 +29,9 @@ import (
 	datypes "github.com/berachain/beacon-kit/da/types"
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/state-transition/core"
@@ -66,6 +68,53 @@ type PayloadBuilder interface {
 	) (ctypes.BuiltExecutionPayloadEnv, error)
 }
 
+// Relays requests execution payloads from external block builders.
+type Relays interface {
+	// Enabled returns whether any relay is configured.
+	Enabled() bool
+	// GetBid requests the most valuable bid for the execution payload of the
+	// given slot, built on top of parentHash.
+	GetBid(
+		ctx context.Context,
+		slot math.Slot,
+		parentHash common.ExecutionHash,
+		pubkey crypto.BLSPubkey,
+	) (*relay.Bid, error)
+	// Outbids returns whether the bid is more valuable than a local payload
+	// of the given value.
+	Outbids(bid *relay.Bid, localValue *math.U256) bool
+	// GetPayload reveals the execution payload of the bid the given signed
+	// blinded block was built with.
+	GetPayload(
+		ctx context.Context,
+		bid *relay.Bid,
+		blk *ctypes.SignedBlindedBeaconBlock,
+	) (*ctypes.ExecutionPayload, engineprimitives.BlobsBundle, error)
+	// NewRegistration returns the registration of the validator with the
+	// given pubkey, made at timestamp.
+	NewRegistration(
+		pubkey crypto.BLSPubkey, timestamp time.Time,
+	) *ctypes.ValidatorRegistration
+	// RegisterValidator registers the validator with all relays.
+	RegisterValidator(
+		ctx context.Context, reg *ctypes.SignedValidatorRegistration,
+	) error
+}
+
+// Proposers provides the settings of the validators proposing blocks.
+type Proposers interface {
+	// Graffiti returns the graffiti of the validator with the given pubkey.
+	Graffiti(pubkey crypto.BLSPubkey) string
+}
+
+// BlockSealer is implemented by signers keeping a slashing protection
+// history, which may make a signed block the only block signed at its slot.
+type BlockSealer interface {
+	// SealBlock makes the block with the given signing root signed at slot
+	// the only block signed at slot.
+	SealBlock(slot math.Slot, signingRoot common.Root) error
+}
+
 // StateProcessor defines the interface for processing the state.
 type StateProcessor interface {
 	// ProcessFork prepares the state for the fork version at the given timestamp.
@@ -92,6 +141,13 @@ type StorageBackend interface {
 	StateFromContext(context.Context) *statedb.StateDB
 }
 
+// DepositVerifier re-validates stored deposits against the canonical EL chain.
+type DepositVerifier interface {
+	// VerifyDeposits checks that the deposits [startIndex, startIndex+count)
+	// were emitted by canonical EL blocks, rolling back any that were not.
+	VerifyDeposits(ctx context.Context, startIndex uint64, count uint64) error
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments a counter metric identified by the provided
@@ -117,6 +173,9 @@ type ChainSpec interface {
 	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
 	SlotToEpoch(slot math.Slot) math.Epoch
 	EpochsPerHistoricalVector() uint64
+	MaxBlobsPerBlock() uint64
+	GenesisForkVersion() common.Version
+	DomainTypeApplicationMask() common.DomainType
 
 	ctypes.ProposerDomain
 }
@@ -74,3 +74,28 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// proposedBuilderPayload increments the counter for the number of blocks
+// proposed with the execution payload of an external builder.
+func (cm *validatorMetrics) proposedBuilderPayload(relay string) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.proposed_builder_payload",
+		"relay",
+		relay,
+	)
+}
+
+// failedToUseBuilderPayload increments the counter for the number of
+// times the validator fell back to the local payload after accepting the bid
+// of an external builder.
+func (cm *validatorMetrics) failedToUseBuilderPayload(
+	relay string, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.failed_to_use_builder_payload",
+		"relay",
+		relay,
+		"error",
+		err.Error(),
+	)
+}
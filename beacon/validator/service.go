@@ -22,6 +22,7 @@ package validator
 
 import (
 	"context"
+	"io"
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -35,12 +36,14 @@ type Service struct {
 	logger log.Logger
 	// chainSpec is the chain spec.
 	chainSpec ChainSpec
-	// signer is used to retrieve the public key of this node.
+	// signer is used to sign blocks and retrieve the public key of this node.
 	signer crypto.BLSSigner
 	// blobFactory is used to create blob sidecars for blocks.
 	blobFactory BlobFactory
 	// sb is the beacon state backend.
 	sb StorageBackend
+	// depositVerifier re-validates deposits before they are included.
+	depositVerifier DepositVerifier
 	// stateProcessor is responsible for processing the state.
 	stateProcessor StateProcessor
 	// localPayloadBuilder represents the local block builder, this builder
@@ -48,6 +51,11 @@ type Service struct {
 	// Building blocks are done by submitting forkchoice updates through.
 	// The local Builder.
 	localPayloadBuilder PayloadBuilder
+	// relays request execution payloads from external block builders, which
+	// are proposed in place of the local payload when more valuable.
+	relays Relays
+	// proposers provides the graffiti of the blocks.
+	proposers Proposers
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 }
@@ -58,21 +66,27 @@ func NewService(
 	logger log.Logger,
 	chainSpec ChainSpec,
 	sb StorageBackend,
+	depositVerifier DepositVerifier,
 	stateProcessor StateProcessor,
 	signer crypto.BLSSigner,
 	blobFactory BlobFactory,
 	localPayloadBuilder PayloadBuilder,
+	relays Relays,
+	proposers Proposers,
 	ts TelemetrySink,
 ) *Service {
 	return &Service{
 		cfg:                 cfg,
 		logger:              logger,
 		sb:                  sb,
+		depositVerifier:     depositVerifier,
 		chainSpec:           chainSpec,
 		signer:              signer,
 		stateProcessor:      stateProcessor,
 		blobFactory:         blobFactory,
 		localPayloadBuilder: localPayloadBuilder,
+		relays:              relays,
+		proposers:           proposers,
 		metrics:             newValidatorMetrics(ts),
 	}
 }
@@ -82,12 +96,22 @@ func (s *Service) Name() string {
 	return "validator"
 }
 
+// Start registers this node with the relays of external block builders, if
+// it builds blocks and any relay is configured.
 func (s *Service) Start(
-	_ context.Context,
+	ctx context.Context,
 ) error {
+	if s.localPayloadBuilder.Enabled() && s.relays.Enabled() {
+		go s.registerWithRelays(ctx)
+	}
 	return nil
 }
 
+// Stop releases the resources held by the signer, such as its slashing
+// protection database.
 func (s *Service) Stop() error {
+	if closer, ok := s.signer.(io.Closer); ok {
+		return closer.Close()
+	}
 	return nil
 }
@@ -96,12 +96,9 @@ type SpecData struct {
 	//
 	// GenesisTime is the time at which the genesis block was created.
 	GenesisTime uint64 `mapstructure:"genesis-time"`
-	// Deneb1ForkTime is the time at which the Deneb1 fork is activated.
-	Deneb1ForkTime uint64 `mapstructure:"deneb-one-fork-time"`
-	// ElectraForkTime is the time at which the Electra fork is activated.
-	ElectraForkTime uint64 `mapstructure:"electra-fork-time"`
-	// Electra1ForkTime is the time at which the Electra1 fork is activated.
-	Electra1ForkTime uint64 `mapstructure:"electra-one-fork-time"`
+	// Forks is the fork schedule of the chain, ordered by activation time. The
+	// first fork is the one active at genesis.
+	Forks []Fork `mapstructure:"forks"`
 
 	// State list lengths
 	//
@@ -154,15 +151,6 @@ type SpecData struct {
 	// minted to the EVMInflationAddress via a withdrawal every block.
 	EVMInflationPerBlockGenesis uint64 `mapstructure:"evm-inflation-per-block"`
 
-	// Deneb1 Value Changes
-	//
-	// EVMInflationAddressDeneb1 is the address on the EVM which will receive the
-	// inflation amount of native EVM balance through a withdrawal every block in the Deneb1 fork.
-	EVMInflationAddressDeneb1 common.ExecutionAddress `mapstructure:"evm-inflation-address-deneb-one"`
-	// EVMInflationPerBlockDeneb1 is the amount of native EVM balance (in Gwei) to be
-	// minted to the EVMInflationAddressDeneb1 via a withdrawal every block in the Deneb1 fork.
-	EVMInflationPerBlockDeneb1 uint64 `mapstructure:"evm-inflation-per-block-deneb-one"`
-
 	// Electra Values
 	//
 	// MinActivationBalance [New in Electra:EIP7251] Minimum balance for a validator to become active
@@ -35,4 +35,8 @@ var (
 	ErrInvalidValidatorSetCap = errors.New(
 		"validator set cap must be less than the validator registry limit",
 	)
+
+	// ErrEmptyForkSchedule is returned when the fork schedule has no fork
+	// active at genesis.
+	ErrEmptyForkSchedule = errors.New("fork schedule must not be empty")
 )
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package chain
+
+import (
+	"fmt"
+	stdmath "math"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// Fork is an entry of the fork schedule of a chain. It activates a fork
+// version at a given time, along with the chain parameters that change with it.
+type Fork struct {
+	// Version is the fork version activated by the fork.
+	Version common.Version `mapstructure:"version"`
+	// Time is the time at which the fork is activated.
+	Time uint64 `mapstructure:"time"`
+
+	// Parameter overrides. A parameter left unset keeps its value from the
+	// previous fork, or its genesis value.
+	//
+	// EVMInflationAddress is the address on the EVM which will receive the
+	// inflation amount of native EVM balance through a withdrawal every block.
+	EVMInflationAddress *common.ExecutionAddress `mapstructure:"evm-inflation-address"`
+	// EVMInflationPerBlock is the amount of native EVM balance (in Gwei) to be
+	// minted to the EVMInflationAddress via a withdrawal every block.
+	EVMInflationPerBlock *uint64 `mapstructure:"evm-inflation-per-block"`
+}
+
+// validateForks ensures that the fork schedule starts with the fork active at
+// genesis, that forks are ordered by both version and time and that every
+// scheduled version is supported. Like most chains, BeaconKit does not support
+// arbitrary ordering of forks.
+func (s spec) validateForks() error {
+	forks := s.Data.Forks
+	if len(forks) == 0 {
+		return ErrEmptyForkSchedule
+	}
+	for _, fork := range forks {
+		if !version.IsSupported(fork.Version) {
+			return fmt.Errorf(
+				"fork schedule violation: version %s (%s) is not supported",
+				version.Name(fork.Version), fork.Version,
+			)
+		}
+	}
+	if forks[0].Time > s.Data.GenesisTime {
+		return fmt.Errorf(
+			"fork ordering violation: first fork %s at %d is activated after genesis at %d",
+			version.Name(forks[0].Version), forks[0].Time, s.Data.GenesisTime,
+		)
+	}
+
+	prevName, prevTime := "genesis", s.Data.GenesisTime
+	for i := 1; i < len(forks); i++ {
+		prev, cur := forks[i-1], forks[i]
+		// must not go backwards
+		if !version.IsBefore(prev.Version, cur.Version) {
+			return fmt.Errorf(
+				"fork ordering violation: version of %s (%s) is not before %s (%s)",
+				version.Name(prev.Version), prev.Version, version.Name(cur.Version), cur.Version,
+			)
+		}
+		if prevTime > cur.Time {
+			return fmt.Errorf(
+				"fork ordering violation: time of %s (%d) > %s (%d)",
+				prevName, prevTime, version.Name(cur.Version), cur.Time,
+			)
+		}
+		prevName, prevTime = version.Name(cur.Version), cur.Time
+	}
+	return nil
+}
+
+// activeForks returns the forks of the schedule activated by the given
+// timestamp. The first fork is always considered active.
+func (s spec) activeForks(timestamp math.U64) []Fork {
+	n := 1
+	for n < len(s.Data.Forks) && s.Data.Forks[n].Time <= timestamp.Unwrap() {
+		n++
+	}
+	return s.Data.Forks[:n]
+}
+
+// ForkSchedule returns the forks of the chain, ordered by activation time.
+func (s spec) ForkSchedule() []Fork {
+	return s.Data.Forks
+}
+
+// ForkTime returns the time at which the given fork version is activated, or
+// the max uint64 if the fork version is not scheduled.
+func (s spec) ForkTime(forkVersion common.Version) uint64 {
+	if fork := s.Data.Fork(forkVersion); fork != nil {
+		return fork.Time
+	}
+	return stdmath.MaxUint64
+}
+
+// Fork returns the entry of the given fork version in the fork schedule, or nil
+// if the fork version is not scheduled.
+func (d *SpecData) Fork(forkVersion common.Version) *Fork {
+	for i := range d.Forks {
+		if version.Equals(d.Forks[i].Version, forkVersion) {
+			return &d.Forks[i]
+		}
+	}
+	return nil
+}
@@ -23,22 +23,12 @@ package chain
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
-	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // ActiveForkVersionForTimestamp returns the active fork version for a given timestamp.
 func (s spec) ActiveForkVersionForTimestamp(timestamp math.U64) common.Version {
-	time := timestamp.Unwrap()
-	if time >= s.Electra1ForkTime() {
-		return version.Electra1()
-	}
-	if time >= s.ElectraForkTime() {
-		return version.Electra()
-	}
-	if time >= s.Deneb1ForkTime() {
-		return version.Deneb1()
-	}
-	return version.Deneb()
+	forks := s.activeForks(timestamp)
+	return forks[len(forks)-1].Version
 }
 
 // GenesisForkVersion returns the fork version at genesis.
@@ -35,9 +35,12 @@ import (
 // Create an instance of chainSpec with test data.
 var spec, _ = chain.NewSpec(
 	&chain.SpecData{
-		Deneb1ForkTime:                   9 * 32 * 2,
-		ElectraForkTime:                  10 * 32 * 2,
-		Electra1ForkTime:                 11 * 32 * 2,
+		Forks: []chain.Fork{
+			{Version: version.Deneb(), Time: 0},
+			{Version: version.Deneb1(), Time: 9 * 32 * 2},
+			{Version: version.Electra(), Time: 10 * 32 * 2},
+			{Version: version.Electra1(), Time: 11 * 32 * 2},
+		},
 		SlotsPerEpoch:                    32,
 		MinEpochsForBlobsSidecarsRequest: 5,
 		MaxWithdrawalsPerPayload:         2,
@@ -53,9 +56,11 @@ func TestActiveForkVersionForTimestamp(t *testing.T) {
 		timestamp uint64
 		expected  common.Version
 	}{
-		{name: "Before Electra Fork", timestamp: spec.ElectraForkTime() - 1, expected: version.Deneb1()},
-		{name: "At Electra Fork", timestamp: spec.ElectraForkTime(), expected: version.Electra()},
-		{name: "After Electra Fork", timestamp: spec.ElectraForkTime() + 1, expected: version.Electra()},
+		{name: "At Genesis", timestamp: 0, expected: version.Deneb()},
+		{name: "Before Electra Fork", timestamp: spec.ForkTime(version.Electra()) - 1, expected: version.Deneb1()},
+		{name: "At Electra Fork", timestamp: spec.ForkTime(version.Electra()), expected: version.Electra()},
+		{name: "After Electra Fork", timestamp: spec.ForkTime(version.Electra()) + 1, expected: version.Electra()},
+		{name: "At Electra1 Fork", timestamp: spec.ForkTime(version.Electra1()), expected: version.Electra1()},
 	}
 
 	// Run test cases
@@ -28,7 +28,6 @@ import (
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
-	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 type BalancesSpec interface {
@@ -101,14 +100,12 @@ type ForkSpec interface {
 	// GenesisTime returns the time at which the genesis block was created.
 	GenesisTime() uint64
 
-	// Deneb1ForkTime returns the time at which the Deneb1 fork takes effect.
-	Deneb1ForkTime() uint64
+	// ForkSchedule returns the forks of the chain, ordered by activation time.
+	ForkSchedule() []Fork
 
-	// ElectraForkTime returns the time at which the Electra fork takes effect.
-	ElectraForkTime() uint64
-
-	// Electra1ForkTime returns the time at which the Electra1 fork takes effect.
-	Electra1ForkTime() uint64
+	// ForkTime returns the time at which the given fork version takes effect,
+	// or the max uint64 if the fork version is not scheduled.
+	ForkTime(forkVersion common.Version) uint64
 }
 
 type BlobSpec interface {
@@ -262,23 +259,8 @@ func (s spec) validate() error {
 
 	// EVM Inflation values can be zero or non-zero, no validation needed.
 
-	// Enforce ordering of the forks. Like most chains, BeaconKit does not support arbitrary ordering of forks.
-	// Fork times here are in chronological order
-	orderedForkTimes := []uint64{
-		s.Data.GenesisTime,
-		s.Data.Deneb1ForkTime,
-		s.Data.ElectraForkTime,
-		s.Data.Electra1ForkTime,
-	}
-	for i := 1; i < len(orderedForkTimes); i++ {
-		prev, cur := orderedForkTimes[i-1], orderedForkTimes[i]
-		// must not go backwards
-		if prev > cur {
-			return fmt.Errorf(
-				"fork ordering violation: timestamp at index %d (%d) > index %d (%d)",
-				i-1, prev, i, cur,
-			)
-		}
+	if err := s.validateForks(); err != nil {
+		return err
 	}
 
 	if s.Data.ConsensusUpdateHeight != 0 {
@@ -432,21 +414,6 @@ func (s spec) GenesisTime() uint64 {
 	return s.Data.GenesisTime
 }
 
-// Deneb1ForkTime returns the timestamp of the Deneb1 fork.
-func (s spec) Deneb1ForkTime() uint64 {
-	return s.Data.Deneb1ForkTime
-}
-
-// ElectraForkTime returns the timestamp of the Electra fork.
-func (s spec) ElectraForkTime() uint64 {
-	return s.Data.ElectraForkTime
-}
-
-// Electra1ForkTime returns the epoch of the Electra1 fork.
-func (s spec) Electra1ForkTime() uint64 {
-	return s.Data.Electra1ForkTime
-}
-
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (s spec) EpochsPerHistoricalVector() uint64 {
 	return s.Data.EpochsPerHistoricalVector
@@ -517,27 +484,23 @@ func (s spec) ValidatorSetCap() uint64 {
 // EVMInflationAddress returns the address on the EVM which will receive the
 // inflation amount of native EVM balance through a withdrawal every block.
 func (s spec) EVMInflationAddress(timestamp math.U64) common.ExecutionAddress {
-	fv := s.ActiveForkVersionForTimestamp(timestamp)
-	switch fv {
-	case version.Deneb1(), version.Electra(), version.Electra1():
-		return s.Data.EVMInflationAddressDeneb1
-	case version.Deneb():
-		return s.Data.EVMInflationAddressGenesis
-	default:
-		panic(fmt.Sprintf("EVMInflationAddress not supported for this fork version: %d", fv))
+	address := s.Data.EVMInflationAddressGenesis
+	for _, fork := range s.activeForks(timestamp) {
+		if fork.EVMInflationAddress != nil {
+			address = *fork.EVMInflationAddress
+		}
 	}
+	return address
 }
 
 // EVMInflationPerBlock returns the amount of native EVM balance (in Gwei) to
 // be minted to the EVMInflationAddress via a withdrawal every block.
 func (s spec) EVMInflationPerBlock(timestamp math.U64) math.Gwei {
-	fv := s.ActiveForkVersionForTimestamp(timestamp)
-	switch fv {
-	case version.Deneb1(), version.Electra(), version.Electra1():
-		return math.Gwei(s.Data.EVMInflationPerBlockDeneb1)
-	case version.Deneb():
-		return math.Gwei(s.Data.EVMInflationPerBlockGenesis)
-	default:
-		panic(fmt.Sprintf("EVMInflationPerBlock not supported for this fork version: %d", fv))
+	perBlock := s.Data.EVMInflationPerBlockGenesis
+	for _, fork := range s.activeForks(timestamp) {
+		if fork.EVMInflationPerBlock != nil {
+			perBlock = *fork.EVMInflationPerBlock
+		}
 	}
+	return math.Gwei(perBlock)
 }
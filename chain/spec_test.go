@@ -21,9 +21,13 @@
 package chain_test
 
 import (
+	stdmath "math"
 	"testing"
 
 	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,16 +37,28 @@ func baseSpecData() *chain.SpecData {
 		MaxWithdrawalsPerPayload: 2,
 		ValidatorSetCap:          100,
 		ValidatorRegistryLimit:   100,
+		Forks:                    forkSchedule(0, 0, 0, 0),
 	}
 }
 
+// forkSchedule returns a schedule of the Deneb, Deneb1, Electra and Electra1
+// forks, activated at the given times.
+func forkSchedule(times ...uint64) []chain.Fork {
+	versions := []common.Version{
+		version.Deneb(), version.Deneb1(), version.Electra(), version.Electra1(),
+	}
+	forks := make([]chain.Fork, len(times))
+	for i, time := range times {
+		forks[i] = chain.Fork{Version: versions[i], Time: time}
+	}
+	return forks
+}
+
 func TestValidate_ForkOrder_Success(t *testing.T) {
 	t.Parallel()
 	data := baseSpecData()
 	data.GenesisTime = 10
-	data.Deneb1ForkTime = 20
-	data.ElectraForkTime = 30
-	data.Electra1ForkTime = 40
+	data.Forks = forkSchedule(0, 20, 30, 40)
 
 	_, err := chain.NewSpec(data)
 	require.NoError(t, err)
@@ -52,36 +68,124 @@ func TestValidate_ForkOrder_GenesisAfterDeneb(t *testing.T) {
 	t.Parallel()
 	data := baseSpecData()
 	data.GenesisTime = 50
-	data.Deneb1ForkTime = 20
-	data.ElectraForkTime = 60
-	data.Electra1ForkTime = 70
+	data.Forks = forkSchedule(0, 20, 60, 70)
 
 	_, err := chain.NewSpec(data)
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "timestamp at index 0 (50) > index 1 (20)")
+	require.Contains(t, err.Error(), "time of genesis (50) > deneb1 (20)")
 }
 
 func TestValidate_ForkOrder_DenebAfterElectra(t *testing.T) {
 	t.Parallel()
 	data := baseSpecData()
 	data.GenesisTime = 10
-	data.Deneb1ForkTime = 80
-	data.ElectraForkTime = 40
-	data.Electra1ForkTime = 50
+	data.Forks = forkSchedule(0, 80, 40, 50)
 
 	_, err := chain.NewSpec(data)
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "timestamp at index 1 (80) > index 2 (40)")
+	require.Contains(t, err.Error(), "time of deneb1 (80) > electra (40)")
 }
 
 func TestValidate_ForkOrder_AllForksAtGenesis(t *testing.T) {
 	t.Parallel()
 	data := baseSpecData()
 	data.GenesisTime = 0
-	data.Deneb1ForkTime = 0
-	data.ElectraForkTime = 0
-	data.Electra1ForkTime = 0
+	data.Forks = forkSchedule(0, 0, 0, 0)
+
+	spec, err := chain.NewSpec(data)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra1(), spec.GenesisForkVersion())
+}
+
+func TestValidate_ForkOrder_FirstForkAfterGenesis(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.GenesisTime = 10
+	data.Forks = forkSchedule(20, 30)
+
+	_, err := chain.NewSpec(data)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "first fork deneb at 20 is activated after genesis at 10")
+}
+
+func TestValidate_ForkOrder_Versions(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.Forks = forkSchedule(0, 10, 20)
+	data.Forks[1], data.Forks[2] = data.Forks[2], data.Forks[1]
 
 	_, err := chain.NewSpec(data)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "version of electra (0x05000000) is not before deneb1 (0x04010000)")
+
+	data.Forks = []chain.Fork{{Version: version.Deneb()}, {Version: version.Deneb()}}
+	_, err = chain.NewSpec(data)
+	require.Error(t, err)
+}
+
+func TestValidate_UnsupportedForkVersion(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.Forks = append(forkSchedule(0, 10, 20, 30), chain.Fork{Version: version.Electra2(), Time: 40})
+
+	_, err := chain.NewSpec(data)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not supported")
+}
+
+func TestValidate_EmptyForkSchedule(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.Forks = nil
+
+	_, err := chain.NewSpec(data)
+	require.ErrorIs(t, err, chain.ErrEmptyForkSchedule)
+}
+
+func TestForkSchedule(t *testing.T) {
+	t.Parallel()
+	data := baseSpecData()
+	data.Forks = forkSchedule(0, 20, 30)
+	spec, err := chain.NewSpec(data)
 	require.NoError(t, err)
+
+	require.Equal(t, data.Forks, spec.ForkSchedule())
+	require.Equal(t, uint64(20), spec.ForkTime(version.Deneb1()))
+	require.Equal(t, uint64(stdmath.MaxUint64), spec.ForkTime(version.Electra1()))
+	require.Equal(t, version.Electra(), spec.ActiveForkVersionForTimestamp(math.U64(stdmath.MaxUint64)))
+}
+
+func TestEVMInflationOverrides(t *testing.T) {
+	t.Parallel()
+	var (
+		genesisAddress  = common.ExecutionAddress{0x01}
+		deneb1Address   = common.ExecutionAddress{0x02}
+		deneb1PerBlock  = uint64(5)
+		electra1Address = common.ExecutionAddress{0x03}
+	)
+	data := baseSpecData()
+	data.EVMInflationAddressGenesis = genesisAddress
+	data.EVMInflationPerBlockGenesis = 1
+	data.Forks = forkSchedule(0, 20, 30, 40)
+	data.Fork(version.Deneb1()).EVMInflationAddress = &deneb1Address
+	data.Fork(version.Deneb1()).EVMInflationPerBlock = &deneb1PerBlock
+	data.Fork(version.Electra1()).EVMInflationAddress = &electra1Address
+	spec, err := chain.NewSpec(data)
+	require.NoError(t, err)
+
+	tests := []struct {
+		timestamp uint64
+		address   common.ExecutionAddress
+		perBlock  math.Gwei
+	}{
+		{timestamp: 19, address: genesisAddress, perBlock: 1},
+		{timestamp: 20, address: deneb1Address, perBlock: 5},
+		// Parameters not overridden by a fork keep their previous value.
+		{timestamp: 30, address: deneb1Address, perBlock: 5},
+		{timestamp: 40, address: electra1Address, perBlock: 5},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.address, spec.EVMInflationAddress(math.U64(tt.timestamp)))
+		require.Equal(t, tt.perBlock, spec.EVMInflationPerBlock(math.U64(tt.timestamp)))
+	}
 }
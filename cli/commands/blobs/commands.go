@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	"os"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for exporting and importing blob sidecars.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "blobs",
+		Short:                      "Export and import blob sidecars as indexed blob archives",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetExportCmd(),
+		GetImportCmd(),
+	)
+
+	return cmd
+}
+
+// openStore opens the blob sidecar store of the node the command runs on.
+func openStore(cmd *cobra.Command) *dastore.Store {
+	logger := clicontext.GetLoggerFromCmd(cmd)
+	blobsDir := filepath.Join(clicontext.GetConfigFromCmd(cmd).RootDir, "data", "blobs")
+	return dastore.New(
+		filedb.NewRangeDB(
+			filedb.NewDB(
+				filedb.WithRootDirectory(blobsDir),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(os.ModePerm),
+				filedb.WithLogger(logger),
+			),
+		),
+		logger.With("service", "da-store"),
+	)
+}
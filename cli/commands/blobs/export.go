@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	"errors"
+	"fmt"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagFromSlot is the flag of the first slot to export.
+	flagFromSlot = "from-slot"
+
+	// flagToSlot is the flag of the last slot to export.
+	flagToSlot = "to-slot"
+
+	// flagOut is the flag of the file to export the sidecars to.
+	flagOut = "out"
+)
+
+// GetExportCmd returns a command exporting the blob sidecars of a range of
+// slots to a blob archive.
+//
+//nolint:lll // reads better if long description is one line
+func GetExportCmd() *cobra.Command {
+	var (
+		fromSlot uint64
+		toSlot   uint64
+		out      string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the blob sidecars of a range of slots to a blob archive",
+		Long:  `Exports the blob sidecars of the slots from --from-slot to --to-slot included to a blob archive: a single file holding the SSZ encoded sidecars of each slot, followed by an index of the slots, with checksums. Slots preceding the first slot with sidecars in the store may have been pruned, so the archive starts at that slot at the earliest. Archives can be imported into the store of a node with 'blobs import', or served by the node API from the directory set as blob-store archive-dir. The node must not be running.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			if fromSlot > toSlot {
+				return fmt.Errorf("--%s (%d) is after --%s (%d)", flagFromSlot, fromSlot, flagToSlot, toSlot)
+			}
+
+			// Slot 0 is the genesis, which never has sidecars.
+			store := openStore(cmd)
+			stored, err := store.IndexesAfter(0)
+			if err != nil {
+				return err
+			}
+			if len(stored) == 0 {
+				return errors.New("no blob sidecars stored")
+			}
+			if first := stored[0]; fromSlot < first {
+				if toSlot < first {
+					return fmt.Errorf("slots up to %d have no blob sidecars stored", first-1)
+				}
+				logger.Warn(
+					"Slots before the first stored slot may have been pruned and are not exported",
+					"from_slot", fromSlot, "first_stored_slot", first,
+				)
+				fromSlot = first
+			}
+
+			if out == "" {
+				out = fmt.Sprintf("blobs-%d-%d%s", fromSlot, toSlot, dastore.ArchiveExtension)
+			}
+			count, err := dastore.WriteArchive(
+				out, math.Slot(fromSlot), math.Slot(toSlot), store.GetBlobSidecars,
+			)
+			if err != nil {
+				return err
+			}
+
+			logger.Info(
+				"Exported blob sidecars",
+				"from_slot", fromSlot,
+				"to_slot", toSlot,
+				"num_sidecars", count,
+				"file", out,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&fromSlot, flagFromSlot, 0, "first slot to export")
+	cmd.Flags().Uint64Var(&toSlot, flagToSlot, 0, "last slot to export")
+	cmd.Flags().StringVar(&out, flagOut, "", "file to export the sidecars to (default blobs-<from-slot>-<to-slot>.blobs)")
+	_ = cmd.MarkFlagRequired(flagFromSlot)
+	_ = cmd.MarkFlagRequired(flagToSlot)
+	return cmd
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blobs
+
+import (
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/spf13/cobra"
+)
+
+// GetImportCmd returns a command writing the blob sidecars of blob archives
+// to the store of the node.
+//
+//nolint:lll // reads better if long description is one line
+func GetImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [archive-file]...",
+		Short: "Imports the blob sidecars of blob archives into the store of the node",
+		Long:  `Imports the blob sidecars of blob archives written by 'blobs export' into the blob sidecar store of the node. The checksums of the archives are verified before their sidecars are written. Sidecars past the data availability period are pruned again unless the node runs with blob-store archive-mode. The node must not be running.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			archives := make([]*dastore.ArchiveFile, 0, len(args))
+			for _, path := range args {
+				archive, err := dastore.OpenArchiveFile(path)
+				if err != nil {
+					return err
+				}
+				archives = append(archives, archive)
+			}
+
+			store := openStore(cmd)
+			for _, archive := range archives {
+				var count int
+				for _, slot := range archive.Slots() {
+					sidecars, err := archive.GetBlobSidecars(slot)
+					if err != nil {
+						return err
+					}
+					if err = store.Persist(sidecars); err != nil {
+						return err
+					}
+					count += len(sidecars)
+				}
+
+				first, last := archive.Range()
+				logger.Info(
+					"Imported blob sidecars",
+					"from_slot", first,
+					"to_slot", last,
+					"num_sidecars", count,
+					"file", archive.Path(),
+				)
+			}
+			return nil
+		},
+	}
+}
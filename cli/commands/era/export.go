@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"cosmossdk.io/store/rootmulti"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	nodetypes "github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/checkpoint"
+	"github.com/berachain/beacon-kit/storage/era"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagFromEra is the flag of the first era to export.
+	flagFromEra = "from-era"
+
+	// flagToEra is the flag of the last era to export.
+	flagToEra = "to-era"
+
+	// flagOutDir is the flag of the directory to write era files to.
+	flagOutDir = "out-dir"
+
+	// flagStateInterval is the flag of the number of eras between state
+	// snapshots.
+	flagStateInterval = "state-interval"
+
+	// defaultStateInterval is the default number of eras between state
+	// snapshots.
+	defaultStateInterval = 1024
+)
+
+// GetExportCmd returns a command exporting the history of a range of eras to
+// era files.
+//
+//nolint:lll // reads better if long description is one line
+func GetExportCmd(
+	chainSpecCreator servertypes.ChainSpecCreator,
+	appCreator servertypes.AppCreator,
+) *cobra.Command {
+	var (
+		fromEra       uint64
+		toEra         uint64
+		outDir        string
+		stateInterval uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports finalized blocks, blob sidecars and states to era files",
+		Long:  `Exports the eras from --from-era to --to-era included, by default up to the last complete era, to one era file each. Era n spans the SlotsPerHistoricalRoot slots starting at slot n*SlotsPerHistoricalRoot. Its file holds the historical batch of the era, that is the block and state roots of its slots, the signed blocks of the era and the blob sidecars still stored for them, and, every --state-interval eras, the beacon state after the last slot of the era. Files are named <chain id>-<era>-<historical root prefix>.era. The blocks must be kept by the block store, see block-store-service archive-mode, and state snapshots need the state of their height to be kept by the node. The node must not be running.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cs, err := chainSpecCreator(clicontext.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			app, err := state.OpenApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			period := cs.SlotsPerHistoricalRoot()
+			//#nosec:G115 // committed heights are not negative.
+			latest := uint64(app.CommitMultiStore().LastCommitID().Version)
+			if latest+1 < period {
+				return errors.New("no complete era to export")
+			}
+			if !cmd.Flags().Changed(flagToEra) {
+				toEra = (latest+1)/period - 1
+			}
+			if fromEra > toEra {
+				return fmt.Errorf("--%s (%d) is after --%s (%d)", flagFromEra, fromEra, flagToEra, toEra)
+			}
+			if last := (toEra+1)*period - 1; last > latest {
+				return fmt.Errorf("era %d ends at slot %d, after the latest height %d", toEra, last, latest)
+			}
+
+			for number := fromEra; number <= toEra; number++ {
+				withState := stateInterval > 0 && (number+1)%stateInterval == 0
+				e, missingSidecars, exportErr := exportEra(cmd, app, number, period, withState)
+				if exportErr != nil {
+					return fmt.Errorf("failed to export era %d: %w", number, exportErr)
+				}
+				if err = e.Verify(); err != nil {
+					return fmt.Errorf("failed to export era %d: %w", number, err)
+				}
+				path := filepath.Join(outDir, e.FileName(network(cs)))
+				if err = e.WriteFile(path); err != nil {
+					return err
+				}
+
+				logger.Info(
+					"Exported era",
+					"era", number,
+					"historical_root", e.HistoricalRoot(),
+					"blocks", len(e.Blocks),
+					"state", e.State != nil,
+					"file", path,
+				)
+				if missingSidecars > 0 {
+					logger.Warn(
+						"Blob sidecars of some blocks are no longer stored and were not exported",
+						"era", number, "blocks", missingSidecars,
+					)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&fromEra, flagFromEra, 0, "first era to export")
+	cmd.Flags().Uint64Var(&toEra, flagToEra, 0, "last era to export (default last complete era)")
+	cmd.Flags().StringVar(&outDir, flagOutDir, ".", "directory to write the era files to")
+	cmd.Flags().Uint64Var(
+		&stateInterval, flagStateInterval, defaultStateInterval,
+		"number of eras between state snapshots, 0 to export no state",
+	)
+	return cmd
+}
+
+// exportEra reads the history of an era from the stores of the node. It also
+// returns the number of blocks whose blob sidecars are no longer stored.
+func exportEra(
+	cmd *cobra.Command,
+	app nodetypes.Node,
+	number, period uint64,
+	withState bool,
+) (*era.Era, int, error) {
+	var (
+		sb    = app.StorageBackend()
+		start = math.Slot(number * period)
+		last  = start + math.Slot(period) - 1
+		e     = &era.Era{
+			Number: number,
+			Batch: &era.HistoricalBatch{
+				BlockRoots: make([]common.Root, period),
+				StateRoots: make([]common.Root, period),
+			},
+			Sidecars: make(map[math.Slot]datypes.BlobSidecars),
+		}
+		missingSidecars int
+	)
+	for i := range period {
+		slot := start + math.Slot(i)
+		if slot == 0 {
+			// The genesis has no block, its roots are kept by the state.
+			st, err := stateAt(cmd, app, last)
+			if err != nil {
+				return nil, 0, err
+			}
+			if e.Batch.BlockRoots[0], err = st.GetBlockRootAtIndex(0); err != nil {
+				return nil, 0, err
+			}
+			if e.Batch.StateRoots[0], err = st.StateRootAtIndex(0); err != nil {
+				return nil, 0, err
+			}
+			continue
+		}
+
+		blk, err := sb.BlockStore().GetBlockBySlot(slot)
+		if err != nil {
+			return nil, 0, err
+		}
+		e.Blocks = append(e.Blocks, blk)
+		e.Batch.BlockRoots[i] = blk.GetBeaconBlock().HashTreeRoot()
+		e.Batch.StateRoots[i] = blk.GetBeaconBlock().GetStateRoot()
+
+		if len(blk.GetBeaconBlock().GetBody().GetBlobKzgCommitments()) == 0 {
+			continue
+		}
+		sidecars, err := sb.AvailabilityStore().GetBlobSidecars(slot)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(sidecars) == 0 {
+			missingSidecars++
+			continue
+		}
+		e.Sidecars[slot] = sidecars
+	}
+
+	if withState {
+		cp, err := checkpointAt(cmd, app, last)
+		if err != nil {
+			return nil, 0, err
+		}
+		e.State = cp
+	}
+	return e, missingSidecars, nil
+}
+
+// checkpointAt returns the checkpoint of the beacon state committed at the
+// height of slot.
+func checkpointAt(cmd *cobra.Command, app nodetypes.Node, slot math.Slot) (*checkpoint.Checkpoint, error) {
+	cms := app.CommitMultiStore()
+	rms, ok := cms.(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("unsupported multistore %T", cms)
+	}
+	//#nosec:G115 // slots are far below the max int64.
+	commitInfo, err := rms.GetCommitInfo(int64(slot))
+	if err != nil {
+		return nil, fmt.Errorf("no state committed at height %d: %w", slot, err)
+	}
+	st, err := stateAt(cmd, app, slot)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := st.GetMarshallable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load beacon state at height %d: %w", slot, err)
+	}
+	return checkpoint.New(slot.Unwrap(), common.NewRootFromBytes(commitInfo.Hash()), bs), nil
+}
+
+// stateAt returns the beacon state committed at the height of slot.
+func stateAt(cmd *cobra.Command, app nodetypes.Node, slot math.Slot) (*statedb.StateDB, error) {
+	//#nosec:G115 // slots are far below the max int64.
+	ms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(int64(slot))
+	if err != nil {
+		return nil, fmt.Errorf("state at height %d is not available: %w", slot, err)
+	}
+	return app.StorageBackend().StateFromContext(state.NewContext(cmd, ms)), nil
+}
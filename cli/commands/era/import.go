@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/storage/era"
+	"github.com/spf13/cobra"
+)
+
+// flagExpectedRoot is the flag of the trusted root of the last block of the
+// imported eras.
+const flagExpectedRoot = "expected-root"
+
+// GetImportCmd returns a command seeding the block store and blob sidecar
+// store of a node from era files. The state snapshots of the files only serve
+// to verify the blocks: a beacon state rebuilt from its SSZ encoding does not
+// reproduce the app hash of the chain, so the node state is restored from a
+// state sync snapshot instead.
+//
+//nolint:lll // reads better if long description is one line
+func GetImportCmd(
+	chainSpecCreator servertypes.ChainSpecCreator,
+	appCreator servertypes.AppCreator,
+) *cobra.Command {
+	var expectedRoot string
+
+	cmd := &cobra.Command{
+		Use:   "import [era-file]...",
+		Short: "Seeds the block store and blob sidecars of a node from era files",
+		Long:  `Seeds the block store and the blob sidecar store of the node with the blocks and sidecars of era files written by 'era export'. The eras must be consecutive. Before anything is written, the blocks, sidecars and states of each file are checked against the block and state roots of its historical batch and consecutive eras are checked to chain. The last block of the eras is then checked against a trusted root: --expected-root if set, otherwise the block roots of the state committed by the node, which must be at most SlotsPerHistoricalRoot slots ahead of the eras. The proposer signature of every block is checked with the validators of the latest trusted state, and blocks already in the block store are checked to match. The state snapshots of the files are not written to the node: a state rebuilt from them would not reproduce the app hash of the chain, so the application store is restored from a state sync snapshot instead. Blocks and sidecars past the availability windows of the stores are pruned again unless they run in archive mode. The node must not be running.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			cs, err := chainSpecCreator(clicontext.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+
+			eras := make([]*era.Era, 0, len(args))
+			for _, path := range args {
+				e, readErr := era.ReadFile(path)
+				if readErr != nil {
+					return readErr
+				}
+				if e.SlotsPerHistoricalRoot() != cs.SlotsPerHistoricalRoot() {
+					return fmt.Errorf(
+						"era file %s has %d slots per era, expected %d",
+						path, e.SlotsPerHistoricalRoot(), cs.SlotsPerHistoricalRoot(),
+					)
+				}
+				eras = append(eras, e)
+			}
+			slices.SortFunc(eras, func(a, b *era.Era) int {
+				return cmp.Compare(a.Number, b.Number)
+			})
+			for i := 1; i < len(eras); i++ {
+				if err = eras[i].VerifyParent(eras[i-1]); err != nil {
+					return err
+				}
+			}
+
+			app, err := state.OpenApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			sb := app.StorageBackend()
+			blockStore := sb.BlockStore()
+			defer blockStore.Close()
+
+			// Eras chain, so anchoring the last one to a trusted root anchors
+			// them all, and with them their state snapshots.
+			var nodeState *ctypes.BeaconState
+			if cms := app.CommitMultiStore(); cms.LastCommitID().Version != 0 {
+				nodeState, err = sb.StateFromContext(state.NewContext(cmd, cms.CacheMultiStore())).GetMarshallable()
+				if err != nil {
+					return fmt.Errorf("failed to load beacon state of the node: %w", err)
+				}
+			}
+			last := eras[len(eras)-1]
+			switch {
+			case expectedRoot != "":
+				var root common.Root
+				if root, err = common.NewRootFromHex(expectedRoot); err != nil {
+					return fmt.Errorf("invalid --%s: %w", flagExpectedRoot, err)
+				}
+				err = last.VerifyAnchor(root)
+			case nodeState != nil:
+				err = last.VerifyAnchorState(nodeState)
+			default:
+				err = fmt.Errorf(
+					"%w: node has no committed state, set --%s", era.ErrUntrusted, flagExpectedRoot,
+				)
+			}
+			if err != nil {
+				return err
+			}
+
+			trusted := nodeState
+			for _, e := range eras {
+				if e.State != nil && (trusted == nil || e.State.State.Slot > trusted.Slot) {
+					trusted = e.State.State
+				}
+			}
+			if trusted == nil {
+				return errors.New("no state to verify the proposer signatures with")
+			}
+			for _, e := range eras {
+				if err = e.VerifySignatures(cs, trusted, signer.BLSSigner{}); err != nil {
+					return err
+				}
+			}
+
+			for _, e := range eras {
+				for _, blk := range e.Blocks {
+					stored, getErr := blockStore.GetBlockBySlot(blk.GetBeaconBlock().GetSlot())
+					if getErr == nil && stored.GetBeaconBlock().HashTreeRoot() != blk.GetBeaconBlock().HashTreeRoot() {
+						return fmt.Errorf(
+							"%w: block store holds a different block at slot %d",
+							era.ErrBlockRootMismatch, blk.GetBeaconBlock().GetSlot(),
+						)
+					}
+				}
+			}
+
+			for _, e := range eras {
+				for _, blk := range e.Blocks {
+					if err = blockStore.Set(blk); err != nil {
+						return err
+					}
+				}
+				for _, slot := range slices.Sorted(maps.Keys(e.Sidecars)) {
+					if err = sb.AvailabilityStore().Persist(e.Sidecars[slot]); err != nil {
+						return err
+					}
+				}
+				logger.Info(
+					"Imported era",
+					"era", e.Number,
+					"historical_root", e.HistoricalRoot(),
+					"blocks", len(e.Blocks),
+					"blob_sidecars", len(e.Sidecars),
+				)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&expectedRoot, flagExpectedRoot, "", "trusted root of the last block of the eras (default the block roots of the node state)")
+	return cmd
+}
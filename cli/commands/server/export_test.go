@@ -18,18 +18,13 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package block
+//go:build test
+// +build test
 
-import (
-	"github.com/berachain/beacon-kit/primitives/common"
-	"github.com/berachain/beacon-kit/primitives/math"
-)
+package server
 
-// BeaconBlock is a block in the beacon chain that has a slot, block root (hash
-// tree root), timestamp, and state root.
-type BeaconBlock interface {
-	GetSlot() math.U64
-	HashTreeRoot() common.Root
-	GetTimestamp() math.U64
-	GetStateRoot() common.Root
-}
+// Exported for testing.
+var (
+	LoadCometBFTStores = loadCometBFTStores
+	RollbackCometBFT   = rollbackCometBFT
+)
@@ -21,60 +21,252 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/berachain/beacon-kit/chain"
 	types "github.com/berachain/beacon-kit/cli/commands/server/types"
 	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/config"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	nodetypes "github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
 	"github.com/berachain/beacon-kit/storage/db"
-	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	"github.com/berachain/beacon-kit/storage/slashing"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstate "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
 	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
-// NewRollbackCmd creates a command to rollback CometBFT and multistore state by
-// one height.
+const (
+	// flagToHeight is the flag of the height to roll back to.
+	flagToHeight = "to-height"
+	// flagPruneSlashingProtection is the flag removing the slashing
+	// protection records above the height rolled back to.
+	flagPruneSlashingProtection = "prune-slashing-protection"
+
+	// executionClientTimeout bounds connecting to the execution client and
+	// rewinding it.
+	executionClientTimeout = time.Minute
+)
+
+// NewRollbackCmd creates a command to rollback CometBFT, multistore and
+// execution client state to a given height, by default one height back.
 func NewRollbackCmd(
 	appCreator types.AppCreator,
+	chainSpecCreator types.ChainSpecCreator,
 ) *cobra.Command {
-	var removeBlock bool
+	var (
+		removeBlock             bool
+		toHeight                int64
+		dryRun                  bool
+		pruneSlashingProtection bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "rollback",
-		Short: "rollback Cosmos SDK and CometBFT state by one height",
+		Short: "rollback Cosmos SDK, CometBFT and execution client state",
 		Long: `
 A state rollback is performed to recover from an incorrect application state transition,
 when CometBFT has persisted an incorrect app hash and is thus unable to make
-progress. Rollback overwrites a state at height n with the state at height n - 1.
-The application also rolls back to height n - 1. No blocks are removed, so upon
-restarting CometBFT the transactions in block n will be re-executed against the
-application.
+progress. Rollback overwrites the state at height n with the state at height
+--to-height, by default n - 1. The application also rolls back to that height, the
+beacon blocks and blob sidecars above it are removed, and the execution client is
+sent a forkchoice update to the execution block recorded at that height.
+
+The slashing protection records of the blocks signed above --to-height are kept,
+so the validator does not propose again until the chain passes the last slot it
+signed. --prune-slashing-protection removes them, which lets the validator sign
+different blocks at slots it already signed. Only use it if the removed blocks
+were never broadcast, as a double proposal can be punished.
+
+The CometBFT blocks above --to-height + 1 are removed. Block --to-height + 1 is
+kept unless --hard is set, so upon restarting CometBFT its transactions are
+re-executed against the application. Use --dry-run to report what would be
+removed without changing anything.
+
+Execution clients may ignore a forkchoice update to an ancestor of their head,
+in which case the head is reset with debug_setHead. If the execution client does
+not serve debug_setHead on its engine endpoint, the command fails and the
+execution client must be rewound with its own tooling.
 `,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			v := clicontext.GetViperFromCmd(cmd)
 			logger := clicontext.GetLoggerFromCmd(cmd)
 			cfg := clicontext.GetConfigFromCmd(cmd)
 
+			chainSpec, err := chainSpecCreator(v)
+			if err != nil {
+				return err
+			}
+			beaconCfg, err := config.ReadConfigFromAppOpts(v)
+			if err != nil {
+				return err
+			}
+
 			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
 			if err != nil {
 				return err
 			}
 			app := appCreator(logger, db, nil, cfg, v)
 
+			blockStore, stateStore, err := loadCometBFTStores(cfg)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = blockStore.Close()
+				_ = stateStore.Close()
+			}()
+
+			cmtState, err := stateStore.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load CometBFT state: %w", err)
+			}
+			if cmtState.IsEmpty() {
+				return errors.New("no CometBFT state found")
+			}
+			height := cmtState.LastBlockHeight
+			target := height - 1
+			if cmd.Flags().Changed(flagToHeight) {
+				target = toHeight
+			}
+			if target < cmtState.InitialHeight || target > height {
+				return fmt.Errorf(
+					"height %d out of range [%d, %d]", target, cmtState.InitialHeight, height,
+				)
+			}
+			if target < blockStore.Base() {
+				return fmt.Errorf(
+					"height %d is below the lowest stored CometBFT block %d", target, blockStore.Base(),
+				)
+			}
+
+			// The execution head is read before anything is changed, which
+			// also checks that the state at the target height is available.
+			header, err := executionHeaderAt(cmd.Context(), app, logger, target)
+			if err != nil {
+				return err
+			}
+			forkVersion := chainSpec.ActiveForkVersionForTimestamp(header.GetTimestamp())
+
+			storageBackend := app.StorageBackend()
+			blockSlots, err := storageBackend.BlockStore().SlotsAfter(math.Slot(target))
+			if err != nil {
+				return err
+			}
+			//#nosec:G115 // target is not negative.
+			sidecarSlots, err := storageBackend.AvailabilityStore().IndexesAfter(uint64(target))
+			if err != nil {
+				return err
+			}
+
+			slashingStore, err := openSlashingProtection(cfg)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = slashingStore.Close() }()
+			signedAbove, err := slashingStore.CountAbove(math.Slot(target))
+			if err != nil {
+				return fmt.Errorf("failed to read slashing protection records: %w", err)
+			}
+
+			logger.Info(
+				"Rollback plan",
+				"dry_run", dryRun,
+				"from_height", height,
+				"to_height", target,
+				"cometbft_blocks_removed", blocksRemoved(blockStore.Height(), target, removeBlock),
+				"beacon_blocks_removed", len(blockSlots),
+				"blob_sidecar_slots_removed", len(sidecarSlots),
+				"slashing_protection_records_above_target", signedAbove,
+				"prune_slashing_protection", pruneSlashingProtection,
+				"execution_head_number", header.GetNumber().Base10(),
+				"execution_head_hash", header.GetBlockHash(),
+			)
+			if signedAbove > 0 && !pruneSlashingProtection {
+				logger.Warn(
+					"Keeping the slashing protection records above the target, the validator "+
+						"will not propose again until the chain passes the slots it signed",
+					"records", signedAbove,
+				)
+			}
+			if signedAbove > 0 && pruneSlashingProtection {
+				logger.Warn(
+					"!!! PRUNING SLASHING PROTECTION RECORDS !!! The validator will be able to "+
+						"sign different blocks at slots it already signed. If any removed block "+
+						"was broadcast, proposing again at its slot is a double proposal",
+					"records", signedAbove,
+				)
+			}
+			if dryRun {
+				return nil
+			}
+
 			// rollback CometBFT state
-			height, hash, err := cmtcmd.RollbackState(cfg, removeBlock)
+			appHash, err := rollbackCometBFT(blockStore, stateStore, target, removeBlock)
 			if err != nil {
 				return fmt.Errorf("failed to rollback CometBFT state: %w", err)
 			}
 
 			// rollback the multistore
-			if err = app.CommitMultiStore().RollbackToVersion(height); err != nil {
+			if err = app.CommitMultiStore().RollbackToVersion(target); err != nil {
 				return fmt.Errorf("failed to rollback to version: %w", err)
 			}
 
+			// remove the beacon blocks and blob sidecars above the target
+			if err = storageBackend.BlockStore().DeleteAfter(math.Slot(target)); err != nil {
+				return err
+			}
+			//#nosec:G115 // target is not negative.
+			if err = storageBackend.AvailabilityStore().DeleteAfter(uint64(target)); err != nil {
+				return err
+			}
+
+			// allow the removed blocks to be proposed again, if requested
+			if pruneSlashingProtection {
+				if err = slashingStore.PruneAbove(math.Slot(target)); err != nil {
+					return fmt.Errorf("failed to prune slashing protection records: %w", err)
+				}
+			}
+
 			logger.Info(
 				"Rolled back state",
-				"height", height,
-				"hash", fmt.Sprintf("%X", hash),
+				"height", target,
+				"hash", fmt.Sprintf("%X", appHash),
+			)
+
+			// rewind the execution client
+			if err = rewindExecutionClient(
+				cmd.Context(), beaconCfg, chainSpec, v, logger, header, forkVersion,
+			); err != nil {
+				return fmt.Errorf(
+					"rolled back to height %d but failed to rewind the execution client: %w",
+					target, err,
+				)
+			}
+			logger.Info(
+				"Rewound execution client",
+				"number", header.GetNumber().Base10(),
+				"hash", header.GetBlockHash(),
 			)
 			return nil
 		},
@@ -82,5 +274,197 @@ application.
 
 	cmd.Flags().
 		BoolVar(&removeBlock, "hard", false, "remove last block as well as state")
+	cmd.Flags().
+		Int64Var(&toHeight, flagToHeight, 0, "height to roll back to (default current height - 1)")
+	cmd.Flags().
+		BoolVar(&dryRun, "dry-run", false, "report what would be removed without changing anything")
+	cmd.Flags().BoolVar(
+		&pruneSlashingProtection, flagPruneSlashingProtection, false,
+		"DANGEROUS: remove the slashing protection records above the target height, "+
+			"allowing different blocks to be signed at slots already signed",
+	)
 	return cmd
 }
+
+// loadCometBFTStores opens the CometBFT block and state stores.
+func loadCometBFTStores(cfg *cmtcfg.Config) (*cmtstore.BlockStore, cmtstate.Store, error) {
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, nil, err
+	}
+	blockStore := cmtstore.NewBlockStore(
+		blockStoreDB, cmtstore.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout),
+	)
+
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		_ = blockStore.Close()
+		return nil, nil, err
+	}
+	stateStore := cmtstate.NewStore(stateDB, cmtstate.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		DBKeyLayout:          cfg.Storage.ExperimentalKeyLayout,
+	})
+	return blockStore, stateStore, nil
+}
+
+// rollbackCometBFT rolls CometBFT state back one height at a time until it
+// reaches target, returning the app hash at target. Every block above
+// target + 1 is removed, and block target + 1 only if removeBlock is set.
+func rollbackCometBFT(
+	blockStore *cmtstore.BlockStore,
+	stateStore cmtstate.Store,
+	target int64,
+	removeBlock bool,
+) ([]byte, error) {
+	for {
+		state, err := stateStore.Load()
+		if err != nil {
+			return nil, err
+		}
+		if state.LastBlockHeight <= target {
+			return state.AppHash, nil
+		}
+		// Rollback removes the latest stored block, which is at most one
+		// above the state height.
+		remove := removeBlock || blockStore.Height() > target+1
+		if _, _, err = cmtstate.Rollback(blockStore, stateStore, remove); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// blocksRemoved returns how many CometBFT blocks rolling back from the given
+// block store height to target removes.
+func blocksRemoved(storeHeight, target int64, removeBlock bool) int64 {
+	if removeBlock {
+		return max(storeHeight-target, 0)
+	}
+	return max(storeHeight-target-1, 0)
+}
+
+// executionHeaderAt returns the latest execution payload header of the beacon
+// state at the given height.
+func executionHeaderAt(
+	ctx context.Context,
+	app nodetypes.Node,
+	logger *phuslu.Logger,
+	height int64,
+) (*ctypes.ExecutionPayloadHeader, error) {
+	cms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("state at height %d is not available: %w", height, err)
+	}
+	sdkCtx := sdk.NewContext(cms, false, servercmtlog.WrapSDKLogger(logger)).WithContext(ctx)
+	header, err := app.StorageBackend().StateFromContext(sdkCtx).GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load execution payload header at height %d: %w", height, err)
+	}
+	return header, nil
+}
+
+// openSlashingProtection opens the slashing protection database of the node.
+func openSlashingProtection(cfg *cmtcfg.Config) (*slashing.Store, error) {
+	slashingDB, err := dbm.NewDB(
+		slashing.DBName, dbm.PebbleDBBackend, filepath.Join(cfg.RootDir, "data"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open slashing protection database: %w", err)
+	}
+	return slashing.NewStore(slashingDB), nil
+}
+
+// rewindExecutionClient sends the execution client a forkchoice update making
+// the given block its head, safe and finalized block. If the execution client
+// keeps its head, the head is reset with debug_setHead.
+func rewindExecutionClient(
+	ctx context.Context,
+	cfg *config.Config,
+	chainSpec chain.Spec,
+	appOpts config.AppOptions,
+	logger *phuslu.Logger,
+	header *ctypes.ExecutionPayloadHeader,
+	forkVersion common.Version,
+) error {
+	jwtPath := cast.ToString(appOpts.Get(flags.JWTSecretPath))
+	data, err := os.ReadFile(jwtPath)
+	if err != nil {
+		return fmt.Errorf("failed reading path '%s', err: %w", jwtPath, err)
+	}
+	jwtSecret, err := jwt.NewFromHex(strings.TrimSpace(string(data)))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, executionClientTimeout)
+	defer cancel()
+	engineClient := client.New(
+		cfg.GetEngine(),
+		logger.With("service", "engine.client"),
+		jwtSecret,
+		metrics.NewNoOpTelemetrySink(),
+		new(big.Int).SetUint64(chainSpec.DepositEth1ChainID()),
+	)
+	if err = engineClient.Start(ctx); err != nil {
+		return err
+	}
+	defer func() { _ = engineClient.Stop() }()
+
+	head := header.GetBlockHash()
+	if err = forkchoiceTo(ctx, engineClient, head, forkVersion); err != nil {
+		return err
+	}
+	latest, err := engineClient.LatestBlockHash(ctx)
+	if err != nil {
+		return err
+	}
+	if latest == head {
+		return nil
+	}
+
+	logger.Warn(
+		"Execution client ignored the forkchoice update, resetting its head",
+		"head", latest, "target", head,
+	)
+	if err = engineClient.SetHead(ctx, header.GetNumber()); err != nil {
+		return fmt.Errorf(
+			"execution client kept head %s and debug_setHead failed, "+
+				"rewind it to block %d (%s) with its own tooling: %w",
+			latest, header.GetNumber(), head, err,
+		)
+	}
+	if err = forkchoiceTo(ctx, engineClient, head, forkVersion); err != nil {
+		return err
+	}
+	if latest, err = engineClient.LatestBlockHash(ctx); err != nil {
+		return err
+	}
+	if latest != head {
+		return fmt.Errorf(
+			"execution client head is %s after debug_setHead, expected block %d (%s)",
+			latest, header.GetNumber(), head,
+		)
+	}
+	return nil
+}
+
+// forkchoiceTo makes the given block the head, safe and finalized block of
+// the execution client.
+func forkchoiceTo(
+	ctx context.Context,
+	engineClient *client.EngineClient,
+	head common.ExecutionHash,
+	forkVersion common.Version,
+) error {
+	_, err := engineClient.ForkchoiceUpdated(
+		ctx,
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      head,
+			SafeBlockHash:      head,
+			FinalizedBlockHash: head,
+		},
+		nil,
+		forkVersion,
+	)
+	return err
+}
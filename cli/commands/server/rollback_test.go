@@ -0,0 +1,487 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/cli/commands/server"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/cli/flags"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	dastore "github.com/berachain/beacon-kit/da/store"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	nodetypes "github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/berachain/beacon-kit/storage/slashing"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtstate "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// chainHeight is the height of the chains set up by the tests.
+const chainHeight = 5
+
+func TestRollbackCometBFT(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		target      int64
+		removeBlock bool
+		// wantHeight is the block store height after the rollback.
+		wantHeight int64
+	}{
+		{name: "one height", target: 4, wantHeight: 5},
+		{name: "one height hard", target: 4, removeBlock: true, wantHeight: 4},
+		{name: "several heights", target: 2, wantHeight: 3},
+		{name: "several heights hard", target: 2, removeBlock: true, wantHeight: 2},
+		{name: "current height", target: chainHeight, wantHeight: chainHeight},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := cmtcfg.DefaultConfig().SetRoot(t.TempDir())
+			writeCometBFTChain(t, cfg, chainHeight)
+
+			blockStore, stateStore, err := server.LoadCometBFTStores(cfg)
+			require.NoError(t, err)
+			defer func() {
+				_ = blockStore.Close()
+				_ = stateStore.Close()
+			}()
+
+			appHash, err := server.RollbackCometBFT(blockStore, stateStore, tc.target, tc.removeBlock)
+			require.NoError(t, err)
+			require.Equal(t, appHashAt(tc.target), appHash)
+
+			state, err := stateStore.Load()
+			require.NoError(t, err)
+			require.Equal(t, tc.target, state.LastBlockHeight)
+			require.Equal(t, appHashAt(tc.target), state.AppHash)
+			require.Equal(t, tc.wantHeight, blockStore.Height())
+		})
+	}
+}
+
+func TestRollbackCmd(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		// ignoreForkchoice makes the execution client keep its head on
+		// forkchoice updates to an ancestor.
+		ignoreForkchoice bool
+		// serveSetHead makes the execution client serve debug_setHead.
+		serveSetHead bool
+		// pruneSlashingProtection removes the slashing protection records
+		// above the target.
+		pruneSlashingProtection bool
+		wantErr                 string
+		wantSetHeads            int
+	}{
+		{name: "forkchoice update"},
+		{name: "prune slashing protection", pruneSlashingProtection: true},
+		{name: "debug_setHead", ignoreForkchoice: true, serveSetHead: true, wantSetHeads: 1},
+		{name: "no debug_setHead", ignoreForkchoice: true, wantErr: "with its own tooling"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			const target = 2
+			el := newRewindEL(t, chainHeight, tc.ignoreForkchoice, tc.serveSetHead)
+			f := newRollbackFixture(t, el)
+
+			cmd := server.NewRollbackCmd(f.appCreator, f.chainSpecCreator)
+			args := []string{"--to-height", "2"}
+			if tc.pruneSlashingProtection {
+				args = append(args, "--prune-slashing-protection")
+			}
+			cmd.SetArgs(args)
+			err := cmd.ExecuteContext(f.ctx)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, executionHash(target), el.headHash())
+			}
+			require.Equal(t, tc.wantSetHeads, el.setHeadCalls())
+
+			// CometBFT, the application and the beacon stores are rolled
+			// back in every case.
+			blockStore, stateStore, err := server.LoadCometBFTStores(f.cfg)
+			require.NoError(t, err)
+			state, err := stateStore.Load()
+			require.NoError(t, err)
+			require.Equal(t, int64(target), state.LastBlockHeight)
+			require.Equal(t, int64(target+1), blockStore.Height())
+			require.NoError(t, blockStore.Close())
+			require.NoError(t, stateStore.Close())
+
+			require.Equal(t, int64(target), f.cms.LastCommitID().Version)
+			slots, err := f.blockStore.SlotsAfter(0)
+			require.NoError(t, err)
+			require.Equal(t, []math.Slot{1, 2}, slots)
+			indexes, err := f.availabilityStore.IndexesAfter(0)
+			require.NoError(t, err)
+			require.Equal(t, []uint64{1, 2}, indexes)
+
+			// Different blocks can only be proposed at the removed slots once
+			// their slashing protection records are pruned.
+			protection := openSlashingProtection(t, f.cfg)
+			err = protection.CheckAndRecordBlock(
+				crypto.BLSPubkey{0x01}, target+1, common.Root{0xff}, common.Root{0x01},
+			)
+			if tc.pruneSlashingProtection {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+			}
+			require.NoError(t, protection.Close())
+		})
+	}
+}
+
+func TestRollbackCmd_DryRun(t *testing.T) {
+	t.Parallel()
+	el := newRewindEL(t, chainHeight, false, false)
+	f := newRollbackFixture(t, el)
+
+	cmd := server.NewRollbackCmd(f.appCreator, f.chainSpecCreator)
+	cmd.SetArgs([]string{"--to-height", "2", "--dry-run", "--prune-slashing-protection"})
+	require.NoError(t, cmd.ExecuteContext(f.ctx))
+
+	blockStore, stateStore, err := server.LoadCometBFTStores(f.cfg)
+	require.NoError(t, err)
+	state, err := stateStore.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(chainHeight), state.LastBlockHeight)
+	require.NoError(t, blockStore.Close())
+	require.NoError(t, stateStore.Close())
+	require.Equal(t, int64(chainHeight), f.cms.LastCommitID().Version)
+	require.Equal(t, executionHash(chainHeight), el.headHash())
+
+	protection := openSlashingProtection(t, f.cfg)
+	count, err := protection.CountAbove(0)
+	require.NoError(t, err)
+	require.Equal(t, chainHeight, count)
+	require.NoError(t, protection.Close())
+}
+
+// appHashAt returns the app hash of the test chains after the block at
+// height.
+func appHashAt(height int64) []byte {
+	return []byte{byte(height)}
+}
+
+// executionHash returns the hash of the execution block of the test chains
+// at number.
+func executionHash(number uint64) common.ExecutionHash {
+	return common.ExecutionHash{0xee, byte(number)}
+}
+
+// writeCometBFTChain writes a CometBFT chain of the given height with a
+// single validator to the stores of cfg, saving the state of every height.
+func writeCometBFTChain(t *testing.T, cfg *cmtcfg.Config, height int64) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(cfg.DBDir(), 0o755))
+	blockStore, stateStore, err := server.LoadCometBFTStores(cfg)
+	require.NoError(t, err)
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+
+	params := cmttypes.DefaultConsensusParams()
+	params.Feature.PbtsEnableHeight = 1
+	genDoc := &cmttypes.GenesisDoc{
+		ChainID:         "rollback-test",
+		GenesisTime:     time.Now(),
+		InitialHeight:   1,
+		ConsensusParams: params,
+		Validators: []cmttypes.GenesisValidator{
+			{PubKey: ed25519.GenPrivKey().PubKey(), Power: 10},
+		},
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	state, err := cmtstate.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	require.NoError(t, stateStore.Save(state))
+
+	proposer := state.Validators.Validators[0].Address
+	lastCommit := &cmttypes.Commit{}
+	for h := int64(1); h <= height; h++ {
+		blk := state.MakeBlock(h, nil, lastCommit, nil, proposer)
+		parts, errParts := blk.MakePartSet(cmttypes.BlockPartSizeBytes)
+		require.NoError(t, errParts)
+		blockID := cmttypes.BlockID{Hash: blk.Hash(), PartSetHeader: parts.Header()}
+		lastCommit = &cmttypes.Commit{
+			Height:  h,
+			BlockID: blockID,
+			Signatures: []cmttypes.CommitSig{{
+				BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+				ValidatorAddress: proposer,
+				Timestamp:        blk.Time,
+				Signature:        make([]byte, ed25519.SignatureSize),
+			}},
+		}
+		blockStore.SaveBlock(blk, parts, lastCommit)
+
+		state.LastBlockHeight = h
+		state.LastBlockID = blockID
+		state.LastBlockTime = blk.Time
+		state.LastValidators = state.Validators.Copy()
+		state.AppHash = appHashAt(h)
+		require.NoError(t, stateStore.Save(state))
+	}
+}
+
+// rollbackFixture is a node home holding a chain of height chainHeight.
+type rollbackFixture struct {
+	ctx               context.Context
+	cfg               *cmtcfg.Config
+	cms               storetypes.CommitMultiStore
+	blockStore        *block.KVStore
+	availabilityStore *dastore.Store
+	backend           *storage.Backend
+	chainSpec         chain.Spec
+}
+
+func newRollbackFixture(t *testing.T, el *rewindEL) *rollbackFixture {
+	t.Helper()
+	home := t.TempDir()
+	cfg := cmtcfg.DefaultConfig().SetRoot(home)
+	writeCometBFTChain(t, cfg, chainHeight)
+
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	blockStore := block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 1000, false)
+	availabilityStore := dastore.New(
+		filedb.NewRangeDB(filedb.NewDB(
+			filedb.WithRootDirectory(filepath.Join(home, "blobs")),
+			filedb.WithFileExtension("ssz"),
+			filedb.WithDirectoryPermissions(0o700),
+			filedb.WithLogger(log.NewNopLogger()),
+		)),
+		log.NewNopLogger(),
+	)
+
+	// Every height records the execution block of the same number.
+	for h := uint64(1); h <= chainHeight; h++ {
+		header := ctypes.NewEmptyExecutionPayloadHeaderWithVersion(version.Deneb1())
+		header.Number = math.U64(h)
+		header.BlockHash = executionHash(h)
+		sdkCtx := sdk.NewContext(cms, false, log.NewNopLogger())
+		require.NoError(t, kvStore.WithContext(sdkCtx).SetLatestExecutionPayloadHeader(header))
+		cms.Commit()
+
+		blk, errBlk := ctypes.NewBeaconBlockWithVersion(math.Slot(h), 0, common.Root{}, version.Deneb1())
+		require.NoError(t, errBlk)
+		require.NoError(t, blockStore.Set(&ctypes.SignedBeaconBlock{BeaconBlock: blk}))
+		require.NoError(t, availabilityStore.Set(h, []byte{0}, []byte{byte(h)}))
+	}
+
+	// The validator proposed every block.
+	protection := openSlashingProtection(t, cfg)
+	for h := uint64(1); h <= chainHeight; h++ {
+		require.NoError(t, protection.CheckAndRecordBlock(
+			crypto.BLSPubkey{0x01}, math.Slot(h), common.Root{byte(h)}, common.Root{0x01},
+		))
+	}
+	require.NoError(t, protection.Close())
+
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	jwtPath := filepath.Join(home, "jwt.hex")
+	require.NoError(t, os.WriteFile(jwtPath, []byte(secret.Hex()), 0o600))
+
+	v := viper.New()
+	v.Set("home", home)
+	v.Set(flags.JWTSecretPath, jwtPath)
+	v.Set("beacon-kit.engine.rpc-dial-url", el.URL)
+	v.Set("beacon-kit.engine.rpc-timeout", "2s")
+	v.Set("beacon-kit.engine.rpc-startup-check-interval", "100ms")
+	v.Set("beacon-kit.engine.rpc-jwt-refresh-interval", "30s")
+
+	ctx := context.WithValue(context.Background(), clicontext.ViperContextKey, v)
+	ctx = context.WithValue(ctx, clicontext.LoggerContextKey, phuslu.NewLogger(os.Stderr, nil))
+	return &rollbackFixture{
+		ctx:               ctx,
+		cfg:               cfg,
+		cms:               cms,
+		blockStore:        blockStore,
+		availabilityStore: availabilityStore,
+		backend: storage.NewBackend(
+			cs, availabilityStore, kvStore, depositStore, blockStore,
+			log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+		),
+		chainSpec: cs,
+	}
+}
+
+func (f *rollbackFixture) appCreator(
+	*phuslu.Logger, dbm.DB, io.Writer, *cmtcfg.Config, servertypes.AppOptions,
+) nodetypes.Node {
+	return &rollbackTestNode{cms: f.cms, backend: f.backend}
+}
+
+func (f *rollbackFixture) chainSpecCreator(servertypes.AppOptions) (chain.Spec, error) {
+	return f.chainSpec, nil
+}
+
+// rollbackTestNode is a node exposing the stores of a rollbackFixture.
+type rollbackTestNode struct {
+	cms     storetypes.CommitMultiStore
+	backend *storage.Backend
+}
+
+func (n *rollbackTestNode) CommitMultiStore() store.CommitMultiStore {
+	return n.cms
+}
+
+func (n *rollbackTestNode) StorageBackend() blockchain.StorageBackend {
+	return n.backend
+}
+
+func (n *rollbackTestNode) Start(context.Context) error {
+	return nil
+}
+
+func openSlashingProtection(t *testing.T, cfg *cmtcfg.Config) *slashing.Store {
+	t.Helper()
+	db, err := dbm.NewDB(slashing.DBName, dbm.PebbleDBBackend, filepath.Join(cfg.RootDir, "data"))
+	require.NoError(t, err)
+	return slashing.NewStore(db)
+}
+
+// rewindEL is a stand-in execution client with a canonical chain of blocks
+// whose head can be moved back by forkchoice updates or debug_setHead.
+type rewindEL struct {
+	*httptest.Server
+	ignoreForkchoice bool
+	serveSetHead     bool
+
+	mu       sync.Mutex
+	head     uint64
+	setHeads int
+}
+
+func newRewindEL(t *testing.T, head uint64, ignoreForkchoice, serveSetHead bool) *rewindEL {
+	t.Helper()
+	el := &rewindEL{head: head, ignoreForkchoice: ignoreForkchoice, serveSetHead: serveSetHead}
+	el.Server = httptest.NewServer(http.HandlerFunc(el.serveHTTP))
+	t.Cleanup(el.Close)
+	return el
+}
+
+func (el *rewindEL) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int               `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	el.mu.Lock()
+	defer el.mu.Unlock()
+
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	switch {
+	case req.Method == "eth_chainId":
+		resp["result"] = hexutil.EncodeUint64(chain.DevnetEth1ChainID)
+	case req.Method == "engine_exchangeCapabilities":
+		resp["result"] = []string{}
+	case req.Method == "eth_getBlockByNumber":
+		resp["result"] = map[string]any{"hash": executionHash(el.head)}
+	case strings.HasPrefix(req.Method, "engine_forkchoiceUpdated"):
+		var state struct {
+			HeadBlockHash common.ExecutionHash `json:"headBlockHash"`
+		}
+		_ = json.Unmarshal(req.Params[0], &state)
+		if !el.ignoreForkchoice {
+			el.head = uint64(state.HeadBlockHash[1])
+		}
+		resp["result"] = map[string]any{"payloadStatus": map[string]any{
+			"status": "VALID", "latestValidHash": state.HeadBlockHash,
+		}}
+	case req.Method == "debug_setHead" && el.serveSetHead:
+		var number hexutil.Uint64
+		_ = json.Unmarshal(req.Params[0], &number)
+		el.head = uint64(number)
+		el.setHeads++
+		resp["result"] = nil
+	default:
+		resp["error"] = map[string]any{
+			"code": -32601, "message": "the method " + req.Method + " does not exist",
+		}
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (el *rewindEL) headHash() common.ExecutionHash {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return executionHash(el.head)
+}
+
+func (el *rewindEL) setHeadCalls() int {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	return el.setHeads
+}
@@ -21,12 +21,16 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/cli/commands/blobs"
 	"github.com/berachain/beacon-kit/cli/commands/deposit"
+	"github.com/berachain/beacon-kit/cli/commands/era"
 	"github.com/berachain/beacon-kit/cli/commands/genesis"
 	"github.com/berachain/beacon-kit/cli/commands/initialize"
 	"github.com/berachain/beacon-kit/cli/commands/jwt"
 	"github.com/berachain/beacon-kit/cli/commands/server"
 	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	"github.com/berachain/beacon-kit/cli/commands/validator"
 	"github.com/berachain/beacon-kit/cli/flags"
 	cmtcli "github.com/berachain/beacon-kit/consensus/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
@@ -42,6 +46,8 @@ func DefaultRootCommandSetup(
 ) {
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
+		// `blobs`
+		blobs.Commands(),
 		// `comet`
 		cmtcli.Commands(appCreator),
 		// `init`
@@ -50,16 +56,22 @@ func DefaultRootCommandSetup(
 		genesis.Commands(chainSpecCreator),
 		// `deposit`
 		deposit.Commands(chainSpecCreator, appCreator),
+		// `era`
+		era.Commands(chainSpecCreator, appCreator),
 		// `jwt`
 		jwt.Commands(),
 		// `rollback`
-		server.NewRollbackCmd(appCreator),
+		server.NewRollbackCmd(appCreator, chainSpecCreator),
 		// `start`
 		server.StartCmdWithOptions(appCreator, server.StartCmdOptions{
 			AddFlags: flags.AddBeaconKitFlags,
 		}),
+		// `state`
+		state.Commands(appCreator),
 		// `status`
 		cmtcli.StatusCommand(),
+		// `validator`
+		validator.Commands(),
 		// `version`
 		version.NewVersionCommand(),
 	)
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	nodetypes "github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/storage/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for exporting the beacon state.
+func Commands(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "state",
+		Short:                      "Export the beacon state as SSZ checkpoint files",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetExportCmd(appCreator),
+	)
+
+	return cmd
+}
+
+// OpenApp opens the application database of the node the command runs on.
+func OpenApp(cmd *cobra.Command, appCreator servertypes.AppCreator) (nodetypes.Node, error) {
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	appDB, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open application database: %w", err)
+	}
+	return appCreator(clicontext.GetLoggerFromCmd(cmd), appDB, nil, cfg, clicontext.GetViperFromCmd(cmd)), nil
+}
+
+// NewContext returns an SDK context over the given multistore.
+func NewContext(cmd *cobra.Command, ms storetypes.MultiStore) sdk.Context {
+	logger := clicontext.GetLoggerFromCmd(cmd)
+	return sdk.NewContext(ms, false, servercmtlog.WrapSDKLogger(logger)).
+		WithContext(cmd.Context())
+}
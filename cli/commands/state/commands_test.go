@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package state_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/chain"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	"github.com/berachain/beacon-kit/cli/commands/state"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	nodemetrics "github.com/berachain/beacon-kit/node-core/components/metrics"
+	nodestorage "github.com/berachain/beacon-kit/node-core/components/storage"
+	nodetypes "github.com/berachain/beacon-kit/node-core/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/storage"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	"github.com/berachain/beacon-kit/storage/block"
+	"github.com/berachain/beacon-kit/storage/checkpoint"
+	"github.com/berachain/beacon-kit/storage/db"
+	"github.com/berachain/beacon-kit/storage/deposit"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCmd(t *testing.T) {
+	t.Parallel()
+	f := newStateFixture(t)
+	out := filepath.Join(t.TempDir(), "state.ssz")
+
+	// Nothing is exported before a state is committed.
+	require.ErrorContains(t,
+		f.run(state.GetExportCmd(f.appCreator), "--out", out),
+		"no committed state",
+	)
+
+	bs, _ := genesisState(t, f.cs)
+	f.commitState(t, 5, bs)
+	require.NoError(t, f.run(state.GetExportCmd(f.appCreator), "--out", out))
+
+	got, err := checkpoint.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), got.Height)
+	require.Equal(t, bs.Fork.CurrentVersion, got.ForkVersion)
+	require.Equal(t, bs.HashTreeRoot(), got.State.HashTreeRoot())
+	require.Equal(t, bs.HashTreeRoot(), got.BlockHeader.GetStateRoot())
+	require.NotEqual(t, common.Root{}, got.AppHash)
+
+	// Heights without a committed state are refused.
+	require.ErrorContains(t,
+		f.run(state.GetExportCmd(f.appCreator), "--height", "4", "--out", out),
+		"no state committed at height 4",
+	)
+}
+
+// genesisState returns the marshallable genesis state of a chain and its
+// deposits.
+func genesisState(t *testing.T, cs chain.Spec) (*ctypes.BeaconState, ctypes.Deposits) {
+	t.Helper()
+	sp, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+
+	deposits := make(ctypes.Deposits, 0, 4)
+	for i := range 4 {
+		deposits = append(deposits, &ctypes.Deposit{
+			Pubkey: [48]byte{byte(i + 1)},
+			Amount: cs.MaxEffectiveBalance(),
+			Credentials: ctypes.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{byte(i + 1)},
+			),
+			Index: uint64(i),
+		})
+	}
+	header := &ctypes.ExecutionPayloadHeader{
+		Versionable: ctypes.NewVersionable(cs.GenesisForkVersion()),
+	}
+	_, err := sp.InitializeBeaconStateFromEth1(st, deposits, header, cs.GenesisForkVersion())
+	require.NoError(t, err)
+
+	bs, err := st.GetMarshallable()
+	require.NoError(t, err)
+	return bs, deposits
+}
+
+// stateFixture is a node home whose apps build their multistore over the
+// database the commands open, and share a deposit store and a block store.
+type stateFixture struct {
+	ctx          context.Context
+	home         string
+	cs           chain.Spec
+	depositStore deposit.StoreManager
+	blockStore   *block.KVStore
+
+	mu  sync.Mutex
+	dbs []dbm.DB
+}
+
+func newStateFixture(t *testing.T) *stateFixture {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	home := t.TempDir()
+	v := viper.New()
+	v.Set("home", home)
+	ctx := context.WithValue(context.Background(), clicontext.ViperContextKey, v)
+	ctx = context.WithValue(ctx, clicontext.LoggerContextKey, phuslu.NewLogger(os.Stderr, nil))
+	return &stateFixture{
+		ctx:          ctx,
+		home:         home,
+		cs:           cs,
+		depositStore: deposit.NewStore(dbm.NewMemDB(), log.NewNopLogger()),
+		blockStore:   block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 1000, false),
+	}
+}
+
+// run runs the command with the given arguments, closing the databases it
+// opened once it returns.
+func (f *stateFixture) run(cmd *cobra.Command, args ...string) error {
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(f.ctx)
+	f.closeDBs()
+	return err
+}
+
+// closeDBs closes the databases the apps of the fixture were created with.
+func (f *stateFixture) closeDBs() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, db := range f.dbs {
+		_ = db.Close()
+	}
+	f.dbs = nil
+}
+
+func (f *stateFixture) appCreator(
+	_ *phuslu.Logger, db dbm.DB, _ io.Writer, _ *cmtcfg.Config, _ servertypes.AppOptions,
+) nodetypes.Node {
+	f.mu.Lock()
+	f.dbs = append(f.dbs, db)
+	f.mu.Unlock()
+
+	cms := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storage.StoreKey, storetypes.StoreTypeIAVL, nil)
+	if err := cms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+	return &stateTestNode{
+		cms: cms,
+		backend: nodestorage.NewBackend(
+			f.cs, nil, beacondb.New(storage.KVStoreService{Key: storage.StoreKey}),
+			f.depositStore, f.blockStore, log.NewNopLogger(), nodemetrics.NewNoOpTelemetrySink(),
+		),
+	}
+}
+
+// commitState commits the given beacon state into the application database of
+// the fixture at the given height.
+func (f *stateFixture) commitState(t *testing.T, height int64, bs *ctypes.BeaconState) {
+	t.Helper()
+	appDB, err := db.OpenDB(f.home, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	app := f.appCreator(nil, appDB, nil, nil, nil)
+
+	cms := app.CommitMultiStore()
+	require.NoError(t, cms.SetInitialVersion(height))
+	ms := cms.CacheMultiStore()
+	st := app.StorageBackend().StateFromContext(sdk.NewContext(ms, false, log.NewNopLogger()))
+	require.NoError(t, st.SetMarshallable(bs))
+	ms.Write()
+	require.Equal(t, height, cms.Commit().Version)
+	f.closeDBs()
+}
+
+// stateTestNode is a node exposing the stores of a stateFixture.
+type stateTestNode struct {
+	cms     storetypes.CommitMultiStore
+	backend *nodestorage.Backend
+}
+
+func (n *stateTestNode) CommitMultiStore() store.CommitMultiStore {
+	return n.cms
+}
+
+func (n *stateTestNode) StorageBackend() blockchain.StorageBackend {
+	return n.backend
+}
+
+func (n *stateTestNode) Start(context.Context) error {
+	return nil
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	servertypes "github.com/berachain/beacon-kit/cli/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/storage/checkpoint"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagHeight is the flag of the height to export the state at.
+	flagHeight = "height"
+
+	// flagOut is the flag of the file to export the state to.
+	flagOut = "out"
+)
+
+// GetExportCmd returns a command exporting the beacon state at a given height
+// to an SSZ checkpoint file.
+//
+//nolint:lll // reads better if long description is one line
+func GetExportCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	var (
+		height int64
+		out    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the beacon state at a given height to an SSZ checkpoint file",
+		Long:  `Exports the beacon state at --height, by default the latest committed height, to an SSZ checkpoint file. Besides the state, the file holds the height, the app hash committed at that height, the fork version of the state and the header of the block at that height, whose state root is the root of the exported state. The node must not be running.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := clicontext.GetLoggerFromCmd(cmd)
+			app, err := OpenApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			cms := app.CommitMultiStore()
+			if !cmd.Flags().Changed(flagHeight) {
+				height = cms.LastCommitID().Version
+			}
+			if height <= 0 {
+				return errors.New("no committed state to export")
+			}
+
+			rms, ok := cms.(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("unsupported multistore %T", cms)
+			}
+			commitInfo, err := rms.GetCommitInfo(height)
+			if err != nil {
+				return fmt.Errorf("no state committed at height %d: %w", height, err)
+			}
+			ms, err := cms.CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return fmt.Errorf("state at height %d is not available: %w", height, err)
+			}
+			st, err := app.StorageBackend().
+				StateFromContext(NewContext(cmd, ms)).
+				GetMarshallable()
+			if err != nil {
+				return fmt.Errorf("failed to load beacon state at height %d: %w", height, err)
+			}
+
+			//#nosec:G115 // height is positive.
+			cp := checkpoint.New(uint64(height), common.NewRootFromBytes(commitInfo.Hash()), st)
+			if err = cp.WriteFile(out); err != nil {
+				return err
+			}
+
+			logger.Info(
+				"Exported beacon state",
+				"height", height,
+				"slot", st.Slot,
+				"fork_version", cp.ForkVersion,
+				"app_hash", cp.AppHash,
+				"state_root", cp.BlockHeader.GetStateRoot(),
+				"block_root", cp.BlockRoot(),
+				"file", out,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&height, flagHeight, 0, "height to export the state at (default latest height)")
+	cmd.Flags().StringVar(&out, flagOut, "state.ssz", "file to export the state to")
+	return cmd
+}
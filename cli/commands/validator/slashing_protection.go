@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/cli/context"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/storage/slashing"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// GetSlashingProtectionCmd returns a command for managing the slashing
+// protection database.
+func GetSlashingProtectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-protection",
+		Short: "Import and export the slashing protection database in the EIP-3076 interchange format",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getImportCmd(),
+		getExportCmd(),
+	)
+
+	return cmd
+}
+
+//nolint:lll // reads better if long description is one line
+func getImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [interchange-file]",
+		Short: "Imports an EIP-3076 interchange file into the slashing protection database",
+		Long:  `Imports an EIP-3076 interchange file into the slashing protection database. The node must not be running. Blocks conflicting with the recorded history are kept with an unknown signing root.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			interchange := &slashing.Interchange{}
+			if err = json.Unmarshal(bz, interchange); err != nil {
+				return errors.Wrap(err, "failed to decode interchange file")
+			}
+
+			store, err := openSlashingProtectionStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+			if err = store.Import(interchange); err != nil {
+				return err
+			}
+
+			cmd.Printf("Imported slashing protection history of %d keys\n", len(interchange.Data))
+			return nil
+		},
+	}
+}
+
+//nolint:lll // reads better if long description is one line
+func getExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [interchange-file]",
+		Short: "Exports the slashing protection database as an EIP-3076 interchange file",
+		Long:  `Exports the slashing protection database as an EIP-3076 interchange file. The node must not be running. If no file is given, the interchange is written to stdout.`,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openSlashingProtectionStore(cmd)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+			interchange, err := store.Export()
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(interchange, "", "  ")
+			if err != nil {
+				return err
+			}
+			if len(args) == 0 {
+				cmd.Println(string(bz))
+				return nil
+			}
+			//#nosec:G306 // the interchange file holds no secrets.
+			return os.WriteFile(args[0], bz, 0o644)
+		},
+	}
+}
+
+// openSlashingProtectionStore opens the slashing protection database in the
+// data directory of the node home.
+func openSlashingProtectionStore(cmd *cobra.Command) (*slashing.Store, error) {
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	db, err := dbm.NewDB(
+		slashing.DBName, dbm.PebbleDBBackend, filepath.Join(cfg.RootDir, "data"),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open slashing protection database")
+	}
+	return slashing.NewStore(db), nil
+}
@@ -37,6 +37,15 @@ const (
 	SuggestedFeeRecipient = builderRoot + "suggested-fee-recipient"
 	BuilderEnabled        = builderRoot + "enabled"
 	BuildPayloadTimeout   = builderRoot + "payload-timeout"
+	ProposerConfigFile    = builderRoot + "proposer-config-file"
+
+	// Relay Config.
+	relayRoot            = beaconKitRoot + "relay."
+	RelayURLs            = relayRoot + "urls"
+	RelayBidTimeout      = relayRoot + "bid-timeout"
+	RelayRevealTimeout   = relayRoot + "reveal-timeout"
+	RelayLocalValueBoost = relayRoot + "local-value-boost"
+	RelayGasLimit        = relayRoot + "gas-limit"
 
 	// Validator Config.
 	validatorRoot = beaconKitRoot + "validator."
@@ -45,6 +54,8 @@ const (
 	// Engine Config.
 	engineRoot              = beaconKitRoot + "engine."
 	RPCDialURL              = engineRoot + "rpc-dial-url"
+	RPCFallbackDialURLs     = engineRoot + "rpc-fallback-dial-urls"
+	RPCShadowNewPayload     = engineRoot + "rpc-shadow-new-payload"
 	RPCRetryInterval        = engineRoot + "rpc-retry-interval"
 	RPCMaxRetryInterval     = engineRoot + "rpc-max-retry-interval"
 	RPCTimeout              = engineRoot + "rpc-timeout"
@@ -52,6 +63,7 @@ const (
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	DepositFetchMaxSpan     = engineRoot + "deposit-fetch-max-span"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
@@ -69,12 +81,38 @@ const (
 	BlockStoreServiceEnabled            = blockStoreServiceRoot + "enabled"
 	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
 		"availability-window"
+	BlockStoreServiceArchiveMode = blockStoreServiceRoot + "archive-mode"
+
+	// Blob Store Config.
+	blobStoreRoot        = beaconKitRoot + "blob-store."
+	BlobStoreArchiveMode = blobStoreRoot + "archive-mode"
+	BlobStoreArchiveDir  = blobStoreRoot + "archive-dir"
 
 	// Node API Config.
-	nodeAPIRoot    = beaconKitRoot + "node-api."
-	NodeAPIEnabled = nodeAPIRoot + "enabled"
-	NodeAPIAddress = nodeAPIRoot + "address"
-	NodeAPILogging = nodeAPIRoot + "logging"
+	nodeAPIRoot             = beaconKitRoot + "node-api."
+	NodeAPIEnabled          = nodeAPIRoot + "enabled"
+	NodeAPIAddress          = nodeAPIRoot + "address"
+	NodeAPILogging          = nodeAPIRoot + "logging"
+	NodeAPIEventsBufferSize = nodeAPIRoot + "events-buffer-size"
+
+	// Signer Config.
+	signerRoot          = beaconKitRoot + "signer."
+	SignerBackend       = signerRoot + "backend"
+	SignerRemoteURL     = signerRoot + "remote-url"
+	SignerRemotePubkey  = signerRoot + "remote-pubkey"
+	SignerRemoteTimeout = signerRoot + "remote-timeout"
+	SignerTLSCAFile     = signerRoot + "tls-ca-file"
+	SignerTLSCertFile   = signerRoot + "tls-cert-file"
+	SignerTLSKeyFile    = signerRoot + "tls-key-file"
+
+	// Tracing Config.
+	tracingRoot        = beaconKitRoot + "tracing."
+	TracingEnabled     = tracingRoot + "enabled"
+	TracingExporter    = tracingRoot + "exporter"
+	TracingEndpoint    = tracingRoot + "endpoint"
+	TracingInsecure    = tracingRoot + "insecure"
+	TracingServiceName = tracingRoot + "service-name"
+	TracingSampleRatio = tracingRoot + "sample-ratio"
 
 	// BLS Config.
 	PrivValidatorKeyFile   = "priv_validator_key_file"
@@ -116,6 +154,26 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().Duration(
+		RPCHealthCheckInteval,
+		defaultCfg.Engine.RPCHealthCheckInterval,
+		"rpc health check interval",
+	)
+	startCmd.Flags().StringSlice(
+		RPCFallbackDialURLs,
+		nil,
+		"rpc dial urls of execution clients to fail over to, in order of priority",
+	)
+	startCmd.Flags().Bool(
+		RPCShadowNewPayload,
+		defaultCfg.Engine.RPCShadowNewPayload,
+		"also send new payloads to fallback execution clients and report disagreements",
+	)
+	startCmd.Flags().Uint64(
+		DepositFetchMaxSpan,
+		defaultCfg.Engine.DepositFetchMaxSpan,
+		"maximum number of execution blocks whose deposit logs are queried at once",
+	)
 	startCmd.Flags().Bool(
 		BuilderEnabled,
 		defaultCfg.PayloadBuilder.Enabled,
@@ -131,6 +189,36 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
 		"suggested fee recipient",
 	)
+	startCmd.Flags().String(
+		ProposerConfigFile,
+		defaultCfg.PayloadBuilder.ProposerConfigFile,
+		"path to a json file setting the fee recipient, gas limit and graffiti of each validator",
+	)
+	startCmd.Flags().StringSlice(
+		RelayURLs,
+		nil,
+		"urls of the builder relays to request execution payloads from",
+	)
+	startCmd.Flags().Duration(
+		RelayBidTimeout,
+		defaultCfg.Relay.BidTimeout,
+		"time waited for the bids of the builder relays",
+	)
+	startCmd.Flags().Duration(
+		RelayRevealTimeout,
+		defaultCfg.Relay.RevealTimeout,
+		"time waited for a builder relay to reveal a payload before falling back to the local payload",
+	)
+	startCmd.Flags().Uint64(
+		RelayLocalValueBoost,
+		defaultCfg.Relay.LocalValueBoost,
+		"percentage by which the local payload value is increased before being compared to bids",
+	)
+	startCmd.Flags().Uint64(
+		RelayGasLimit,
+		defaultCfg.Relay.GasLimit,
+		"gas limit registered with the builder relays",
+	)
 	startCmd.Flags().String(
 		KZGTrustedSetupPath,
 		defaultCfg.KZG.TrustedSetupPath,
@@ -166,6 +254,21 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.AvailabilityWindow,
 		"block service availability window",
 	)
+	startCmd.Flags().Bool(
+		BlockStoreServiceArchiveMode,
+		defaultCfg.BlockStoreService.ArchiveMode,
+		"block service archive mode",
+	)
+	startCmd.Flags().Bool(
+		BlobStoreArchiveMode,
+		defaultCfg.BlobStore.ArchiveMode,
+		"keep every blob sidecar, disabling pruning past the data availability period",
+	)
+	startCmd.Flags().String(
+		BlobStoreArchiveDir,
+		defaultCfg.BlobStore.ArchiveDir,
+		"read-only directory of blob archives serving the sidecars pruned from the store",
+	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -181,4 +284,74 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.NodeAPI.Logging,
 		"node api logging",
 	)
+	startCmd.Flags().Int(
+		NodeAPIEventsBufferSize,
+		defaultCfg.NodeAPI.EventsBufferSize,
+		"node api events buffer size per subscriber",
+	)
+	startCmd.Flags().String(
+		SignerBackend,
+		defaultCfg.Signer.Backend,
+		"block signer backend, either local or remote",
+	)
+	startCmd.Flags().String(
+		SignerRemoteURL,
+		defaultCfg.Signer.RemoteURL,
+		"remote signer url",
+	)
+	startCmd.Flags().String(
+		SignerRemotePubkey,
+		defaultCfg.Signer.RemotePubkey,
+		"public key of the remote signing key",
+	)
+	startCmd.Flags().Duration(
+		SignerRemoteTimeout,
+		defaultCfg.Signer.RemoteTimeout,
+		"remote signer request timeout",
+	)
+	startCmd.Flags().String(
+		SignerTLSCAFile,
+		defaultCfg.Signer.TLSCAFile,
+		"remote signer CA certificate file",
+	)
+	startCmd.Flags().String(
+		SignerTLSCertFile,
+		defaultCfg.Signer.TLSCertFile,
+		"remote signer client certificate file",
+	)
+	startCmd.Flags().String(
+		SignerTLSKeyFile,
+		defaultCfg.Signer.TLSKeyFile,
+		"remote signer client key file",
+	)
+	startCmd.Flags().Bool(
+		TracingEnabled,
+		defaultCfg.Tracing.Enabled,
+		"export opentelemetry traces",
+	)
+	startCmd.Flags().String(
+		TracingExporter,
+		defaultCfg.Tracing.Exporter,
+		"trace exporter, either otlp-grpc or otlp-http",
+	)
+	startCmd.Flags().String(
+		TracingEndpoint,
+		defaultCfg.Tracing.Endpoint,
+		"otlp collector endpoint",
+	)
+	startCmd.Flags().Bool(
+		TracingInsecure,
+		defaultCfg.Tracing.Insecure,
+		"disable tls for the otlp collector connection",
+	)
+	startCmd.Flags().String(
+		TracingServiceName,
+		defaultCfg.Tracing.ServiceName,
+		"service name of the exported traces",
+	)
+	startCmd.Flags().Float64(
+		TracingSampleRatio,
+		defaultCfg.Tracing.SampleRatio,
+		"fraction of the traces which are exported",
+	)
 }
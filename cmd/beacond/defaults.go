@@ -38,19 +38,25 @@ func DefaultComponents() []any {
 		components.ProvideConfig,
 		components.ProvideServerConfig,
 		components.ProvideDepositStore,
+		components.ProvideEventBroker,
+		components.ProvideEventPublisher,
 		components.ProvideEngineClient,
 		components.ProvideExecutionEngine,
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder,
+		components.ProvideRelays,
+		components.ProvideProposerRegistry,
 		components.ProvideReportingService,
 		components.ProvideCometBFTService,
 		components.ProvideServiceRegistry,
 		components.ProvideSidecarFactory,
+		components.ProvideSlashingProtectionStore,
 		components.ProvideStateProcessor,
 		components.ProvideKVStore,
 		components.ProvideStorageBackend,
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
+		components.ProvideTracingService,
 		components.ProvideTrustedSetup,
 		components.ProvideValidatorService,
 		components.ProvideShutDownService,
@@ -70,6 +76,7 @@ func DefaultComponents() []any {
 		components.ProvideNodeAPIEventsHandler,
 		components.ProvideNodeAPINodeHandler,
 		components.ProvideNodeAPIProofHandler,
+		components.ProvideNodeAPIValidatorHandler,
 	)
 
 	return c
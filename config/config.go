@@ -27,12 +27,16 @@ import (
 	"github.com/berachain/beacon-kit/config/template"
 	viperlib "github.com/berachain/beacon-kit/config/viper"
 	"github.com/berachain/beacon-kit/da/kzg"
+	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/errors"
 	engineclient "github.com/berachain/beacon-kit/execution/client"
 	log "github.com/berachain/beacon-kit/log/phuslu"
 	blockstore "github.com/berachain/beacon-kit/node-api/block_store"
 	"github.com/berachain/beacon-kit/node-api/server"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -58,9 +62,13 @@ func DefaultConfig() *Config {
 		Logger:            log.DefaultConfig(),
 		KZG:               kzg.DefaultConfig(),
 		PayloadBuilder:    builder.DefaultConfig(),
+		Relay:             relay.DefaultConfig(),
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
+		BlobStore:         dastore.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		Signer:            signer.DefaultConfig(),
+		Tracing:           tracing.DefaultConfig(),
 	}
 }
 
@@ -81,12 +89,20 @@ type Config struct {
 	KZG kzg.Config `mapstructure:"kzg"`
 	// PayloadBuilder is the configuration for the local build payload timeout.
 	PayloadBuilder builder.Config `mapstructure:"payload-builder"`
+	// Relay is the configuration for the relays of external block builders.
+	Relay relay.Config `mapstructure:"relay"`
 	// Validator is the configuration for the validator client.
 	Validator validator.Config `mapstructure:"validator"`
 	// BlockStoreService is the configuration for the block store service.
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
+	// BlobStore is the configuration for the blob sidecar store.
+	BlobStore dastore.Config `mapstructure:"blob-store"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Signer is the configuration for the BLS signer used for block proposals.
+	Signer signer.Config `mapstructure:"signer"`
+	// Tracing is the configuration for OpenTelemetry tracing.
+	Tracing tracing.Config `mapstructure:"tracing"`
 }
 
 // GetEngine returns the execution client configuration.
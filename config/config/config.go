@@ -83,6 +83,20 @@ type Config struct {
 
 	// Telemetry defines the application telemetry configuration
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
+
+	// StateSync defines the state sync snapshot configuration.
+	StateSync StateSyncConfig `mapstructure:"state-sync"`
+}
+
+// StateSyncConfig defines the state sync snapshot configuration.
+type StateSyncConfig struct {
+	// SnapshotInterval sets the interval at which state sync snapshots are
+	// taken. A value of 0 disables snapshots.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+
+	// SnapshotKeepRecent sets the number of recent state sync snapshots to
+	// keep and serve. A value of 0 keeps all snapshots.
+	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
 // DefaultConfig returns server's default configuration.
@@ -102,6 +116,11 @@ func DefaultConfig() *Config {
 			Enabled:      false,
 			GlobalLabels: [][]string{},
 		},
+		StateSync: StateSyncConfig{
+			SnapshotInterval: 0,
+			//nolint:mnd // default number of snapshots to keep.
+			SnapshotKeepRecent: 2,
+		},
 	}
 }
 
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/cli/commands/server/types"
 	"github.com/berachain/beacon-kit/cli/flags"
 	viperlib "github.com/berachain/beacon-kit/config/viper"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cast"
 	"github.com/spf13/viper"
@@ -92,6 +93,10 @@ func loadSpecData(path string) (*chain.SpecData, error) {
 		return nil, fmt.Errorf("failed to read config: %w", err)
 	}
 
+	if err := setLegacyForks(v); err != nil {
+		return nil, err
+	}
+
 	// Ensure all required fields are set.
 	specData := chain.SpecData{}
 	specType := reflect.TypeOf(specData)
@@ -113,3 +118,41 @@ func loadSpecData(path string) (*chain.SpecData, error) {
 
 	return &specData, nil
 }
+
+// legacyForkKeys are the keys of the per-fork values of chain-spec files
+// written before the fork schedule was introduced.
+//
+//nolint:gochecknoglobals // read-only.
+var legacyForkKeys = []string{
+	"deneb-one-fork-time",
+	"electra-fork-time",
+	"electra-one-fork-time",
+	"evm-inflation-address-deneb-one",
+	"evm-inflation-per-block-deneb-one",
+}
+
+// setLegacyForks builds the fork schedule of a chain-spec file which sets the
+// per-fork values of legacyForkKeys rather than a fork schedule.
+func setLegacyForks(v *viper.Viper) error {
+	if v.IsSet("forks") || !v.IsSet(legacyForkKeys[0]) {
+		return nil
+	}
+	for _, key := range legacyForkKeys {
+		if !v.IsSet(key) {
+			return fmt.Errorf("missing required configuration for key: %s", key)
+		}
+	}
+
+	v.Set("forks", []map[string]any{
+		{"version": version.Deneb().String(), "time": 0},
+		{
+			"version":                 version.Deneb1().String(),
+			"time":                    v.Get("deneb-one-fork-time"),
+			"evm-inflation-address":   v.Get("evm-inflation-address-deneb-one"),
+			"evm-inflation-per-block": v.Get("evm-inflation-per-block-deneb-one"),
+		},
+		{"version": version.Electra().String(), "time": v.Get("electra-fork-time")},
+		{"version": version.Electra1().String(), "time": v.Get("electra-one-fork-time")},
+	})
+	return nil
+}
@@ -21,6 +21,9 @@
 package spec_test
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/berachain/beacon-kit/cli/flags"
@@ -127,3 +130,38 @@ func TestCreateChainSpec_File(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, devnetSpec, dcs, "the chain spec loaded from TOML does not match the devnet spec")
 }
+
+func TestCreateChainSpec_File_LegacyForks(t *testing.T) {
+	t.Parallel()
+
+	// Rewrite the mainnet spec file with the per-fork keys used before the fork schedule.
+	bz, err := os.ReadFile("../../testing/networks/80094/spec.toml")
+	require.NoError(t, err)
+	content, _, found := strings.Cut(string(bz), "# Fork schedule")
+	require.True(t, found)
+	legacy := `deneb-one-fork-time = 1_738_415_507
+electra-fork-time = 1_749_056_400
+electra-one-fork-time = 1_756_915_200
+evm-inflation-address-deneb-one = "0x656b95E550C07a9ffe548bd4085c72418Ceb1dba"
+evm-inflation-per-block-deneb-one = 5_750_000_000
+`
+	path := filepath.Join(t.TempDir(), "spec.toml")
+	require.NoError(t, os.WriteFile(path, []byte(legacy+content), 0o600))
+
+	opts := dummyAppOptions{values: map[string]interface{}{
+		flags.ChainSpec:         "file",
+		flags.ChainSpecFilePath: path,
+	}}
+	mcs, err := spec.Create(opts)
+	require.NoError(t, err)
+
+	mainnetSpec, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	require.Equal(t, mainnetSpec, mcs, "the chain spec loaded from legacy TOML does not match the mainnet spec")
+
+	// Legacy files must set all per-fork keys.
+	legacy = strings.Replace(legacy, "electra-fork-time = 1_749_056_400\n", "", 1)
+	require.NoError(t, os.WriteFile(path, []byte(legacy+content), 0o600))
+	_, err = spec.Create(opts)
+	require.ErrorContains(t, err, "missing required configuration for key: electra-fork-time")
+}
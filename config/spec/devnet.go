@@ -23,6 +23,7 @@ package spec
 import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -76,17 +77,19 @@ func DevnetChainSpecData() *chain.SpecData {
 
 	// Fork timings are set to facilitate local testing across fork versions.
 	specData.GenesisTime = devnetGenesisTime
-	specData.Deneb1ForkTime = devnetDeneb1ForkTime
-	specData.ElectraForkTime = devnetElectraForkTime
-	specData.Electra1ForkTime = devnetElectra1ForkTime
+	specData.Fork(version.Deneb1()).Time = devnetDeneb1ForkTime
+	specData.Fork(version.Electra()).Time = devnetElectraForkTime
+	specData.Fork(version.Electra1()).Time = devnetElectra1ForkTime
 
 	// EVM inflation is different from mainnet to test.
 	specData.EVMInflationAddressGenesis = common.NewExecutionAddressFromHex(devnetEVMInflationAddress)
 	specData.EVMInflationPerBlockGenesis = devnetEVMInflationPerBlock
 
 	// EVM inflation is different from mainnet for now, after the Deneb1 fork.
-	specData.EVMInflationAddressDeneb1 = common.NewExecutionAddressFromHex(devnetEVMInflationAddressDeneb1)
-	specData.EVMInflationPerBlockDeneb1 = devnetEVMInflationPerBlockDeneb1
+	deneb1EVMInflationAddress := common.NewExecutionAddressFromHex(devnetEVMInflationAddressDeneb1)
+	deneb1EVMInflationPerBlock := uint64(devnetEVMInflationPerBlockDeneb1)
+	specData.Fork(version.Deneb1()).EVMInflationAddress = &deneb1EVMInflationAddress
+	specData.Fork(version.Deneb1()).EVMInflationPerBlock = &deneb1EVMInflationPerBlock
 
 	// Staking is different from mainnet for now.
 	specData.MaxEffectiveBalance = devnetMaxStakeAmount
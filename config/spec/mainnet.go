@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/delay"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -106,6 +107,12 @@ const (
 
 // MainnetChainSpecData is the chain.SpecData for the Berachain mainnet.
 func MainnetChainSpecData() *chain.SpecData {
+	// EVM inflation values changed by the Deneb1 fork.
+	var (
+		deneb1EVMInflationAddress  = common.NewExecutionAddressFromHex(mainnetEVMInflationAddressDeneb1)
+		deneb1EVMInflationPerBlock = uint64(mainnetEVMInflationPerBlockDeneb1)
+	)
+
 	specData := &chain.SpecData{
 		Config: delay.DefaultConfig(),
 
@@ -140,10 +147,18 @@ func MainnetChainSpecData() *chain.SpecData {
 		TargetSecondsPerEth1Block: defaultTargetSecondsPerEth1Block,
 
 		// Fork-related values.
-		GenesisTime:      mainnetGenesisTime,
-		Deneb1ForkTime:   mainnetDeneb1ForkTime,
-		ElectraForkTime:  mainnetElectraForkTime,
-		Electra1ForkTime: mainnetElectra1ForkTime,
+		GenesisTime: mainnetGenesisTime,
+		Forks: []chain.Fork{
+			{Version: version.Deneb(), Time: 0},
+			{
+				Version:              version.Deneb1(),
+				Time:                 mainnetDeneb1ForkTime,
+				EVMInflationAddress:  &deneb1EVMInflationAddress,
+				EVMInflationPerBlock: &deneb1EVMInflationPerBlock,
+			},
+			{Version: version.Electra(), Time: mainnetElectraForkTime},
+			{Version: version.Electra1(), Time: mainnetElectra1ForkTime},
+		},
 
 		// State list length constants.
 		EpochsPerHistoricalVector: defaultEpochsPerHistoricalVector,
@@ -167,10 +182,6 @@ func MainnetChainSpecData() *chain.SpecData {
 		EVMInflationAddressGenesis:  common.NewExecutionAddressFromHex(mainnetEVMInflationAddress),
 		EVMInflationPerBlockGenesis: mainnetEVMInflationPerBlock,
 
-		// Deneb1 values.
-		EVMInflationAddressDeneb1:  common.NewExecutionAddressFromHex(mainnetEVMInflationAddressDeneb1),
-		EVMInflationPerBlockDeneb1: mainnetEVMInflationPerBlockDeneb1,
-
 		// Electra values.
 		MinActivationBalance:             mainnetMinActivationBalance,
 		MinValidatorWithdrawabilityDelay: mainnetMinValidatorWithdrawabilityDelay,
@@ -22,6 +22,7 @@ package spec
 
 import (
 	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // TestnetChainSpecData is the chain.SpecData for Berachain's public testnet, Bepolia.
@@ -37,17 +38,17 @@ func TestnetChainSpecData() *chain.SpecData {
 	// Deneb1 fork timing on Bepolia. This is calculated based on the timestamp of the first bepolia
 	// epoch, block 192, which was used to initiate the fork when beacon-kit forked by epoch instead
 	// of by timestamp.
-	specData.Deneb1ForkTime = 1_740_090_694
+	specData.Fork(version.Deneb1()).Time = 1_740_090_694
 
 	// Timestamp of the Electra fork on Bepolia.
-	specData.ElectraForkTime = 1_746_633_600
+	specData.Fork(version.Electra()).Time = 1_746_633_600
 
 	// Enable stable block time before the Electra1 fork.
 	specData.Config.ConsensusUpdateHeight = 7_768_334
 	specData.Config.ConsensusEnableHeight = 7_768_335
 
 	// Timestamp of the Electra1 fork on Bepolia.
-	specData.Electra1ForkTime = 1_754_496_000
+	specData.Fork(version.Electra1()).Time = 1_754_496_000
 
 	return specData
 }
@@ -37,9 +37,25 @@ chain-spec-file = "{{ .BeaconKit.ChainSpecFilePath }}"
 shutdown-timeout = "{{ .BeaconKit.ShutdownTimeout }}"
 
 [beacon-kit.engine]
-# HTTP url of the execution client JSON-RPC endpoint.
+# Url of the execution client JSON-RPC endpoint. Supported schemes are http://,
+# https://, ws://, wss:// and ipc:// (e.g. ipc:///path/to/geth.ipc). IPC
+# connections are local and do not use JWT authentication. Over ws://, wss://
+# and ipc:// deposits are streamed by subscription instead of polled.
 rpc-dial-url = "{{ .BeaconKit.Engine.RPCDialURL }}"
 
+# Urls of execution clients to fail over to, in order of priority, when the
+# execution client at rpc-dial-url is unavailable.
+rpc-fallback-dial-urls = [{{ range $i, $url := .BeaconKit.Engine.RPCFallbackDialURLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Also send new payloads and forkchoice updates to the healthy fallback
+# execution clients, reporting any disagreement on the payload status. This is
+# required to keep the fallbacks in sync: without it they must catch up on the
+# missing blocks after failing over.
+rpc-shadow-new-payload = {{ .BeaconKit.Engine.RPCShadowNewPayload }}
+
+# Interval for the execution client health checks.
+rpc-health-check-interval = "{{ .BeaconKit.Engine.RPCHealthCheckInterval }}"
+
 # RPC timeout for execution client requests.
 rpc-timeout = "{{ .BeaconKit.Engine.RPCTimeout }}"
 
@@ -52,6 +68,9 @@ rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# Maximum number of execution blocks whose deposit logs are queried at once.
+deposit-fetch-max-span = {{ .BeaconKit.Engine.DepositFetchMaxSpan }}
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -81,11 +100,39 @@ enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
 # from this node.
 suggested-fee-recipient = "{{.BeaconKit.PayloadBuilder.SuggestedFeeRecipient}}"
 
+# Path to an optional JSON file setting the fee recipient, gas limit and graffiti
+# of each validator, overriding suggested-fee-recipient, the relay gas-limit and
+# the validator graffiti. Fee recipients set through the prepare_beacon_proposer
+# endpoint of the node API take precedence over the file.
+proposer-config-file = "{{.BeaconKit.PayloadBuilder.ProposerConfigFile}}"
+
 # The timeout for local build payload. This should match, or be slightly less
 # than the configured timeout on your execution client. It also must be less than
 # timeout_proposal in the CometBFT configuration.
 payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
+[beacon-kit.relay]
+# Base urls of the relays of external block builders. The execution payloads
+# they bid are proposed in place of the local payload when more valuable.
+# The public key of a relay may be given as the user of its url, as in
+# "https://0xa1b2...@relay.example.com", to only accept bids signed by it.
+# External builders are disabled if no url is set.
+urls = [{{ range $i, $url := .BeaconKit.Relay.URLs }}{{ if $i }}, {{ end }}"{{ $url }}"{{ end }}]
+
+# Time waited for the bids of the relays.
+bid-timeout = "{{ .BeaconKit.Relay.BidTimeout }}"
+
+# Time waited for a relay to reveal the execution payload of a signed blinded
+# block, before falling back to the local payload.
+reveal-timeout = "{{ .BeaconKit.Relay.RevealTimeout }}"
+
+# Percentage by which the value of the local payload is increased before being
+# compared to bids.
+local-value-boost = {{ .BeaconKit.Relay.LocalValueBoost }}
+
+# Gas limit registered with the relays.
+gas-limit = {{ .BeaconKit.Relay.GasLimit }}
+
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
 graffiti = "{{ .BeaconKit.Validator.Graffiti }}"
@@ -101,6 +148,19 @@ enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 # AvailabilityWindow is the number of slots to keep in the store.
 availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
 
+# ArchiveMode keeps every finalized block in the store, ignoring the availability window.
+archive-mode = "{{ .BeaconKit.BlockStoreService.ArchiveMode }}"
+
+[beacon-kit.blob-store]
+# ArchiveMode keeps every blob sidecar in the store, disabling the pruning of
+# the sidecars past the data availability period.
+archive-mode = "{{ .BeaconKit.BlobStore.ArchiveMode }}"
+
+# ArchiveDir is the path to a read-only directory of blob archives, written by
+# 'blobs export', from which the sidecars pruned from the store are served by
+# the node API. Archives are not served if empty.
+archive-dir = "{{ .BeaconKit.BlobStore.ArchiveDir }}"
+
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -110,4 +170,58 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# EventsBufferSize is the number of events buffered for each event stream
+# subscriber. Subscribers falling further behind are disconnected.
+events-buffer-size = {{ .BeaconKit.NodeAPI.EventsBufferSize }}
+
+[beacon-kit.signer]
+# Backend is the signer used for block proposals and randao reveals.
+# Options are "local", which signs with the private validator key file, and
+# "remote", which signs with a Web3Signer-compatible remote signer and reads
+# no key from disk.
+# CometBFT consensus votes are signed by CometBFT's private validator. With the
+# remote backend, keep the validator key off disk by setting
+# priv_validator_laddr in config.toml to an external CometBFT signer holding
+# the same BLS key, in which case the private validator key file is unused.
+backend = "{{ .BeaconKit.Signer.Backend }}"
+
+# RemoteURL is the base url of the remote signer.
+remote-url = "{{ .BeaconKit.Signer.RemoteURL }}"
+
+# RemotePubkey is the public key of the remote signing key, which must be the
+# key of the CometBFT validator. If empty, the remote signer must hold a single
+# key, which is used.
+remote-pubkey = "{{ .BeaconKit.Signer.RemotePubkey }}"
+
+# RemoteTimeout is the timeout for individual remote signer requests.
+remote-timeout = "{{ .BeaconKit.Signer.RemoteTimeout }}"
+
+# TLSCAFile is the CA certificate used to verify the remote signer.
+tls-ca-file = "{{ .BeaconKit.Signer.TLSCAFile }}"
+
+# TLSCertFile and TLSKeyFile are the client certificate and key presented to
+# the remote signer for mutual TLS.
+tls-cert-file = "{{ .BeaconKit.Signer.TLSCertFile }}"
+tls-key-file = "{{ .BeaconKit.Signer.TLSKeyFile }}"
+
+[beacon-kit.tracing]
+# Enabled determines if OpenTelemetry traces of block processing are exported.
+enabled = "{{ .BeaconKit.Tracing.Enabled }}"
+
+# Exporter is the protocol of the OTLP collector, either "otlp-grpc" or
+# "otlp-http".
+exporter = "{{ .BeaconKit.Tracing.Exporter }}"
+
+# Endpoint is the host and port of the OTLP collector.
+endpoint = "{{ .BeaconKit.Tracing.Endpoint }}"
+
+# Insecure disables TLS for the connection to the OTLP collector.
+insecure = "{{ .BeaconKit.Tracing.Insecure }}"
+
+# ServiceName is the service name the traces are reported under.
+service-name = "{{ .BeaconKit.Tracing.ServiceName }}"
+
+# SampleRatio is the fraction of the traces which are exported, between 0 and 1.
+sample-ratio = {{ .BeaconKit.Tracing.SampleRatio }}
 `
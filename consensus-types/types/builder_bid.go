@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/constraints"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/karalabe/ssz"
+)
+
+// Compile-time assertions to ensure BuilderBid implements necessary interfaces.
+var _ ssz.DynamicObject = (*BuilderBid)(nil)
+
+// BuilderBid is the bid of an external block builder for the execution
+// payload of a block, as defined in the builder specs.
+// https://github.com/ethereum/builder-specs/blob/main/specs/electra/builder.md#builderbid
+type BuilderBid struct {
+	constraints.Versionable
+
+	// Header is the header of the execution payload being bid.
+	Header *ExecutionPayloadHeader
+	// BlobKzgCommitments are the commitments to the blobs of the payload.
+	BlobKzgCommitments []eip4844.KZGCommitment
+	// ExecutionRequests are the execution requests of the payload, from
+	// Electra onwards.
+	ExecutionRequests *ExecutionRequests
+	// Value is the value of the payload to the proposer, in wei.
+	Value *math.U256
+	// Pubkey is the public key of the builder.
+	Pubkey crypto.BLSPubkey
+}
+
+// SignedBuilderBid is a BuilderBid signed by the builder.
+type SignedBuilderBid struct {
+	Message   *BuilderBid
+	Signature crypto.BLSSignature
+}
+
+// ComputeBuilderSigningRoot computes the signing root of an object signed in
+// the builder domain, which is independent of the fork and of the chain.
+// https://github.com/ethereum/builder-specs/blob/main/specs/bellatrix/builder.md#signing
+func ComputeBuilderSigningRoot(
+	sszObject interface{ HashTreeRoot() common.Root },
+	genesisForkVersion common.Version,
+	domainTypeApplicationBuilder common.DomainType,
+) common.Root {
+	domain := NewForkData(genesisForkVersion, common.Root{}).ComputeDomain(domainTypeApplicationBuilder)
+	return ComputeSigningRoot(sszObject, domain)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the BuilderBid in SSZ.
+func (b *BuilderBid) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // header and commitments offsets, value and pubkey.
+	size := uint32(4 + 4 + 32 + 48)
+	includeExecRequest := version.EqualsOrIsAfter(b.GetForkVersion(), version.Electra())
+	if includeExecRequest {
+		size += constants.SSZOffsetSize
+	}
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Header)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if includeExecRequest {
+		size += ssz.SizeDynamicObject(siz, b.ExecutionRequests)
+	}
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the BuilderBid.
+func (b *BuilderBid) DefineSSZ(codec *ssz.Codec) {
+	includeExecRequest := version.EqualsOrIsAfter(b.GetForkVersion(), version.Electra())
+
+	ssz.DefineDynamicObjectOffset(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock)
+	if includeExecRequest {
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	}
+	ssz.DefineUint256(codec, &b.Value)
+	ssz.DefineStaticBytes(codec, &b.Pubkey)
+
+	ssz.DefineDynamicObjectContent(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, constants.MaxBlobCommitmentsPerBlock)
+	if includeExecRequest {
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	}
+}
+
+// HashTreeRoot computes the Merkleization of the BuilderBid.
+func (b *BuilderBid) HashTreeRoot() common.Root {
+	return ssz.HashSequential(b)
+}
@@ -55,8 +55,18 @@ func CreateAndSignDepositMessage(
 		Credentials: credentials,
 		Amount:      amount,
 	}
-	signingRoot := ComputeSigningRoot(depositMessage, domain)
-	signature, err := signer.Sign(signingRoot[:])
+	signature, err := crypto.SignRequest(signer, &crypto.SigningRequest{
+		Type:                  crypto.SigningTypeDeposit,
+		SigningRoot:           ComputeSigningRoot(depositMessage, domain),
+		ForkVersion:           forkData.CurrentVersion,
+		GenesisValidatorsRoot: forkData.GenesisValidatorsRoot,
+		Deposit: &crypto.SigningDeposit{
+			Pubkey:                depositMessage.Pubkey,
+			WithdrawalCredentials: common.Bytes32(credentials),
+			Amount:                amount,
+			GenesisForkVersion:    forkData.CurrentVersion,
+		},
+	})
 	if err != nil {
 		return nil, crypto.BLSSignature{}, err
 	}
@@ -47,6 +47,10 @@ var (
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
 
+	// ErrPayloadHeaderMismatch is an error for when an execution payload does
+	// not match the execution payload header it is expected to reveal.
+	ErrPayloadHeaderMismatch = errors.New("execution payload does not match header")
+
 	// ErrInvalidValidatorStatus is an error for when the validator status is invalid.
 	ErrInvalidValidatorStatus = errors.New("invalid validator status")
 
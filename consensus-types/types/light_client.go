@@ -0,0 +1,502 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// ExecutionPositionBody is the position of the ExecutionPayload in the
+	// block body.
+	ExecutionPositionBody uint64 = 9
+
+	// ExecutionGIndexBody is the generalized index of the ExecutionPayload in
+	// the block body. It remains consistent for all Deneb and Electra forks.
+	ExecutionGIndexBody = 25
+
+	// ExecutionBranchDepth is the depth of the proof of the ExecutionPayload
+	// in the block body.
+	ExecutionBranchDepth = 4
+
+	// CommitSignatureSize is the size of the CommitSignature object in bytes.
+	// Total size: BlockIDFlag (1) + ValidatorAddress (20) + Timestamp (8) +
+	// Signature (96).
+	CommitSignatureSize = 125
+
+	// LightClientValidatorSize is the size of the LightClientValidator object
+	// in bytes. Total size: Pubkey (48) + VotingPower (8).
+	LightClientValidatorSize = 56
+
+	// MaxCometBFTHeaderSize is the maximum size of the protobuf encoding of a
+	// CometBFT header.
+	MaxCometBFTHeaderSize = 4096
+
+	// MaxCometBFTBlockSize is the maximum size of a CometBFT block, and so of
+	// the transaction carrying a beacon block.
+	MaxCometBFTBlockSize = 104857600
+
+	// MaxCometBFTTxBranchDepth is the maximum depth of the proof of a
+	// transaction against the data hash of a CometBFT header.
+	MaxCometBFTTxBranchDepth = 32
+)
+
+// Compile-time assertions to ensure the light client types implement
+// necessary interfaces.
+var (
+	_ ssz.DynamicObject = (*LightClientHeader)(nil)
+	_ ssz.DynamicObject = (*LightClientCometBFTHeader)(nil)
+	_ ssz.StaticObject  = (*LightClientValidator)(nil)
+	_ ssz.StaticObject  = (*CommitSignature)(nil)
+	_ ssz.DynamicObject = (*LightClientCommit)(nil)
+	_ ssz.DynamicObject = (*LightClientBootstrap)(nil)
+	_ ssz.DynamicObject = (*LightClientUpdate)(nil)
+	_ ssz.DynamicObject = (*LightClientFinalityUpdate)(nil)
+	_ ssz.DynamicObject = (*LightClientOptimisticUpdate)(nil)
+)
+
+/* -------------------------------------------------------------------------- */
+/*                              LightClientHeader                             */
+/* -------------------------------------------------------------------------- */
+
+// LightClientHeader is the header of a beacon block together with its
+// execution payload header, proven against the block body root, and the
+// CometBFT header of the block carrying it.
+type LightClientHeader struct {
+	// Beacon is the header of the beacon block.
+	Beacon *BeaconBlockHeader
+	// Execution is the header of the execution payload of the block.
+	Execution *ExecutionPayloadHeader
+	// ExecutionBranch proves Execution against Beacon.BodyRoot.
+	ExecutionBranch [ExecutionBranchDepth]common.Root
+	// CometBFT is the CometBFT header of the block carrying the beacon block,
+	// which commits and validator sets refer to.
+	CometBFT *LightClientCometBFTHeader
+}
+
+// NewLightClientHeader builds the LightClientHeader of the given block, carried
+// by the CometBFT block of the given header.
+func NewLightClientHeader(
+	blk *BeaconBlock,
+	cometBFT *LightClientCometBFTHeader,
+) (*LightClientHeader, error) {
+	body := blk.GetBody()
+	execution, err := body.GetExecutionPayload().ToHeader()
+	if err != nil {
+		return nil, err
+	}
+	branch, err := buildExecutionBranch(body)
+	if err != nil {
+		return nil, err
+	}
+	return &LightClientHeader{
+		Beacon:          blk.GetHeader(),
+		Execution:       execution,
+		ExecutionBranch: branch,
+		CometBFT:        cometBFT,
+	}, nil
+}
+
+// buildExecutionBranch builds the proof of the execution payload in the body.
+func buildExecutionBranch(body *BeaconBlockBody) ([ExecutionBranchDepth]common.Root, error) {
+	var branch [ExecutionBranchDepth]common.Root
+	tlrs, err := body.GetTopLevelRoots()
+	if err != nil {
+		return branch, err
+	}
+
+	// GetTopLevelRoots leaves the KZG commitments root blank, but it is a
+	// sibling in the proof of the execution payload.
+	commitmentsTree, err := merkle.NewTreeWithMaxLeaves[common.Root](
+		body.GetBlobKzgCommitments().Leafify(),
+		constants.MaxBlobCommitmentsPerBlock,
+	)
+	if err != nil {
+		return branch, err
+	}
+	tlrs[KZGPosition] = commitmentsTree.HashTreeRoot()
+
+	tree, err := merkle.NewTreeWithMaxLeaves[common.Root](tlrs, body.Length()-1)
+	if err != nil {
+		return branch, err
+	}
+	proof, err := tree.MerkleProof(ExecutionPositionBody)
+	if err != nil {
+		return branch, err
+	}
+	copy(branch[:], proof)
+	return branch, nil
+}
+
+// SizeSSZ returns the size of the LightClientHeader in SSZ.
+func (h *LightClientHeader) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := BeaconBlockHeaderSize + 2*constants.SSZOffsetSize + ExecutionBranchDepth*bytes.B32Size
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeDynamicObject(siz, h.Execution) + ssz.SizeDynamicObject(siz, h.CometBFT)
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientHeader.
+func (h *LightClientHeader) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &h.Beacon)
+	ssz.DefineDynamicObjectOffset(codec, &h.Execution)
+	ssz.DefineUnsafeArrayOfStaticBytes(codec, h.ExecutionBranch[:])
+	ssz.DefineDynamicObjectOffset(codec, &h.CometBFT)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &h.Execution)
+	ssz.DefineDynamicObjectContent(codec, &h.CometBFT)
+}
+
+// GetForkVersion returns the fork version of the header's block.
+func (h *LightClientHeader) GetForkVersion() common.Version {
+	return h.Execution.GetForkVersion()
+}
+
+/* -------------------------------------------------------------------------- */
+/*                          LightClientCometBFTHeader                         */
+/* -------------------------------------------------------------------------- */
+
+// LightClientCometBFTHeader is the header of the CometBFT block carrying a
+// beacon block, together with the proof of the transaction of the beacon
+// block. Commits sign the hash of the header, which commits to the
+// transactions of the block through its data hash, and to the validator sets
+// signing the block and the next one.
+type LightClientCometBFTHeader struct {
+	// Header is the protobuf encoding of the CometBFT header.
+	Header []byte
+	// BlockTx is the transaction carrying the beacon block, which is the SSZ
+	// encoding of the signed beacon block.
+	BlockTx []byte
+	// TxIndex is the index of BlockTx among the transactions of the block.
+	TxIndex uint64
+	// TxTotal is the number of transactions of the block.
+	TxTotal uint64
+	// TxBranch proves the hash of BlockTx against the data hash of Header.
+	TxBranch []common.Root
+}
+
+// SizeSSZ returns the size of the LightClientCometBFTHeader in SSZ.
+func (h *LightClientCometBFTHeader) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 3*constants.SSZOffsetSize + 8 + 8
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicBytes(siz, h.Header)
+	size += ssz.SizeDynamicBytes(siz, h.BlockTx)
+	size += ssz.SizeSliceOfStaticBytes(siz, h.TxBranch)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientCometBFTHeader.
+func (h *LightClientCometBFTHeader) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicBytesOffset(codec, &h.Header, MaxCometBFTHeaderSize)
+	ssz.DefineDynamicBytesOffset(codec, &h.BlockTx, MaxCometBFTBlockSize)
+	ssz.DefineUint64(codec, &h.TxIndex)
+	ssz.DefineUint64(codec, &h.TxTotal)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &h.TxBranch, MaxCometBFTTxBranchDepth)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicBytesContent(codec, &h.Header, MaxCometBFTHeaderSize)
+	ssz.DefineDynamicBytesContent(codec, &h.BlockTx, MaxCometBFTBlockSize)
+	ssz.DefineSliceOfStaticBytesContent(codec, &h.TxBranch, MaxCometBFTTxBranchDepth)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            LightClientValidator                            */
+/* -------------------------------------------------------------------------- */
+
+// LightClientValidator is a member of the CometBFT validator set.
+type LightClientValidator struct {
+	// Pubkey is the BLS public key of the validator.
+	Pubkey crypto.BLSPubkey
+	// VotingPower is the CometBFT voting power of the validator.
+	VotingPower uint64
+}
+
+// SizeSSZ returns the size of the LightClientValidator in SSZ.
+func (*LightClientValidator) SizeSSZ(*ssz.Sizer) uint32 {
+	return LightClientValidatorSize
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientValidator.
+func (v *LightClientValidator) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &v.Pubkey)
+	ssz.DefineUint64(codec, &v.VotingPower)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              LightClientCommit                             */
+/* -------------------------------------------------------------------------- */
+
+// CommitSignature is the precommit of a validator in a CometBFT commit.
+type CommitSignature struct {
+	// BlockIDFlag tells whether the validator voted for the block.
+	BlockIDFlag uint8
+	// ValidatorAddress is the CometBFT address of the validator.
+	ValidatorAddress bytes.B20
+	// Timestamp is the time of the vote, in unix nanoseconds.
+	Timestamp uint64
+	// Signature is the BLS signature of the vote.
+	Signature crypto.BLSSignature
+}
+
+// SizeSSZ returns the size of the CommitSignature in SSZ.
+func (*CommitSignature) SizeSSZ(*ssz.Sizer) uint32 {
+	return CommitSignatureSize
+}
+
+// DefineSSZ defines the SSZ encoding for the CommitSignature.
+func (s *CommitSignature) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint8(codec, &s.BlockIDFlag)
+	ssz.DefineStaticBytes(codec, &s.ValidatorAddress)
+	ssz.DefineUint64(codec, &s.Timestamp)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// LightClientCommit is the CometBFT commit of a block. Since beacon-kit has
+// single slot finality, it takes the place of the sync aggregate: validators
+// holding more than 2/3 of the voting power signed the CometBFT block that
+// carries the beacon block at the same height.
+type LightClientCommit struct {
+	// Height is the height of the committed block.
+	Height uint64
+	// Round is the consensus round in which the block was committed.
+	Round uint32
+	// BlockHash is the hash of the CometBFT header of the block.
+	BlockHash common.Root
+	// PartSetTotal is the number of parts of the CometBFT block.
+	PartSetTotal uint32
+	// PartSetHash is the hash of the parts of the CometBFT block.
+	PartSetHash common.Root
+	// Signatures are the precommits of the validators.
+	Signatures []*CommitSignature
+}
+
+// SizeSSZ returns the size of the LightClientCommit in SSZ.
+func (c *LightClientCommit) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 8 + 4 + bytes.B32Size + 4 + bytes.B32Size + constants.SSZOffsetSize
+	if fixed {
+		return size
+	}
+	return size + ssz.SizeSliceOfStaticObjects(siz, c.Signatures)
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientCommit.
+func (c *LightClientCommit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &c.Height)
+	ssz.DefineUint32(codec, &c.Round)
+	ssz.DefineStaticBytes(codec, &c.BlockHash)
+	ssz.DefineUint32(codec, &c.PartSetTotal)
+	ssz.DefineStaticBytes(codec, &c.PartSetHash)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &c.Signatures, constants.ValidatorsRegistryLimit)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &c.Signatures, constants.ValidatorsRegistryLimit)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            LightClientBootstrap                            */
+/* -------------------------------------------------------------------------- */
+
+// LightClientBootstrap is the starting point of a light client: a trusted
+// header and the CometBFT validator set that signs the following block.
+type LightClientBootstrap struct {
+	// Header is the header of the trusted block.
+	Header *LightClientHeader
+	// CurrentValidators is the validator set signing the next block.
+	CurrentValidators []*LightClientValidator
+}
+
+// SizeSSZ returns the size of the LightClientBootstrap in SSZ.
+func (b *LightClientBootstrap) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 2 * constants.SSZOffsetSize
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Header)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.CurrentValidators)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientBootstrap.
+func (b *LightClientBootstrap) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &b.Header)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.CurrentValidators, constants.ValidatorsRegistryLimit)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &b.Header)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &b.CurrentValidators, constants.ValidatorsRegistryLimit)
+}
+
+// MarshalSSZ marshals the LightClientBootstrap to SSZ format.
+func (b *LightClientBootstrap) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                              LightClientUpdate                             */
+/* -------------------------------------------------------------------------- */
+
+// LightClientUpdate lets a light client move from one validator set to the
+// next. The commit of the attested header is signed by the validator set known
+// to the light client, which then learns the next validator set.
+type LightClientUpdate struct {
+	// AttestedHeader is the header of the committed block.
+	AttestedHeader *LightClientHeader
+	// NextValidators is the validator set signing the block after the
+	// attested one.
+	NextValidators []*LightClientValidator
+	// Commit is the CometBFT commit of the attested block.
+	Commit *LightClientCommit
+	// SignatureSlot is the slot of the block including the commit.
+	SignatureSlot math.Slot
+}
+
+// SizeSSZ returns the size of the LightClientUpdate in SSZ.
+func (u *LightClientUpdate) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 3*constants.SSZOffsetSize + 8
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, u.AttestedHeader)
+	size += ssz.SizeSliceOfStaticObjects(siz, u.NextValidators)
+	size += ssz.SizeDynamicObject(siz, u.Commit)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientUpdate.
+func (u *LightClientUpdate) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &u.AttestedHeader)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &u.NextValidators, constants.ValidatorsRegistryLimit)
+	ssz.DefineDynamicObjectOffset(codec, &u.Commit)
+	ssz.DefineUint64(codec, &u.SignatureSlot)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &u.AttestedHeader)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &u.NextValidators, constants.ValidatorsRegistryLimit)
+	ssz.DefineDynamicObjectContent(codec, &u.Commit)
+}
+
+// MarshalSSZ marshals the LightClientUpdate to SSZ format.
+func (u *LightClientUpdate) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(u))
+	return buf, ssz.EncodeToBytes(buf, u)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                          LightClientFinalityUpdate                         */
+/* -------------------------------------------------------------------------- */
+
+// LightClientFinalityUpdate carries the latest finalized header. With single
+// slot finality every committed block is final, so the attested and finalized
+// headers are the same and no finality branch is needed.
+type LightClientFinalityUpdate struct {
+	// AttestedHeader is the header of the committed block.
+	AttestedHeader *LightClientHeader
+	// FinalizedHeader is the header of the finalized block.
+	FinalizedHeader *LightClientHeader
+	// Commit is the CometBFT commit of the attested block.
+	Commit *LightClientCommit
+	// SignatureSlot is the slot of the block including the commit.
+	SignatureSlot math.Slot
+}
+
+// SizeSSZ returns the size of the LightClientFinalityUpdate in SSZ.
+func (u *LightClientFinalityUpdate) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 3*constants.SSZOffsetSize + 8
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, u.AttestedHeader)
+	size += ssz.SizeDynamicObject(siz, u.FinalizedHeader)
+	size += ssz.SizeDynamicObject(siz, u.Commit)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientFinalityUpdate.
+func (u *LightClientFinalityUpdate) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &u.AttestedHeader)
+	ssz.DefineDynamicObjectOffset(codec, &u.FinalizedHeader)
+	ssz.DefineDynamicObjectOffset(codec, &u.Commit)
+	ssz.DefineUint64(codec, &u.SignatureSlot)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &u.AttestedHeader)
+	ssz.DefineDynamicObjectContent(codec, &u.FinalizedHeader)
+	ssz.DefineDynamicObjectContent(codec, &u.Commit)
+}
+
+// MarshalSSZ marshals the LightClientFinalityUpdate to SSZ format.
+func (u *LightClientFinalityUpdate) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(u))
+	return buf, ssz.EncodeToBytes(buf, u)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                         LightClientOptimisticUpdate                        */
+/* -------------------------------------------------------------------------- */
+
+// LightClientOptimisticUpdate carries the latest attested header.
+type LightClientOptimisticUpdate struct {
+	// AttestedHeader is the header of the committed block.
+	AttestedHeader *LightClientHeader
+	// Commit is the CometBFT commit of the attested block.
+	Commit *LightClientCommit
+	// SignatureSlot is the slot of the block including the commit.
+	SignatureSlot math.Slot
+}
+
+// SizeSSZ returns the size of the LightClientOptimisticUpdate in SSZ.
+func (u *LightClientOptimisticUpdate) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := 2*constants.SSZOffsetSize + 8
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, u.AttestedHeader)
+	size += ssz.SizeDynamicObject(siz, u.Commit)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the LightClientOptimisticUpdate.
+func (u *LightClientOptimisticUpdate) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &u.AttestedHeader)
+	ssz.DefineDynamicObjectOffset(codec, &u.Commit)
+	ssz.DefineUint64(codec, &u.SignatureSlot)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &u.AttestedHeader)
+	ssz.DefineDynamicObjectContent(codec, &u.Commit)
+}
+
+// MarshalSSZ marshals the LightClientOptimisticUpdate to SSZ format.
+func (u *LightClientOptimisticUpdate) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(u))
+	return buf, ssz.EncodeToBytes(buf, u)
+}
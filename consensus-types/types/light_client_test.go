@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/utils"
+	"github.com/karalabe/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLightClientHeader(t *testing.T) {
+	t.Parallel()
+	for _, v := range []common.Version{version.Deneb1(), version.Electra1()} {
+		t.Run(version.Name(v), func(t *testing.T) {
+			t.Parallel()
+			blk := utils.GenerateValidBeaconBlock(t, v)
+
+			header, err := types.NewLightClientHeader(blk, &types.LightClientCometBFTHeader{})
+			require.NoError(t, err)
+			require.Equal(t, blk.HashTreeRoot(), header.Beacon.HashTreeRoot())
+			require.Equal(t, blk.GetBody().GetExecutionPayload().HashTreeRoot(), header.Execution.HashTreeRoot())
+			require.True(t, merkle.VerifyProof(
+				header.Beacon.BodyRoot,
+				header.Execution.HashTreeRoot(),
+				types.ExecutionGIndexBody,
+				header.ExecutionBranch[:],
+			))
+		})
+	}
+}
+
+func TestLightClientUpdateSSZ(t *testing.T) {
+	t.Parallel()
+	header, err := types.NewLightClientHeader(
+		utils.GenerateValidBeaconBlock(t, version.Electra()),
+		&types.LightClientCometBFTHeader{
+			Header:   []byte{1, 2, 3},
+			BlockTx:  []byte{4, 5},
+			TxTotal:  2,
+			TxBranch: []common.Root{{6}},
+		},
+	)
+	require.NoError(t, err)
+
+	update := &types.LightClientUpdate{
+		AttestedHeader: header,
+		NextValidators: []*types.LightClientValidator{
+			{Pubkey: crypto.BLSPubkey{1}, VotingPower: 10},
+			{Pubkey: crypto.BLSPubkey{2}, VotingPower: 20},
+		},
+		Commit: &types.LightClientCommit{
+			Height:     10,
+			BlockHash:  common.Root{1},
+			Signatures: []*types.CommitSignature{{BlockIDFlag: 2, Signature: crypto.BLSSignature{3}}},
+		},
+		SignatureSlot: 11,
+	}
+	bz, err := update.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, bz, int(ssz.Size(update)))
+
+	// The attested header is the first dynamic field.
+	headerBz := make([]byte, ssz.Size(header))
+	require.NoError(t, ssz.EncodeToBytes(headerBz, header))
+	require.Equal(t, headerBz, bz[3*4+8:3*4+8+len(headerBz)])
+}
+
+func TestLightClientCometBFTHeaderSSZ(t *testing.T) {
+	t.Parallel()
+	header := &types.LightClientCometBFTHeader{
+		Header:   []byte{1, 2, 3},
+		BlockTx:  []byte{4, 5},
+		TxIndex:  1,
+		TxTotal:  2,
+		TxBranch: []common.Root{{6}, {7}},
+	}
+	bz := make([]byte, ssz.Size(header))
+	require.NoError(t, ssz.EncodeToBytes(bz, header))
+
+	decoded := new(types.LightClientCometBFTHeader)
+	require.NoError(t, ssz.DecodeFromBytes(bz, decoded))
+	require.Equal(t, header, decoded)
+}
@@ -55,9 +55,13 @@ type SignedBeaconBlock struct {
 func NewSignedBeaconBlock(
 	blk *BeaconBlock, forkData *ForkData, cs ProposerDomain, signer crypto.BLSSigner,
 ) (*SignedBeaconBlock, error) {
-	domain := forkData.ComputeDomain(cs.DomainTypeProposer())
-	signingRoot := ComputeSigningRoot(blk, domain)
-	signature, err := signer.Sign(signingRoot[:])
+	signature, err := signBlock(blk, &crypto.SigningBlockHeader{
+		Slot:          blk.GetSlot(),
+		ProposerIndex: blk.GetProposerIndex(),
+		ParentRoot:    blk.GetParentBlockRoot(),
+		StateRoot:     blk.GetStateRoot(),
+		BodyRoot:      blk.GetBody().HashTreeRoot(),
+	}, forkData, cs, signer)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +72,25 @@ func NewSignedBeaconBlock(
 	}, nil
 }
 
+// signBlock signs the given (possibly blinded) block, whose header is passed
+// along to the signer.
+func signBlock(
+	blk interface{ HashTreeRoot() common.Root },
+	header *crypto.SigningBlockHeader,
+	forkData *ForkData,
+	cs ProposerDomain,
+	signer crypto.BLSSigner,
+) (crypto.BLSSignature, error) {
+	domain := forkData.ComputeDomain(cs.DomainTypeProposer())
+	return crypto.SignRequest(signer, &crypto.SigningRequest{
+		Type:                  crypto.SigningTypeBlock,
+		SigningRoot:           ComputeSigningRoot(blk, domain),
+		ForkVersion:           forkData.CurrentVersion,
+		GenesisValidatorsRoot: forkData.GenesisValidatorsRoot,
+		Block:                 header,
+	})
+}
+
 func NewEmptySignedBeaconBlockWithVersion(forkVersion common.Version) (*SignedBeaconBlock, error) {
 	switch forkVersion {
 	case version.Deneb(), version.Deneb1(), version.Electra(), version.Electra1():
@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/karalabe/ssz"
+)
+
+// SignedBlindedBeaconBlock is a SignedBeaconBlock whose execution payload is
+// replaced by the execution payload header. Blinding preserves the hash tree
+// root of the block, hence its signature.
+//
+// NOTE: This struct is never unmarshalled. It is marshalled with SSZ to be
+// served by the node API, and signed in place of the block when the execution
+// payload is built by an external builder.
+type SignedBlindedBeaconBlock struct {
+	Message   *BlindedBeaconBlock
+	Signature crypto.BLSSignature
+}
+
+// BlindedBeaconBlock is a BeaconBlock carrying a BlindedBeaconBlockBody.
+type BlindedBeaconBlock struct {
+	Slot          math.Slot
+	ProposerIndex math.ValidatorIndex
+	ParentRoot    common.Root
+	StateRoot     common.Root
+	Body          *BlindedBeaconBlockBody
+}
+
+// BlindedBeaconBlockBody is a BeaconBlockBody carrying the execution payload
+// header in place of the execution payload.
+type BlindedBeaconBlockBody struct {
+	body                   *BeaconBlockBody
+	ExecutionPayloadHeader *ExecutionPayloadHeader
+}
+
+// NewSignedBlindedBeaconBlock blinds the given signed beacon block.
+func NewSignedBlindedBeaconBlock(blk *SignedBeaconBlock) (*SignedBlindedBeaconBlock, error) {
+	header, err := blk.GetBody().GetExecutionPayload().ToHeader()
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBlindedBeaconBlock{
+		Message: &BlindedBeaconBlock{
+			Slot:          blk.GetSlot(),
+			ProposerIndex: blk.GetProposerIndex(),
+			ParentRoot:    blk.GetParentBlockRoot(),
+			StateRoot:     blk.GetStateRoot(),
+			Body: &BlindedBeaconBlockBody{
+				body:                   blk.GetBody(),
+				ExecutionPayloadHeader: header,
+			},
+		},
+		Signature: blk.GetSignature(),
+	}, nil
+}
+
+// NewBlindedBeaconBlock blinds blk with the given execution payload header,
+// which stands in for the execution payload of its body.
+func NewBlindedBeaconBlock(blk *BeaconBlock, header *ExecutionPayloadHeader) *BlindedBeaconBlock {
+	return &BlindedBeaconBlock{
+		Slot:          blk.GetSlot(),
+		ProposerIndex: blk.GetProposerIndex(),
+		ParentRoot:    blk.GetParentBlockRoot(),
+		StateRoot:     blk.GetStateRoot(),
+		Body: &BlindedBeaconBlockBody{
+			body:                   blk.GetBody(),
+			ExecutionPayloadHeader: header,
+		},
+	}
+}
+
+// SignBlindedBeaconBlock signs the provided BlindedBeaconBlock. The signature
+// is valid for the block once unblinded.
+func SignBlindedBeaconBlock(
+	blk *BlindedBeaconBlock, forkData *ForkData, cs ProposerDomain, signer crypto.BLSSigner,
+) (*SignedBlindedBeaconBlock, error) {
+	signature, err := signBlock(blk, &crypto.SigningBlockHeader{
+		Slot:          blk.Slot,
+		ProposerIndex: blk.ProposerIndex,
+		ParentRoot:    blk.ParentRoot,
+		StateRoot:     blk.StateRoot,
+		BodyRoot:      blk.Body.HashTreeRoot(),
+	}, forkData, cs, signer)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBlindedBeaconBlock{
+		Message:   blk,
+		Signature: signature,
+	}, nil
+}
+
+// Unblind returns the signed beacon block carrying the given execution
+// payload, which must match the execution payload header of the block.
+func (b *SignedBlindedBeaconBlock) Unblind(payload *ExecutionPayload) (*SignedBeaconBlock, error) {
+	header, err := payload.ToHeader()
+	if err != nil {
+		return nil, err
+	}
+	if got, want := header.HashTreeRoot(), b.Message.Body.ExecutionPayloadHeader.HashTreeRoot(); got != want {
+		return nil, errors.Wrapf(
+			ErrPayloadHeaderMismatch, "expected header root %s, got %s", want, got,
+		)
+	}
+
+	body := *b.Message.Body.body
+	body.SetExecutionPayload(payload)
+	return &SignedBeaconBlock{
+		BeaconBlock: &BeaconBlock{
+			Versionable:   NewVersionable(body.GetForkVersion()),
+			Slot:          b.Message.Slot,
+			ProposerIndex: b.Message.ProposerIndex,
+			ParentRoot:    b.Message.ParentRoot,
+			StateRoot:     b.Message.StateRoot,
+			Body:          &body,
+		},
+		Signature: b.Signature,
+	}, nil
+}
+
+// GetBeaconBlockBody returns the body being blinded. Its execution payload is
+// not part of the blinded body.
+func (b *BlindedBeaconBlockBody) GetBeaconBlockBody() *BeaconBlockBody {
+	return b.body
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the SignedBlindedBeaconBlock in SSZ.
+func (b *SignedBlindedBeaconBlock) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := constants.SSZOffsetSize + bytes.B96Size
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Message)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedBlindedBeaconBlock.
+func (b *SignedBlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &b.Message)
+	ssz.DefineStaticBytes(codec, &b.Signature)
+
+	ssz.DefineDynamicObjectContent(codec, &b.Message)
+}
+
+// MarshalSSZ marshals the SignedBlindedBeaconBlock to SSZ format.
+func (b *SignedBlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlock in SSZ.
+func (b *BlindedBeaconBlock) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	//nolint:mnd // same layout as BeaconBlock.
+	var size = uint32(8 + 8 + 32 + 32 + 4)
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(siz, b.Body)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentRoot)
+	ssz.DefineStaticBytes(codec, &b.StateRoot)
+	ssz.DefineDynamicObjectOffset(codec, &b.Body)
+
+	ssz.DefineDynamicObjectContent(codec, &b.Body)
+}
+
+// HashTreeRoot computes the Merkleization of the BlindedBeaconBlock, which
+// matches the one of the unblinded BeaconBlock.
+func (b *BlindedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// HashTreeRoot computes the Merkleization of the BlindedBeaconBlockBody, which
+// matches the one of the unblinded BeaconBlockBody.
+func (b *BlindedBeaconBlockBody) HashTreeRoot() common.Root {
+	return ssz.HashConcurrent(b)
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlockBody in SSZ.
+func (b *BlindedBeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size = 96 + 72 + 32 + 4 + 4 + 4 + 4 + 4 + b.body.syncAggregate.SizeSSZ(siz) + 4 + 4 + 4
+	includeExecRequest := version.EqualsOrIsAfter(b.body.GetForkVersion(), version.Electra())
+	if includeExecRequest {
+		size += constants.SSZOffsetSize
+	}
+
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(siz, b.body.proposerSlashings)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.body.attesterSlashings)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.body.attestations)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.body.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.body.voluntaryExits)
+	size += ssz.SizeDynamicObject(siz, b.ExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticObjects(siz, b.body.blsToExecutionChanges)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.body.BlobKzgCommitments)
+	if includeExecRequest {
+		size += ssz.SizeDynamicObject(siz, b.body.executionRequests)
+	}
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the BlindedBeaconBlockBody. It
+// mirrors BeaconBlockBody, swapping the payload with the payload header.
+//
+//nolint:mnd // TODO: get from accessible chainspec field params
+func (b *BlindedBeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	body := b.body
+
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineStaticBytes(codec, &body.RandaoReveal)
+	ssz.DefineStaticObject(codec, &body.Eth1Data)
+	ssz.DefineStaticBytes(codec, &body.Graffiti)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &body.proposerSlashings, constants.MaxProposerSlashings)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &body.attesterSlashings, constants.MaxAttesterSlashings)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &body.attestations, constants.MaxAttestations)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &body.Deposits, constants.MaxDeposits)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &body.voluntaryExits, constants.MaxVoluntaryExits)
+	ssz.DefineStaticObject(codec, &body.syncAggregate)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticObjectsOffset(codec, &body.blsToExecutionChanges, constants.MaxBlsToExecutionChanges)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &body.BlobKzgCommitments, 4096)
+	includeExecRequest := version.EqualsOrIsAfter(body.GetForkVersion(), version.Electra())
+	if includeExecRequest {
+		ssz.DefineDynamicObjectOffset(codec, &body.executionRequests)
+	}
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &body.proposerSlashings, constants.MaxProposerSlashings)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &body.attesterSlashings, constants.MaxAttesterSlashings)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &body.attestations, constants.MaxAttestations)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &body.Deposits, constants.MaxDeposits)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &body.voluntaryExits, constants.MaxVoluntaryExits)
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticObjectsContent(codec, &body.blsToExecutionChanges, constants.MaxBlsToExecutionChanges)
+	ssz.DefineSliceOfStaticBytesContent(codec, &body.BlobKzgCommitments, 4096)
+	if includeExecRequest {
+		ssz.DefineDynamicObjectContent(codec, &body.executionRequests)
+	}
+}
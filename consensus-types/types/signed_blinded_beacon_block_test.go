@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/testing/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedBlindedBeaconBlock(t *testing.T) {
+	t.Parallel()
+	runForAllSupportedVersions(t, func(t *testing.T, v common.Version) {
+		blk := &types.SignedBeaconBlock{
+			BeaconBlock: utils.GenerateValidBeaconBlock(t, v),
+			Signature:   [96]byte{1, 2, 3},
+		}
+
+		blinded, err := types.NewSignedBlindedBeaconBlock(blk)
+		require.NoError(t, err)
+
+		// Blinding must not change the block root.
+		require.Equal(t, blk.GetBeaconBlock().HashTreeRoot(), blinded.Message.HashTreeRoot())
+		require.Equal(t, blk.GetSignature(), blinded.Signature)
+
+		bz, err := blinded.MarshalSSZ()
+		require.NoError(t, err)
+		full, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		require.Less(t, len(bz), len(full))
+	})
+}
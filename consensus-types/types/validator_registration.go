@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/karalabe/ssz"
+)
+
+// Compile-time assertions to ensure ValidatorRegistration implements
+// necessary interfaces.
+var _ ssz.StaticObject = (*ValidatorRegistration)(nil)
+
+// ValidatorRegistration registers the preferences of a validator with
+// external block builders, as defined in the builder specs.
+// https://github.com/ethereum/builder-specs/blob/main/specs/bellatrix/builder.md#validatorregistrationv1
+type ValidatorRegistration struct {
+	// FeeRecipient is the address receiving the payments of builders.
+	FeeRecipient common.ExecutionAddress
+	// GasLimit is the gas limit builders should target.
+	GasLimit math.U64
+	// Timestamp is the time of the registration. Builders keep the most
+	// recent registration of a validator.
+	Timestamp math.U64
+	// Pubkey is the public key of the validator.
+	Pubkey crypto.BLSPubkey
+}
+
+// SignedValidatorRegistration is a ValidatorRegistration signed by the
+// validator in the builder domain.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration
+	Signature crypto.BLSSignature
+}
+
+// NewSignedValidatorRegistration signs the provided ValidatorRegistration.
+func NewSignedValidatorRegistration(
+	reg *ValidatorRegistration,
+	genesisForkVersion common.Version,
+	domainTypeApplicationBuilder common.DomainType,
+	signer crypto.BLSSigner,
+) (*SignedValidatorRegistration, error) {
+	signature, err := crypto.SignRequest(signer, &crypto.SigningRequest{
+		Type:        crypto.SigningTypeValidatorRegistration,
+		SigningRoot: ComputeBuilderSigningRoot(reg, genesisForkVersion, domainTypeApplicationBuilder),
+		ForkVersion: genesisForkVersion,
+		ValidatorRegistration: &crypto.SigningValidatorRegistration{
+			FeeRecipient: reg.FeeRecipient,
+			GasLimit:     reg.GasLimit,
+			Timestamp:    reg.Timestamp,
+			Pubkey:       reg.Pubkey,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SignedValidatorRegistration{
+		Message:   reg,
+		Signature: signature,
+	}, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ValidatorRegistration in SSZ.
+func (*ValidatorRegistration) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 20+8+8+48 = 84.
+	return 84
+}
+
+// DefineSSZ defines the SSZ encoding for the ValidatorRegistration.
+func (r *ValidatorRegistration) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.FeeRecipient)
+	ssz.DefineUint64(codec, &r.GasLimit)
+	ssz.DefineUint64(codec, &r.Timestamp)
+	ssz.DefineStaticBytes(codec, &r.Pubkey)
+}
+
+// HashTreeRoot computes the Merkleization of the ValidatorRegistration.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
@@ -135,8 +135,8 @@ func (s *Service) Commit(
 		// We expect this to happen and do not want to commit any incomplete or invalid state.
 		return nil, s.ctx.Err()
 	}
-
-	return s.commit(req)
+	//nolint:contextcheck // see s.ctx comment for more details
+	return s.commit(s.ctx, req)
 }
 
 // NOTE: Partially copied from https://github.com/cosmos/cosmos-sdk/blob/960d44842b9e313cbe762068a67a894ac82060ab/baseapp/abci.go#L168
@@ -182,46 +182,55 @@ func (s *Service) Query(
 	return resp, nil
 }
 
-//
-// NOOP methods
-//
-
-func (Service) ListSnapshots(
+// ListSnapshots implements the ABCI interface. It returns the state sync
+// snapshots available locally.
+func (s *Service) ListSnapshots(
 	context.Context,
 	*abci.ListSnapshotsRequest,
 ) (*abci.ListSnapshotsResponse, error) {
-	return &abci.ListSnapshotsResponse{}, nil
+	return s.listSnapshots()
 }
 
-func (Service) LoadSnapshotChunk(
-	context.Context,
-	*abci.LoadSnapshotChunkRequest,
+// LoadSnapshotChunk implements the ABCI interface. It returns the requested
+// chunk of a local state sync snapshot.
+func (s *Service) LoadSnapshotChunk(
+	_ context.Context,
+	req *abci.LoadSnapshotChunkRequest,
 ) (*abci.LoadSnapshotChunkResponse, error) {
-	return &abci.LoadSnapshotChunkResponse{}, nil
+	return s.loadSnapshotChunk(req)
 }
 
-func (Service) OfferSnapshot(
-	context.Context,
-	*abci.OfferSnapshotRequest,
+// OfferSnapshot implements the ABCI interface. It starts restoring state from
+// the offered snapshot, if acceptable.
+func (s *Service) OfferSnapshot(
+	_ context.Context,
+	req *abci.OfferSnapshotRequest,
 ) (*abci.OfferSnapshotResponse, error) {
-	return &abci.OfferSnapshotResponse{}, nil
+	return s.offerSnapshot(req), nil
 }
 
-func (Service) ApplySnapshotChunk(
-	context.Context,
-	*abci.ApplySnapshotChunkRequest,
+// ApplySnapshotChunk implements the ABCI interface. It restores a chunk of the
+// snapshot being restored and, upon the final chunk, verifies the restored
+// state against the app hash of the offered snapshot.
+func (s *Service) ApplySnapshotChunk(
+	_ context.Context,
+	req *abci.ApplySnapshotChunkRequest,
 ) (*abci.ApplySnapshotChunkResponse, error) {
-	return &abci.ApplySnapshotChunkResponse{}, nil
+	return s.applySnapshotChunk(req), nil
 }
 
-func (Service) ExtendVote(
+//
+// NOOP methods
+//
+
+func (*Service) ExtendVote(
 	context.Context,
 	*abci.ExtendVoteRequest,
 ) (*abci.ExtendVoteResponse, error) {
 	return &abci.ExtendVoteResponse{}, nil
 }
 
-func (Service) VerifyVoteExtension(
+func (*Service) VerifyVoteExtension(
 	context.Context,
 	*abci.VerifyVoteExtensionRequest,
 ) (*abci.VerifyVoteExtensionResponse, error) {
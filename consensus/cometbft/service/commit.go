@@ -22,15 +22,22 @@
 package cometbft
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/store/rootmulti"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service) commit(
-	*cmtabci.CommitRequest,
+	ctx context.Context,
+	_ *cmtabci.CommitRequest,
 ) (*cmtabci.CommitResponse, error) {
+	_, span := tracing.Start(ctx, "abci.Commit")
+	defer span.End()
+
 	_, finalState, err := s.cachedStates.GetFinal()
 	if err != nil {
 		// This is unexpected since CometBFT should call Commit only
@@ -39,6 +46,7 @@ func (s *Service) commit(
 	}
 
 	header := finalState.Context().BlockHeader()
+	span.SetAttributes(attribute.Int64("height", header.Height))
 	retainHeight := s.GetBlockRetentionHeight(header.Height)
 
 	rms, ok := s.sm.GetCommitMultiStore().(*rootmulti.Store)
@@ -55,6 +63,8 @@ func (s *Service) commit(
 		}
 	}
 
+	s.snapshotIfApplicable(header.Height)
+
 	return &cmtabci.CommitResponse{
 		RetainHeight: retainHeight,
 	}, nil
@@ -126,6 +136,13 @@ func (s *Service) GetBlockRetentionHeight(commitHeight int64) int64 {
 		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
 	}
 
+	if s.snapshotManager != nil {
+		snapshotRetentionHeights := s.snapshotManager.GetSnapshotBlockRetentionHeights()
+		if snapshotRetentionHeights > 0 {
+			retentionHeight = minNonZero(retentionHeight, commitHeight-snapshotRetentionHeights)
+		}
+	}
+
 	v := commitHeight - int64(s.minRetainBlocks) // #nosec G115
 	retentionHeight = minNonZero(retentionHeight, v)
 
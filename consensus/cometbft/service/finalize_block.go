@@ -30,24 +30,32 @@ import (
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/cache"
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/delay"
 	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	"github.com/sourcegraph/conc/iter"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service) finalizeBlock(
 	ctx context.Context,
 	req *cmtabci.FinalizeBlockRequest,
-) (*cmtabci.FinalizeBlockResponse, error) {
-	if err := s.validateFinalizeBlockHeight(req); err != nil {
+) (_ *cmtabci.FinalizeBlockResponse, err error) {
+	ctx, span := tracing.Start(
+		ctx, "abci.FinalizeBlock", attribute.Int64("height", req.Height),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	if err = s.validateFinalizeBlockHeight(req); err != nil {
 		return nil, err
 	}
+	s.syncingToHeight.Store(req.SyncingToHeight)
 
 	// Check whether currently block hash is already available. If so
 	// we may speed up block finalization.
 	hash := string(req.Hash)
-	switch cached, err := s.cachedStates.GetCached(hash); {
-	case err == nil:
+	switch cached, cacheErr := s.cachedStates.GetCached(hash); {
+	case cacheErr == nil:
 		// Block with height equal to initial height is special and we can't rely on its cache.
 		// This is because Genesis state is cached but not committed (and purged from s.cachedStates)
 		// We handle the case outside of this switch, via the s.Blockchain.FinalizeBlock below.
@@ -56,7 +64,10 @@ func (s *Service) finalizeBlock(
 				return nil, fmt.Errorf("failed marking state as final, hash %s, height %d: %w", hash, req.Height, err)
 			}
 
+			// Trace the finalization of the cached state within this
+			// FinalizeBlock rather than the ProcessProposal which cached it.
 			finalState := cached.State
+			finalState.SetContext(finalState.Context().WithContext(ctx))
 			var (
 				signedBlk *ctypes.SignedBeaconBlock
 				sidecars  datypes.BlobSidecars
@@ -77,19 +88,19 @@ func (s *Service) finalizeBlock(
 			}
 			if err = s.Blockchain.PostFinalizeBlockOps(
 				finalState.Context(),
-				blk,
+				signedBlk,
 			); err != nil {
 				return nil, fmt.Errorf("finalize block: failed post finalize block ops: %w", err)
 			}
 			return s.calculateFinalizeBlockResponse(req, cached.ValUpdates)
 		}
 
-	case errors.Is(err, cache.ErrStateNotFound):
+	case errors.Is(cacheErr, cache.ErrStateNotFound):
 		// this is a benign error, it just signal it's the first time we see the block being finalized
 		// Keep processing below
 
 	default:
-		return nil, fmt.Errorf("failed checking cached state, hash %s, height %d: %w", hash, req.Height, err)
+		return nil, fmt.Errorf("failed checking cached state, hash %s, height %d: %w", hash, req.Height, cacheErr)
 	}
 
 	// Block has not been cached already, as it happens when we block sync.
@@ -168,9 +179,9 @@ func (s *Service) nextBlockDelay(req *cmtabci.FinalizeBlockRequest) time.Duratio
 	}
 	// c3.2
 	//
-	// Looks like we've skipped SBTEnableHeight (probably restoring from the
-	// snapshot) => panic.
-	panic(fmt.Sprintf("nil block delay at height %d past SBTEnableHeight %d. This is only possible w/ statesync, which is not supported by SBT atm",
+	// Looks like we've skipped SBTEnableHeight (probably restoring from a
+	// snapshot which does not carry the block delay) => panic.
+	panic(fmt.Sprintf("nil block delay at height %d past SBTEnableHeight %d. This is only possible w/ statesync from a snapshot without block delay",
 		req.Height, s.cmtConsensusParams.Feature.SBTEnableHeight))
 }
 
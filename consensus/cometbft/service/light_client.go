@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	cmtdbm "github.com/cometbft/cometbft-db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtstate "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// dbProvider opens the CometBFT DBs like the default provider, keeping a
+// handle on the state DB for the light client server.
+func (s *Service) dbProvider(ctx *cmtcfg.DBContext) (cmtdbm.DB, error) {
+	db, err := cmtcfg.DefaultDBProvider(ctx)
+	if err == nil && ctx.ID == "state" {
+		s.stateDB = db
+	}
+	return db, err
+}
+
+// newStateStore returns a store over the state DB of the node, from which the
+// light client server reads historical validator sets. The store is never
+// closed, as the node closes the state DB on stop.
+func (s *Service) newStateStore(cfg *cmtcfg.Config) cmtstate.Store {
+	return cmtstate.NewStore(s.stateDB, cmtstate.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		DBKeyLayout:          cfg.Storage.ExperimentalKeyLayout,
+	})
+}
+
+// LightClientCommit returns the CometBFT commit of the block at the given
+// height. The commit included in the next block is preferred, falling back to
+// the commit seen by this node for the latest block.
+func (s *Service) LightClientCommit(height int64) (*ctypes.LightClientCommit, error) {
+	if !s.nodeCreated.Load() {
+		return nil, errNodeNotStarted
+	}
+
+	blockStore := s.node.BlockStore()
+	commit := blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return nil, fmt.Errorf("no commit found at height %d", height)
+	}
+	return NewLightClientCommit(commit)
+}
+
+// LightClientValidators returns the CometBFT validator set which signs the
+// block at the given height.
+func (s *Service) LightClientValidators(height int64) ([]*ctypes.LightClientValidator, error) {
+	if !s.nodeCreated.Load() {
+		return nil, errNodeNotStarted
+	}
+
+	vals, err := s.stateStore.LoadValidators(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading validators at height %d: %w", height, err)
+	}
+	return NewLightClientValidators(vals)
+}
+
+// LightClientCometBFTHeader returns the header of the CometBFT block at the
+// given height, together with the proof of its beacon block transaction.
+func (s *Service) LightClientCometBFTHeader(height int64) (*ctypes.LightClientCometBFTHeader, error) {
+	if !s.nodeCreated.Load() {
+		return nil, errNodeNotStarted
+	}
+
+	blk, _ := s.node.BlockStore().LoadBlock(height)
+	if blk == nil {
+		return nil, fmt.Errorf("no block found at height %d", height)
+	}
+	return NewLightClientCometBFTHeader(blk)
+}
+
+// NewLightClientCometBFTHeader returns the header of the given CometBFT block,
+// together with the proof of its beacon block transaction.
+func NewLightClientCometBFTHeader(blk *cmttypes.Block) (*ctypes.LightClientCometBFTHeader, error) {
+	if len(blk.Txs) <= int(blockchain.BeaconBlockTxIndex) {
+		return nil, fmt.Errorf("block at height %d carries no beacon block", blk.Height)
+	}
+	pbHeader := blk.Header.ToProto()
+	header, err := pbHeader.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding header at height %d: %w", blk.Height, err)
+	}
+	proof := blk.Txs.Proof(int(blockchain.BeaconBlockTxIndex))
+	branch := make([]common.Root, len(proof.Proof.Aunts))
+	for i, aunt := range proof.Proof.Aunts {
+		branch[i] = common.Root(aunt)
+	}
+	return &ctypes.LightClientCometBFTHeader{
+		Header:   header,
+		BlockTx:  proof.Data,
+		TxIndex:  uint64(proof.Proof.Index), // #nosec G115 -- index is never negative.
+		TxTotal:  uint64(proof.Proof.Total), // #nosec G115 -- total is never negative.
+		TxBranch: branch,
+	}, nil
+}
+
+// NewLightClientValidators returns the light client representation of the
+// given validator set, in the order of the set.
+func NewLightClientValidators(vals *cmttypes.ValidatorSet) ([]*ctypes.LightClientValidator, error) {
+	res := make([]*ctypes.LightClientValidator, 0, vals.Size())
+	for _, val := range vals.Validators {
+		pk, errPk := blsPubkey(val)
+		if errPk != nil {
+			return nil, errPk
+		}
+		res = append(res, &ctypes.LightClientValidator{
+			Pubkey:      pk,
+			VotingPower: uint64(val.VotingPower), // #nosec G115 -- voting power is never negative.
+		})
+	}
+	return res, nil
+}
+
+// NewLightClientCommit returns the light client representation of the given
+// commit.
+func NewLightClientCommit(commit *cmttypes.Commit) (*ctypes.LightClientCommit, error) {
+	res := &ctypes.LightClientCommit{
+		Height:       uint64(commit.Height), // #nosec G115 -- height is never negative.
+		Round:        uint32(commit.Round),  // #nosec G115 -- round is never negative.
+		PartSetTotal: commit.BlockID.PartSetHeader.Total,
+		Signatures:   make([]*ctypes.CommitSignature, 0, len(commit.Signatures)),
+	}
+	copy(res.BlockHash[:], commit.BlockID.Hash)
+	copy(res.PartSetHash[:], commit.BlockID.PartSetHeader.Hash)
+
+	for _, sig := range commit.Signatures {
+		cs := &ctypes.CommitSignature{
+			BlockIDFlag: uint8(sig.BlockIDFlag),
+		}
+		if len(sig.ValidatorAddress) > 0 && len(sig.ValidatorAddress) != len(cs.ValidatorAddress) {
+			return nil, fmt.Errorf("unexpected validator address length %d", len(sig.ValidatorAddress))
+		}
+		if len(sig.Signature) > 0 && len(sig.Signature) != len(cs.Signature) {
+			return nil, fmt.Errorf("unexpected signature length %d", len(sig.Signature))
+		}
+		copy(cs.ValidatorAddress[:], sig.ValidatorAddress)
+		copy(cs.Signature[:], sig.Signature)
+		if !sig.Timestamp.IsZero() {
+			cs.Timestamp = uint64(sig.Timestamp.UnixNano()) // #nosec G115 -- votes are after 1970.
+		}
+		res.Signatures = append(res.Signatures, cs)
+	}
+	return res, nil
+}
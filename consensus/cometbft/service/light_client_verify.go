@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	stdbytes "bytes"
+	"fmt"
+	"time"
+
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtmerkle "github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ErrLightClientVerification is returned when light client data does not
+// verify.
+var ErrLightClientVerification = errors.New("light client verification failed")
+
+// The functions below verify the light client data served by the node, as a
+// light client does. The beacon block of a light client header is linked to
+// the CometBFT header of the block carrying it by the proof of its
+// transaction against the data hash of the header. Commits sign the hash of
+// the CometBFT header, which also commits to the validator set signing the
+// block and to the one signing the next block.
+
+// VerifyLightClientBootstrap checks the bootstrap of the beacon block with
+// the trusted root, and that its current validators are the validator set
+// which signs the next block.
+func VerifyLightClientBootstrap(b *ctypes.LightClientBootstrap, trustedRoot common.Root) error {
+	if root := b.Header.Beacon.HashTreeRoot(); root != trustedRoot {
+		return fmt.Errorf("%w: bootstrap of block %s, expected %s", ErrLightClientVerification, root, trustedRoot)
+	}
+	header, err := VerifyLightClientHeader(b.Header)
+	if err != nil {
+		return err
+	}
+	return verifyValidatorsHash(header.NextValidatorsHash, b.CurrentValidators)
+}
+
+// VerifyLightClientUpdate checks that the attested header of the update is
+// committed by more than 2/3 of the voting power of vals, the validator set
+// known to the light client, and that the next validators of the update are
+// the validator set which signs the next block.
+func VerifyLightClientUpdate(
+	chainID string,
+	u *ctypes.LightClientUpdate,
+	vals []*ctypes.LightClientValidator,
+) error {
+	header, err := verifyCommittedHeader(chainID, u.AttestedHeader, u.Commit, vals)
+	if err != nil {
+		return err
+	}
+	return verifyValidatorsHash(header.NextValidatorsHash, u.NextValidators)
+}
+
+// VerifyLightClientFinalityUpdate checks that the finalized header of the
+// update is committed by more than 2/3 of the voting power of vals.
+func VerifyLightClientFinalityUpdate(
+	chainID string,
+	u *ctypes.LightClientFinalityUpdate,
+	vals []*ctypes.LightClientValidator,
+) error {
+	if u.FinalizedHeader.Beacon.HashTreeRoot() != u.AttestedHeader.Beacon.HashTreeRoot() {
+		return fmt.Errorf("%w: finalized header is not the attested header", ErrLightClientVerification)
+	}
+	_, err := verifyCommittedHeader(chainID, u.AttestedHeader, u.Commit, vals)
+	return err
+}
+
+// VerifyLightClientHeader checks the execution payload header of the light
+// client header against the body of its beacon block, and the beacon block
+// against the transaction of the CometBFT header. It returns the CometBFT
+// header.
+func VerifyLightClientHeader(h *ctypes.LightClientHeader) (*cmttypes.Header, error) {
+	if !merkle.VerifyProof(
+		h.Beacon.BodyRoot, h.Execution.HashTreeRoot(), ctypes.ExecutionGIndexBody, h.ExecutionBranch[:],
+	) {
+		return nil, fmt.Errorf("%w: invalid execution branch", ErrLightClientVerification)
+	}
+
+	cmt := h.CometBFT
+	if cmt == nil {
+		return nil, fmt.Errorf("%w: missing CometBFT header", ErrLightClientVerification)
+	}
+	var pbHeader cmtproto.Header
+	if err := pbHeader.Unmarshal(cmt.Header); err != nil {
+		return nil, fmt.Errorf("%w: invalid CometBFT header: %w", ErrLightClientVerification, err)
+	}
+	header, err := cmttypes.HeaderFromProto(&pbHeader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid CometBFT header: %w", ErrLightClientVerification, err)
+	}
+
+	if cmt.TxIndex != uint64(blockchain.BeaconBlockTxIndex) {
+		return nil, fmt.Errorf("%w: beacon block at transaction %d", ErrLightClientVerification, cmt.TxIndex)
+	}
+	aunts := make([][]byte, len(cmt.TxBranch))
+	for i, aunt := range cmt.TxBranch {
+		aunts[i] = aunt[:]
+	}
+	proof := cmttypes.TxProof{
+		RootHash: header.DataHash,
+		Data:     cmt.BlockTx,
+		Proof: cmtmerkle.Proof{
+			Total: int64(cmt.TxTotal), // #nosec G115 -- checked by the proof.
+			Index: int64(cmt.TxIndex), // #nosec G115 -- checked above.
+			// The leaves of the data hash are the prefixed hashes of the
+			// transaction hashes (RFC 6962).
+			LeafHash: tmhash.Sum(append([]byte{0}, cmttypes.Tx(cmt.BlockTx).Hash()...)),
+			Aunts:    aunts,
+		},
+	}
+	if err = proof.Validate(header.DataHash); err != nil {
+		return nil, fmt.Errorf("%w: invalid transaction proof: %w", ErrLightClientVerification, err)
+	}
+
+	blk, err := ctypes.NewEmptySignedBeaconBlockWithVersion(h.GetForkVersion())
+	if err != nil {
+		return nil, err
+	}
+	if err = ssz.Unmarshal(cmt.BlockTx, blk); err != nil {
+		return nil, fmt.Errorf("%w: invalid beacon block transaction: %w", ErrLightClientVerification, err)
+	}
+	if root := blk.GetBeaconBlock().HashTreeRoot(); root != h.Beacon.HashTreeRoot() {
+		return nil, fmt.Errorf(
+			"%w: CometBFT block carries beacon block %s, expected %s",
+			ErrLightClientVerification, root, h.Beacon.HashTreeRoot(),
+		)
+	}
+	return &header, nil
+}
+
+// verifyCommittedHeader checks that the light client header is committed by
+// more than 2/3 of the voting power of vals, the validator set signing its
+// block. It returns the CometBFT header.
+func verifyCommittedHeader(
+	chainID string,
+	h *ctypes.LightClientHeader,
+	c *ctypes.LightClientCommit,
+	vals []*ctypes.LightClientValidator,
+) (*cmttypes.Header, error) {
+	header, err := VerifyLightClientHeader(h)
+	if err != nil {
+		return nil, err
+	}
+	if header.ChainID != chainID {
+		return nil, fmt.Errorf("%w: header of chain %s", ErrLightClientVerification, header.ChainID)
+	}
+	valSet, err := verifiedValidatorSet(header.ValidatorsHash, vals)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := commitFromLightClient(c)
+	if err != nil {
+		return nil, err
+	}
+	if !stdbytes.Equal(commit.BlockID.Hash, header.Hash()) {
+		return nil, fmt.Errorf("%w: commit of another block", ErrLightClientVerification)
+	}
+	if err = valSet.VerifyCommitLight(chainID, commit.BlockID, header.Height, commit); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLightClientVerification, err)
+	}
+	return header, nil
+}
+
+// verifyValidatorsHash checks that vals is the validator set of the given
+// hash.
+func verifyValidatorsHash(hash []byte, vals []*ctypes.LightClientValidator) error {
+	_, err := verifiedValidatorSet(hash, vals)
+	return err
+}
+
+// verifiedValidatorSet returns the CometBFT validator set of vals, checked
+// against the given hash.
+func verifiedValidatorSet(hash []byte, vals []*ctypes.LightClientValidator) (*cmttypes.ValidatorSet, error) {
+	valz := make([]*cmttypes.Validator, 0, len(vals))
+	for _, val := range vals {
+		pk, err := bls12381.NewPublicKeyFromCompressedBytes(val.Pubkey[:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid validator pubkey: %w", ErrLightClientVerification, err)
+		}
+		valz = append(valz, cmttypes.NewValidator(pk, int64(val.VotingPower))) // #nosec G115 -- checked below.
+	}
+	valSet, err := cmttypes.ValidatorSetFromExistingValidators(valz)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLightClientVerification, err)
+	}
+	if !stdbytes.Equal(valSet.Hash(), hash) {
+		return nil, fmt.Errorf("%w: validator set does not match its hash", ErrLightClientVerification)
+	}
+	return valSet, nil
+}
+
+// commitFromLightClient returns the CometBFT commit of the light client
+// commit.
+func commitFromLightClient(c *ctypes.LightClientCommit) (*cmttypes.Commit, error) {
+	commit := &cmttypes.Commit{
+		Height: int64(c.Height), // #nosec G115 -- checked by the commit.
+		Round:  int32(c.Round),  // #nosec G115 -- checked by the commit.
+		BlockID: cmttypes.BlockID{
+			Hash: c.BlockHash[:],
+			PartSetHeader: cmttypes.PartSetHeader{
+				Total: c.PartSetTotal,
+				Hash:  c.PartSetHash[:],
+			},
+		},
+		Signatures: make([]cmttypes.CommitSig, 0, len(c.Signatures)),
+	}
+	for _, sig := range c.Signatures {
+		cs := cmttypes.CommitSig{BlockIDFlag: cmttypes.BlockIDFlag(sig.BlockIDFlag)}
+		// Absent votes carry neither address nor signature.
+		if sig.ValidatorAddress != (bytes.B20{}) {
+			cs.ValidatorAddress = sig.ValidatorAddress[:]
+		}
+		if sig.Signature != (crypto.BLSSignature{}) {
+			cs.Signature = sig.Signature[:]
+		}
+		if sig.Timestamp != 0 {
+			cs.Timestamp = time.Unix(0, int64(sig.Timestamp)).UTC() // #nosec G115 -- votes are before 2262.
+		}
+		commit.Signatures = append(commit.Signatures, cs)
+	}
+	if err := commit.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("%w: invalid commit: %w", ErrLightClientVerification, err)
+	}
+	return commit, nil
+}
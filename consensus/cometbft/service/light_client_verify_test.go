@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/version"
+	testutils "github.com/berachain/beacon-kit/testing/utils"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+const lightClientChainID = "light-client-test"
+
+// lightClientData is the light client data of a beacon block carried by a
+// CometBFT block, committed by a validator set.
+type lightClientData struct {
+	header     *ctypes.LightClientHeader
+	commit     *ctypes.LightClientCommit
+	vals       []*ctypes.LightClientValidator
+	nextVals   []*ctypes.LightClientValidator
+	cometBlock *cmttypes.Block
+}
+
+func TestVerifyLightClientUpdate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		tamper  func(t *testing.T, d *lightClientData)
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			tamper: func(*testing.T, *lightClientData) {},
+		},
+		{
+			name: "beacon header not in the CometBFT block",
+			tamper: func(_ *testing.T, d *lightClientData) {
+				d.header.Beacon.StateRoot = common.Root{9}
+			},
+			wantErr: true,
+		},
+		{
+			name: "execution header not in the beacon block",
+			tamper: func(_ *testing.T, d *lightClientData) {
+				d.header.Execution.GasUsed++
+			},
+			wantErr: true,
+		},
+		{
+			name: "beacon block tx not in the CometBFT block",
+			tamper: func(_ *testing.T, d *lightClientData) {
+				d.header.CometBFT.BlockTx = append([]byte{}, d.cometBlock.Txs[1]...)
+			},
+			wantErr: true,
+		},
+		{
+			name: "tx branch tampered",
+			tamper: func(_ *testing.T, d *lightClientData) {
+				d.header.CometBFT.TxBranch[0][0] ^= 1
+			},
+			wantErr: true,
+		},
+		{
+			name: "CometBFT header of another block",
+			tamper: func(t *testing.T, d *lightClientData) {
+				t.Helper()
+				var pb cmtproto.Header
+				require.NoError(t, pb.Unmarshal(d.header.CometBFT.Header))
+				pb.AppHash = []byte{9}
+				var err error
+				d.header.CometBFT.Header, err = pb.Marshal()
+				require.NoError(t, err)
+			},
+			wantErr: true,
+		},
+		{
+			name: "commit of another block",
+			tamper: func(_ *testing.T, d *lightClientData) {
+				d.commit.BlockHash[0] ^= 1
+			},
+			wantErr: true,
+		},
+		{
+			name: "signature tampered",
+			tamper: func(_ *testing.T, d *lightClientData) {
+				d.commit.Signatures[0].Signature[10] ^= 1
+			},
+			wantErr: true,
+		},
+		{
+			name: "validator set not of the header",
+			tamper: func(_ *testing.T, d *lightClientData) {
+				d.vals = d.vals[1:]
+			},
+			wantErr: true,
+		},
+		{
+			name: "next validators not of the header",
+			tamper: func(_ *testing.T, d *lightClientData) {
+				d.nextVals = d.vals
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := newLightClientData(t)
+			tc.tamper(t, d)
+
+			err := cometbft.VerifyLightClientUpdate(lightClientChainID, &ctypes.LightClientUpdate{
+				AttestedHeader: d.header,
+				NextValidators: d.nextVals,
+				Commit:         d.commit,
+				SignatureSlot:  d.header.Beacon.GetSlot() + 1,
+			}, d.vals)
+			if tc.wantErr {
+				require.ErrorIs(t, err, cometbft.ErrLightClientVerification)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyLightClientUpdateOtherChain(t *testing.T) {
+	t.Parallel()
+	d := newLightClientData(t)
+
+	err := cometbft.VerifyLightClientUpdate("other-chain", &ctypes.LightClientUpdate{
+		AttestedHeader: d.header,
+		NextValidators: d.nextVals,
+		Commit:         d.commit,
+	}, d.vals)
+	require.ErrorIs(t, err, cometbft.ErrLightClientVerification)
+}
+
+func TestVerifyLightClientBootstrap(t *testing.T) {
+	t.Parallel()
+	d := newLightClientData(t)
+	trustedRoot := d.header.Beacon.HashTreeRoot()
+
+	bootstrap := &ctypes.LightClientBootstrap{
+		Header:            d.header,
+		CurrentValidators: d.nextVals,
+	}
+	require.NoError(t, cometbft.VerifyLightClientBootstrap(bootstrap, trustedRoot))
+
+	err := cometbft.VerifyLightClientBootstrap(bootstrap, common.Root{1})
+	require.ErrorIs(t, err, cometbft.ErrLightClientVerification)
+
+	bootstrap.CurrentValidators = d.vals
+	err = cometbft.VerifyLightClientBootstrap(bootstrap, trustedRoot)
+	require.ErrorIs(t, err, cometbft.ErrLightClientVerification)
+}
+
+func TestVerifyLightClientFinalityUpdate(t *testing.T) {
+	t.Parallel()
+	d := newLightClientData(t)
+
+	update := &ctypes.LightClientFinalityUpdate{
+		AttestedHeader:  d.header,
+		FinalizedHeader: d.header,
+		Commit:          d.commit,
+	}
+	require.NoError(t, cometbft.VerifyLightClientFinalityUpdate(lightClientChainID, update, d.vals))
+
+	other := newLightClientData(t)
+	other.header.Beacon.Slot++
+	update.FinalizedHeader = other.header
+	err := cometbft.VerifyLightClientFinalityUpdate(lightClientChainID, update, d.vals)
+	require.ErrorIs(t, err, cometbft.ErrLightClientVerification)
+}
+
+// newLightClientData builds a CometBFT block carrying a beacon block and
+// commits it with a fresh validator set, as served by the node.
+func newLightClientData(t *testing.T) *lightClientData {
+	t.Helper()
+
+	valSet, privVals := newBLSValidatorSet(t, 4)
+	nextValSet, _ := newBLSValidatorSet(t, 3)
+
+	blk := testutils.GenerateValidBeaconBlock(t, version.Electra())
+	tx, err := (&ctypes.SignedBeaconBlock{BeaconBlock: blk}).MarshalSSZ()
+	require.NoError(t, err)
+
+	height := int64(blk.GetSlot().Unwrap()) // #nosec G115 -- test slot.
+	cometBlock := cmttypes.MakeBlock(height, []cmttypes.Tx{tx, []byte("sidecars")}, &cmttypes.Commit{}, nil)
+	cometBlock.ChainID = lightClientChainID
+	cometBlock.Time = time.Unix(10, 0).UTC()
+	cometBlock.ValidatorsHash = valSet.Hash()
+	cometBlock.NextValidatorsHash = nextValSet.Hash()
+	cometBlock.ProposerAddress = valSet.Validators[0].Address
+	partSet, err := cometBlock.MakePartSet(cmttypes.BlockPartSizeBytes)
+	require.NoError(t, err)
+	blockID := cmttypes.BlockID{Hash: cometBlock.Hash(), PartSetHeader: partSet.Header()}
+
+	voteSet := cmttypes.NewVoteSet(lightClientChainID, height, 0, cmttypes.PrecommitType, valSet)
+	extCommit, err := cmttypes.MakeExtCommit(blockID, height, 0, voteSet, privVals, time.Now(), false)
+	require.NoError(t, err)
+
+	commit, err := cometbft.NewLightClientCommit(extCommit.ToCommit())
+	require.NoError(t, err)
+	cometHeader, err := cometbft.NewLightClientCometBFTHeader(cometBlock)
+	require.NoError(t, err)
+	header, err := ctypes.NewLightClientHeader(blk, cometHeader)
+	require.NoError(t, err)
+	vals, err := cometbft.NewLightClientValidators(valSet)
+	require.NoError(t, err)
+	nextVals, err := cometbft.NewLightClientValidators(nextValSet)
+	require.NoError(t, err)
+
+	return &lightClientData{
+		header:     header,
+		commit:     commit,
+		vals:       vals,
+		nextVals:   nextVals,
+		cometBlock: cometBlock,
+	}
+}
+
+// newBLSValidatorSet returns a validator set of n BLS validators, together
+// with their signers in the order of the set.
+func newBLSValidatorSet(t *testing.T, n int) (*cmttypes.ValidatorSet, []cmttypes.PrivValidator) {
+	t.Helper()
+
+	byAddress := make(map[string]cmttypes.PrivValidator, n)
+	valz := make([]*cmttypes.Validator, 0, n)
+	for range n {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		pv := cmttypes.NewMockPVWithParams(privKey, false, false)
+		byAddress[string(privKey.PubKey().Address())] = pv
+		valz = append(valz, cmttypes.NewValidator(privKey.PubKey(), 10))
+	}
+	valSet := cmttypes.NewValidatorSet(valz)
+
+	privVals := make([]cmttypes.PrivValidator, 0, n)
+	for _, val := range valSet.Validators {
+		privVals = append(privVals, byAddress[string(val.Address)])
+	}
+	return valSet, privVals
+}
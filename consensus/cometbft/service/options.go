@@ -24,6 +24,8 @@ import (
 	"fmt"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 )
 
@@ -83,3 +85,23 @@ func SetInterBlockCache(cache storetypes.MultiStorePersistentCache) func(*Servic
 func SetChainID(chainID string) func(*Service) {
 	return func(s *Service) { s.chainID = chainID }
 }
+
+// SetSnapshot sets the snapshot store and options used to take and restore
+// state sync snapshots. Snapshots are disabled if the store is nil.
+func SetSnapshot(
+	store *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) func(*Service) {
+	return func(s *Service) {
+		if store == nil {
+			s.snapshotManager = nil
+			return
+		}
+
+		manager, err := s.newSnapshotManager(store, opts)
+		if err != nil {
+			panic(fmt.Errorf("failed to create snapshot manager: %w", err))
+		}
+		s.snapshotManager = manager
+	}
+}
@@ -26,8 +26,10 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/consensus/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service) prepareProposal(
@@ -37,6 +39,10 @@ func (s *Service) prepareProposal(
 	startTime := time.Now()
 	defer s.telemetrySink.MeasureSince(
 		"beacon_kit.runtime.prepare_proposal_duration", startTime)
+	ctx, span := tracing.Start(
+		ctx, "abci.PrepareProposal", attribute.Int64("height", req.Height),
+	)
+	defer span.End()
 
 	// CometBFT must never call PrepareProposal with a height of 0.
 	if req.Height < 1 {
@@ -75,6 +81,7 @@ func (s *Service) prepareProposal(
 		slotData,
 	)
 	if err != nil {
+		tracing.RecordError(span, err)
 		s.logger.Error(
 			"failed to prepare proposal",
 			"height", req.Height,
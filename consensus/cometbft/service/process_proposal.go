@@ -26,8 +26,10 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/cache"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Service) processProposal(
@@ -37,6 +39,10 @@ func (s *Service) processProposal(
 	startTime := time.Now()
 	defer s.telemetrySink.MeasureSince(
 		"beacon_kit.runtime.process_proposal_duration", startTime)
+	ctx, span := tracing.Start(
+		ctx, "abci.ProcessProposal", attribute.Int64("height", req.Height),
+	)
+	defer span.End()
 
 	// CometBFT must never call ProcessProposal with a height of 0.
 	if req.Height < 1 {
@@ -70,6 +76,7 @@ func (s *Service) processProposal(
 		s.nodeAddress[:],
 	)
 	if err != nil {
+		tracing.RecordError(span, err)
 		status := cmtabci.PROCESS_PROPOSAL_STATUS_REJECT
 		s.logger.Error(
 			"failed to process proposal",
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmtstate "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var errNodeNotStarted = errors.New("consensus node not started")
+
+// committedStateProvider returns CometBFT's state as of the latest committed
+// block. It is implemented by the evidence pool of the node, which is updated
+// upon each commit.
+type committedStateProvider interface {
+	State() cmtstate.State
+}
+
+// UpcomingProposers returns a best-effort projection of the validators that
+// will propose the next count blocks, together with the height of the first
+// of them. Proposers are projected with CometBFT's weighted round-robin from
+// the current validator set and proposer priorities, assuming each height is
+// decided in round 0 and no validator set change beyond the ones already
+// scheduled.
+func (s *Service) UpcomingProposers(count int) (int64, []crypto.BLSPubkey, error) {
+	if !s.nodeCreated.Load() {
+		return 0, nil, errNodeNotStarted
+	}
+
+	state := s.committedState.State()
+	if count <= 0 || state.Validators == nil || state.NextValidators == nil {
+		return state.LastBlockHeight + 1, nil, nil
+	}
+
+	proposers := make([]crypto.BLSPubkey, 0, count)
+	appendProposer := func(vals *cmttypes.ValidatorSet) error {
+		pk, err := blsPubkey(vals.GetProposer())
+		if err != nil {
+			return err
+		}
+		proposers = append(proposers, pk)
+		return nil
+	}
+
+	// The proposer of the next height is already determined by the current
+	// validator set, while the next validator set already has its priorities
+	// incremented for the height after that.
+	if err := appendProposer(state.Validators); err != nil {
+		return 0, nil, err
+	}
+	vals := state.NextValidators.Copy()
+	for len(proposers) < count {
+		if err := appendProposer(vals); err != nil {
+			return 0, nil, err
+		}
+		vals.IncrementProposerPriority(1)
+	}
+	return state.LastBlockHeight + 1, proposers, nil
+}
+
+func blsPubkey(val *cmttypes.Validator) (crypto.BLSPubkey, error) {
+	if val == nil || val.PubKey == nil {
+		return crypto.BLSPubkey{}, errors.New("empty validator set")
+	}
+	if val.PubKey.Type() != bls12381.KeyType {
+		return crypto.BLSPubkey{}, fmt.Errorf("unexpected validator pubkey type %s", val.PubKey.Type())
+	}
+	// CometBFT serializes BLS pubkeys uncompressed, beacon pubkeys are
+	// compressed.
+	pk, err := bls12381.NewPublicKeyFromBytes(val.PubKey.Bytes())
+	if err != nil {
+		return crypto.BLSPubkey{}, fmt.Errorf("invalid validator pubkey: %w", err)
+	}
+	return crypto.BLSPubkey(pk.Compress()), nil
+}
@@ -24,7 +24,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
@@ -38,6 +40,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/storage"
+	cmtdbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	cmtcrypto "github.com/cometbft/cometbft/crypto"
@@ -45,6 +48,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	cmtstate "github.com/cometbft/cometbft/state"
 	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -60,6 +64,27 @@ type Service struct {
 	node        *node.Node
 	nodeAddress cmtcrypto.Address
 
+	// nodeCreated is set once node is created, so that its sync status
+	// can be safely read from other goroutines.
+	nodeCreated atomic.Bool
+
+	// committedState exposes CometBFT's state as of the latest committed
+	// block, e.g. the current validator set. It is set together with
+	// nodeCreated.
+	committedState committedStateProvider
+
+	// stateDB is the CometBFT state DB opened by the node, kept by
+	// dbProvider for the light client server.
+	stateDB cmtdbm.DB
+
+	// stateStore exposes the historical validator sets the light client
+	// server reads. It reads stateDB and is set together with nodeCreated.
+	stateStore cmtstate.Store
+
+	// syncingToHeight is the tip of the chain, as last reported by CometBFT
+	// upon finalizing a block.
+	syncingToHeight atomic.Int64
+
 	delayCfg delay.ConfigGetter
 
 	// cmtConsensusParams are part of the blockchain state and
@@ -85,6 +110,16 @@ type Service struct {
 
 	interBlockCache storetypes.MultiStorePersistentCache
 
+	// snapshotManager takes, serves and restores state sync snapshots of
+	// the CommitMultiStore. It is nil if snapshots are not configured.
+	snapshotManager         *snapshots.Manager
+	blockDelaySnapshotter   *blockDelaySnapshotter
+	beaconStoresSnapshotter *beaconStoresSnapshotter
+
+	// restoringSnapshot is the snapshot offered by CometBFT which is being
+	// restored, if any.
+	restoringSnapshot *snapshotTarget
+
 	// initialHeight is the initial height at which we start the node
 	initialHeight   int64
 	minRetainBlocks uint64
@@ -157,12 +192,7 @@ func NewService(
 	}
 
 	// Make sure that SBT consensus parameters are duly set when the node restart.
-	// Note that we can't rely on genesis.json having these parameters set right
-	// because we introduced stable block time post (mainnet) genesis.
-	lastBlockHeight := s.sm.GetCommitMultiStore().LastCommitID().Version
-	if lastBlockHeight >= s.delayCfg.SbtConsensusUpdateHeight() {
-		s.cmtConsensusParams.Feature.SBTEnableHeight = s.delayCfg.SbtConsensusEnableHeight()
-	}
+	s.syncSBTConsensusParams(s.sm.GetCommitMultiStore().LastCommitID().Version)
 
 	// Load block delay.
 	//
@@ -192,13 +222,19 @@ func (s *Service) Start(
 		return err
 	}
 
-	privVal, err := pvm.LoadOrGenFilePV(
-		cfg.PrivValidatorKeyFile(),
-		cfg.PrivValidatorStateFile(),
-		nil,
-	)
-	if err != nil {
-		return err
+	// An external signer listening on priv_validator_laddr signs the votes in
+	// place of the private validator key file, which is then neither read nor
+	// generated.
+	var privVal cmttypes.PrivValidator
+	if cfg.PrivValidatorListenAddr == "" {
+		privVal, err = pvm.LoadOrGenFilePV(
+			cfg.PrivValidatorKeyFile(),
+			cfg.PrivValidatorStateFile(),
+			nil,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	s.ResetAppCtx(ctx)
@@ -209,13 +245,16 @@ func (s *Service) Start(
 		nodeKey,
 		proxy.NewLocalClientCreator(s),
 		GetGenDocProvider(cfg),
-		cmtcfg.DefaultDBProvider,
+		s.dbProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		servercmtlog.WrapCometLogger(s.logger),
 	)
 	if err != nil {
 		return err
 	}
+	s.committedState = s.node.EvidencePool()
+	s.stateStore = s.newStateStore(cfg)
+	s.nodeCreated.Store(true)
 
 	pubKey, errPk := s.node.PrivValidator().GetPubKey()
 	if errPk != nil {
@@ -256,6 +295,12 @@ func (s *Service) Stop() error {
 		s.node.Wait()
 	}
 
+	if s.snapshotManager != nil {
+		if err := s.snapshotManager.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close snapshot store: %w", err))
+		}
+	}
+
 	s.logger.Info("Closing application.db")
 	if err := s.sm.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("failed to close application.id: %w", err))
@@ -304,6 +349,16 @@ func (s *Service) LastBlockHeight() int64 {
 	return s.sm.GetCommitMultiStore().LastCommitID().Version
 }
 
+// syncSBTConsensusParams sets the SBT consensus parameters if the chain is
+// already past the SBT consensus update height. Note that we can't rely on
+// genesis.json having these parameters set right because we introduced stable
+// block time post (mainnet) genesis.
+func (s *Service) syncSBTConsensusParams(lastBlockHeight int64) {
+	if lastBlockHeight >= s.delayCfg.SbtConsensusUpdateHeight() {
+		s.cmtConsensusParams.Feature.SBTEnableHeight = s.delayCfg.SbtConsensusEnableHeight()
+	}
+}
+
 func (s *Service) setMinRetainBlocks(minRetainBlocks uint64) {
 	s.minRetainBlocks = minRetainBlocks
 }
@@ -0,0 +1,507 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/consensus/cometbft/service/delay"
+	servercmtlog "github.com/berachain/beacon-kit/consensus/cometbft/service/log"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *Service) listSnapshots() (*abci.ListSnapshotsResponse, error) {
+	resp := &abci.ListSnapshotsResponse{Snapshots: []*abci.Snapshot{}}
+	if s.snapshotManager == nil {
+		return resp, nil
+	}
+
+	snapshots, err := s.snapshotManager.List()
+	if err != nil {
+		s.logger.Error("Failed to list snapshots", "err", err)
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots {
+		abciSnapshot, errConv := snapshot.ToABCI()
+		if errConv != nil {
+			s.logger.Error("Failed to convert ABCI snapshot", "err", errConv)
+			return nil, errConv
+		}
+		resp.Snapshots = append(resp.Snapshots, &abciSnapshot)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) loadSnapshotChunk(
+	req *abci.LoadSnapshotChunkRequest,
+) (*abci.LoadSnapshotChunkResponse, error) {
+	if s.snapshotManager == nil {
+		return &abci.LoadSnapshotChunkResponse{}, nil
+	}
+
+	chunk, err := s.snapshotManager.LoadChunk(req.Height, req.Format, req.Chunk)
+	if err != nil {
+		s.logger.Error(
+			"Failed to load snapshot chunk",
+			"height", req.Height,
+			"format", req.Format,
+			"chunk", req.Chunk,
+			"err", err,
+		)
+		return nil, err
+	}
+
+	return &abci.LoadSnapshotChunkResponse{Chunk: chunk}, nil
+}
+
+func (s *Service) offerSnapshot(
+	req *abci.OfferSnapshotRequest,
+) *abci.OfferSnapshotResponse {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ABORT}
+	}
+
+	if req.Snapshot == nil {
+		s.logger.Error("Received nil snapshot")
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}
+	}
+
+	if len(req.AppHash) == 0 {
+		s.logger.Error("Received snapshot without trusted app hash")
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}
+	}
+
+	snapshot, err := snapshottypes.SnapshotFromABCI(req.Snapshot)
+	if err != nil {
+		s.logger.Error("Failed to decode snapshot metadata", "err", err)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}
+	}
+
+	if s.beaconStoresSnapshotter != nil {
+		s.beaconStoresSnapshotter.takeRestored()
+	}
+	err = s.snapshotManager.Restore(snapshot)
+	switch {
+	case err == nil:
+		// Note down the app hash CometBFT trusts for the snapshot height,
+		// so that we can verify the restored state against it once the
+		// last chunk has been applied.
+		s.restoringSnapshot = &snapshotTarget{
+			height:  req.Snapshot.Height,
+			appHash: bytes.Clone(req.AppHash),
+		}
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ACCEPT}
+
+	case errors.Is(err, snapshottypes.ErrUnknownFormat):
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT}
+
+	case errors.Is(err, snapshottypes.ErrInvalidMetadata):
+		s.logger.Error(
+			"Rejecting invalid snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"err", err,
+		)
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_REJECT}
+
+	default:
+		s.logger.Error(
+			"Failed to restore snapshot",
+			"height", req.Snapshot.Height,
+			"format", req.Snapshot.Format,
+			"err", err,
+		)
+		// We don't support resetting the IAVL stores and retrying a
+		// different snapshot, so we ask CometBFT to abort the restoration.
+		return &abci.OfferSnapshotResponse{Result: abci.OFFER_SNAPSHOT_RESULT_ABORT}
+	}
+}
+
+func (s *Service) applySnapshotChunk(
+	req *abci.ApplySnapshotChunkRequest,
+) *abci.ApplySnapshotChunkResponse {
+	if s.snapshotManager == nil {
+		s.logger.Error("Snapshot manager not configured")
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT}
+	}
+
+	done, err := s.snapshotManager.RestoreChunk(req.Chunk)
+	switch {
+	case err == nil && !done:
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}
+
+	case err == nil:
+		if errVerify := s.verifyRestoredSnapshot(); errVerify != nil {
+			s.logger.Error("Restored snapshot failed verification", "err", errVerify)
+			return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT}
+		}
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}
+
+	case errors.Is(err, snapshottypes.ErrChunkHashMismatch):
+		s.logger.Error(
+			"Chunk checksum mismatch; rejecting sender and requesting refetch",
+			"chunk", req.Index,
+			"sender", req.Sender,
+			"err", err,
+		)
+		return &abci.ApplySnapshotChunkResponse{
+			Result:        abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.Index},
+			RejectSenders: []string{req.Sender},
+		}
+
+	default:
+		s.logger.Error("Failed to restore snapshot", "err", err)
+		return &abci.ApplySnapshotChunkResponse{Result: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT}
+	}
+}
+
+// verifyRestoredSnapshot checks that the state restored from a snapshot
+// matches the height and app hash offered by CometBFT, before the node is
+// allowed to resume from it.
+func (s *Service) verifyRestoredSnapshot() error {
+	target := s.restoringSnapshot
+	s.restoringSnapshot = nil
+	if target == nil {
+		return errNoSnapshotOffered
+	}
+
+	lastCommitID := s.sm.GetCommitMultiStore().LastCommitID()
+	if lastCommitID.Version != int64(target.height) { // #nosec G115
+		return fmt.Errorf(
+			"%w: restored height %d, expected %d",
+			errSnapshotMismatch, lastCommitID.Version, target.height,
+		)
+	}
+	if !bytes.Equal(lastCommitID.Hash, target.appHash) {
+		return fmt.Errorf(
+			"%w: restored app hash %X, expected %X",
+			errSnapshotMismatch, lastCommitID.Hash, target.appHash,
+		)
+	}
+
+	if err := s.restoreBeaconStores(); err != nil {
+		return err
+	}
+
+	// Restored state may be past the SBT consensus update height.
+	s.syncSBTConsensusParams(lastCommitID.Version)
+
+	s.logger.Info(
+		"Restored state from snapshot",
+		"height", lastCommitID.Version,
+		"app_hash", fmt.Sprintf("%X", lastCommitID.Hash),
+	)
+	return nil
+}
+
+// snapshotTarget is the snapshot being restored, as offered by CometBFT.
+type snapshotTarget struct {
+	height  uint64
+	appHash []byte
+}
+
+// restoreBeaconStores seeds the beacon-side stores with the payloads restored
+// along with the snapshot, once the state restored from it has been verified
+// against the app hash, so that block sync can resume from the snapshot
+// height.
+func (s *Service) restoreBeaconStores() error {
+	if s.beaconStoresSnapshotter == nil {
+		return nil
+	}
+	payloads := s.beaconStoresSnapshotter.takeRestored()
+	if payloads == nil {
+		return errNoBeaconStores
+	}
+	ms := s.sm.GetCommitMultiStore().CacheMultiStore()
+	ctx := sdk.NewContext(ms, false, servercmtlog.WrapSDKLogger(s.logger)).
+		WithContext(s.ctx)
+	return s.Blockchain.RestoreStores(ctx, payloads)
+}
+
+var (
+	errNoSnapshotOffered = errors.New("no snapshot offered")
+	errSnapshotMismatch  = errors.New("restored snapshot mismatch")
+	errNoBeaconStores    = errors.New("snapshot does not carry the beacon stores")
+)
+
+const (
+	blockDelaySnapshotName   = "block_delay"
+	blockDelaySnapshotFormat = 1
+)
+
+// blockDelaySnapshotter carries the stable block time state alongside state
+// sync snapshots. The block delay is persisted outside of the
+// CommitMultiStore, but a node restored from a snapshot past SBTEnableHeight
+// cannot compute the next block delay without it.
+//
+// Snapshots are taken asynchronously after Commit, so the block delay for a
+// snapshot height is recorded at Commit time and picked up once the snapshot
+// is actually taken.
+type blockDelaySnapshotter struct {
+	mu      sync.Mutex
+	pending map[uint64][]byte
+
+	// restore is called with the block delay restored from a snapshot.
+	restore func(*delay.BlockDelay) error
+}
+
+var _ snapshottypes.ExtensionSnapshotter = (*blockDelaySnapshotter)(nil)
+
+func newBlockDelaySnapshotter(
+	restore func(*delay.BlockDelay) error,
+) *blockDelaySnapshotter {
+	return &blockDelaySnapshotter{
+		pending: make(map[uint64][]byte),
+		restore: restore,
+	}
+}
+
+// record notes down the block delay to be included in the snapshot taken at
+// height.
+func (b *blockDelaySnapshotter) record(height uint64, bd *delay.BlockDelay) {
+	if bd == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pending[height] = bd.ToBytes()
+}
+
+// SnapshotName implements snapshottypes.ExtensionSnapshotter.
+func (*blockDelaySnapshotter) SnapshotName() string {
+	return blockDelaySnapshotName
+}
+
+// SnapshotFormat implements snapshottypes.ExtensionSnapshotter.
+func (*blockDelaySnapshotter) SnapshotFormat() uint32 {
+	return blockDelaySnapshotFormat
+}
+
+// SupportedFormats implements snapshottypes.ExtensionSnapshotter.
+func (*blockDelaySnapshotter) SupportedFormats() []uint32 {
+	return []uint32{blockDelaySnapshotFormat}
+}
+
+// SnapshotExtension implements snapshottypes.ExtensionSnapshotter. No
+// payload is written if the block delay is not set at height.
+func (b *blockDelaySnapshotter) SnapshotExtension(
+	height uint64,
+	payloadWriter snapshottypes.ExtensionPayloadWriter,
+) error {
+	b.mu.Lock()
+	bz, found := b.pending[height]
+	// Drop this and any stale entry left by a failed snapshot.
+	for h := range b.pending {
+		if h <= height {
+			delete(b.pending, h)
+		}
+	}
+	b.mu.Unlock()
+
+	if !found {
+		return nil
+	}
+	return payloadWriter(bz)
+}
+
+// RestoreExtension implements snapshottypes.ExtensionSnapshotter.
+func (b *blockDelaySnapshotter) RestoreExtension(
+	_ uint64,
+	format uint32,
+	payloadReader snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != blockDelaySnapshotFormat {
+		return fmt.Errorf("%w: %d", snapshottypes.ErrUnknownFormat, format)
+	}
+
+	bz, err := payloadReader()
+	if errors.Is(err, io.EOF) {
+		// Snapshot was taken before the block delay was set.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	bd, err := delay.FromBytes(bz)
+	if err != nil {
+		return err
+	}
+	if err = b.restore(bd); err != nil {
+		return err
+	}
+
+	if _, err = payloadReader(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("unexpected block delay payload: %w", err)
+	}
+	return nil
+}
+
+const (
+	beaconStoresSnapshotName   = "beacon_stores"
+	beaconStoresSnapshotFormat = 1
+)
+
+// beaconStoresSnapshotter carries the beacon-side stores kept beside the
+// CommitMultiStore alongside state sync snapshots: the deposits processed by
+// the beacon state and the block at the snapshot height. A node restored from
+// a snapshot cannot validate the deposits of the next blocks without them.
+//
+// Payloads are not covered by the app hash, so restored payloads are held
+// until the restored state is verified, and are then checked against it
+// before the stores are seeded.
+type beaconStoresSnapshotter struct {
+	// snapshot writes the payloads of the beacon state committed at height.
+	snapshot func(height uint64, payloadWriter snapshottypes.ExtensionPayloadWriter) error
+
+	mu       sync.Mutex
+	restored [][]byte
+}
+
+var _ snapshottypes.ExtensionSnapshotter = (*beaconStoresSnapshotter)(nil)
+
+// takeRestored returns and forgets the payloads restored from the last
+// snapshot, nil if none was.
+func (b *beaconStoresSnapshotter) takeRestored() [][]byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	restored := b.restored
+	b.restored = nil
+	return restored
+}
+
+// SnapshotName implements snapshottypes.ExtensionSnapshotter.
+func (*beaconStoresSnapshotter) SnapshotName() string {
+	return beaconStoresSnapshotName
+}
+
+// SnapshotFormat implements snapshottypes.ExtensionSnapshotter.
+func (*beaconStoresSnapshotter) SnapshotFormat() uint32 {
+	return beaconStoresSnapshotFormat
+}
+
+// SupportedFormats implements snapshottypes.ExtensionSnapshotter.
+func (*beaconStoresSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{beaconStoresSnapshotFormat}
+}
+
+// SnapshotExtension implements snapshottypes.ExtensionSnapshotter.
+func (b *beaconStoresSnapshotter) SnapshotExtension(
+	height uint64,
+	payloadWriter snapshottypes.ExtensionPayloadWriter,
+) error {
+	return b.snapshot(height, payloadWriter)
+}
+
+// RestoreExtension implements snapshottypes.ExtensionSnapshotter.
+func (b *beaconStoresSnapshotter) RestoreExtension(
+	_ uint64,
+	format uint32,
+	payloadReader snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != beaconStoresSnapshotFormat {
+		return fmt.Errorf("%w: %d", snapshottypes.ErrUnknownFormat, format)
+	}
+
+	restored := [][]byte{}
+	for {
+		bz, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		restored = append(restored, bz)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.restored = restored
+	return nil
+}
+
+// newSnapshotManager creates the snapshot manager for the CommitMultiStore,
+// registering the block delay and beacon stores extensions.
+func (s *Service) newSnapshotManager(
+	store *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) (*snapshots.Manager, error) {
+	cms := s.sm.GetCommitMultiStore()
+	cms.SetSnapshotInterval(opts.Interval)
+
+	s.blockDelaySnapshotter = newBlockDelaySnapshotter(
+		func(bd *delay.BlockDelay) error {
+			if err := s.sm.SaveBlockDelay(bd.ToBytes()); err != nil {
+				return err
+			}
+			s.blockDelay = bd
+			return nil
+		},
+	)
+
+	manager := snapshots.NewManager(
+		store, opts, cms, nil, servercmtlog.WrapSDKLogger(s.logger),
+	)
+	extensions := []snapshottypes.ExtensionSnapshotter{s.blockDelaySnapshotter}
+	if s.Blockchain != nil {
+		s.beaconStoresSnapshotter = &beaconStoresSnapshotter{
+			snapshot: func(height uint64, payloadWriter snapshottypes.ExtensionPayloadWriter) error {
+				ms, err := cms.CacheMultiStoreWithVersion(int64(height)) // #nosec G115
+				if err != nil {
+					return err
+				}
+				ctx := sdk.NewContext(ms, false, servercmtlog.WrapSDKLogger(s.logger))
+				return s.Blockchain.SnapshotStores(ctx, payloadWriter)
+			},
+		}
+		extensions = append(extensions, s.beaconStoresSnapshotter)
+	}
+	if err := manager.RegisterExtensions(extensions...); err != nil {
+		return nil, err
+	}
+	return manager, nil
+}
+
+// snapshotIfApplicable takes a snapshot of the committed state at height, if
+// state sync snapshots are enabled and height is a snapshot height.
+func (s *Service) snapshotIfApplicable(height int64) {
+	if s.snapshotManager == nil {
+		return
+	}
+	interval := s.snapshotManager.GetInterval()
+	if interval == 0 || height <= 0 || uint64(height)%interval != 0 { // #nosec G115
+		return
+	}
+	s.blockDelaySnapshotter.record(uint64(height), s.blockDelay) // #nosec G115
+	s.snapshotManager.SnapshotIfApplicable(height)
+}
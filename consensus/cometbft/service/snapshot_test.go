@@ -0,0 +1,337 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
+	"github.com/berachain/beacon-kit/config/spec"
+	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage"
+	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotsDisabled(t *testing.T) {
+	t.Parallel()
+	s := newTestService(t)
+	ctx := context.Background()
+
+	list, err := s.ListSnapshots(ctx, &abci.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.Snapshots)
+
+	offer, err := s.OfferSnapshot(ctx, &abci.OfferSnapshotRequest{
+		Snapshot: &abci.Snapshot{Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 1},
+		AppHash:  []byte{0x01},
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.OFFER_SNAPSHOT_RESULT_ABORT, offer.Result)
+
+	apply, err := s.ApplySnapshotChunk(ctx, &abci.ApplySnapshotChunkRequest{})
+	require.NoError(t, err)
+	require.Equal(t, abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT, apply.Result)
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	source, snapshot := newSourceWithSnapshot(t, nil)
+	appHash := source.CommitMultiStore().LastCommitID().Hash
+
+	target := newTestService(t, withTestSnapshotStore(t))
+	offer, err := target.OfferSnapshot(ctx, &abci.OfferSnapshotRequest{
+		Snapshot: snapshot,
+		AppHash:  appHash,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.OFFER_SNAPSHOT_RESULT_ACCEPT, offer.Result)
+
+	for i := range snapshot.Chunks {
+		chunk := loadChunk(t, source, snapshot, i)
+		apply, errApply := target.ApplySnapshotChunk(ctx, &abci.ApplySnapshotChunkRequest{
+			Index: i,
+			Chunk: chunk,
+		})
+		require.NoError(t, errApply)
+		require.Equal(t, abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT, apply.Result)
+	}
+
+	info, err := target.Info(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(snapshot.Height), info.LastBlockHeight) // #nosec G115
+	require.Equal(t, appHash, info.LastBlockAppHash)
+
+	kv := target.CommitMultiStore().GetCommitKVStore(storage.StoreKey)
+	require.Equal(t, []byte("value-2"), kv.Get([]byte("key-2")))
+}
+
+func TestSnapshotRestoreAppHashMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	source, snapshot := newSourceWithSnapshot(t, nil)
+
+	target := newTestService(t, withTestSnapshotStore(t))
+	offer, err := target.OfferSnapshot(ctx, &abci.OfferSnapshotRequest{
+		Snapshot: snapshot,
+		AppHash:  []byte("not the app hash"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.OFFER_SNAPSHOT_RESULT_ACCEPT, offer.Result)
+
+	var last *abci.ApplySnapshotChunkResponse
+	for i := range snapshot.Chunks {
+		last, err = target.ApplySnapshotChunk(ctx, &abci.ApplySnapshotChunkRequest{
+			Index: i,
+			Chunk: loadChunk(t, source, snapshot, i),
+		})
+		require.NoError(t, err)
+	}
+	require.Equal(t, abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT, last.Result)
+}
+
+func TestSnapshotApplyCorruptedChunk(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	source, snapshot := newSourceWithSnapshot(t, nil)
+
+	target := newTestService(t, withTestSnapshotStore(t))
+	_, err := target.OfferSnapshot(ctx, &abci.OfferSnapshotRequest{
+		Snapshot: snapshot,
+		AppHash:  source.CommitMultiStore().LastCommitID().Hash,
+	})
+	require.NoError(t, err)
+
+	apply, err := target.ApplySnapshotChunk(ctx, &abci.ApplySnapshotChunkRequest{
+		Index:  0,
+		Chunk:  []byte("corrupted"),
+		Sender: "peer",
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY, apply.Result)
+	require.Equal(t, []uint32{0}, apply.RefetchChunks)
+	require.Equal(t, []string{"peer"}, apply.RejectSenders)
+}
+
+func TestSnapshotRestoreBeaconStores(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	payloads := [][]byte{[]byte("block"), nil, []byte("deposit-0"), []byte("deposit-1")}
+
+	tests := []struct {
+		name string
+		// source is the blockchain of the node taking the snapshot, nil if
+		// it does not carry the beacon stores.
+		source     *storesBlockchain
+		restoreErr error
+		wantResult abci.ApplySnapshotChunkResult
+	}{
+		{
+			name:       "seeded",
+			source:     &storesBlockchain{payloads: payloads},
+			wantResult: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT,
+		},
+		{
+			name:       "stores do not match state",
+			source:     &storesBlockchain{payloads: payloads},
+			restoreErr: errors.New("mismatch"),
+			wantResult: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		},
+		{
+			name:       "stores missing",
+			wantResult: abci.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var sourceChain blockchain.BlockchainI
+			if tc.source != nil {
+				sourceChain = tc.source
+			}
+			source, snapshot := newSourceWithSnapshot(t, sourceChain)
+			appHash := source.CommitMultiStore().LastCommitID().Hash
+
+			targetChain := &storesBlockchain{restoreErr: tc.restoreErr}
+			target := newTestChainService(t, targetChain, withTestSnapshotStore(t))
+			offer, err := target.OfferSnapshot(ctx, &abci.OfferSnapshotRequest{
+				Snapshot: snapshot,
+				AppHash:  appHash,
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.OFFER_SNAPSHOT_RESULT_ACCEPT, offer.Result)
+
+			var last *abci.ApplySnapshotChunkResponse
+			for i := range snapshot.Chunks {
+				last, err = target.ApplySnapshotChunk(ctx, &abci.ApplySnapshotChunkRequest{
+					Index: i,
+					Chunk: loadChunk(t, source, snapshot, i),
+				})
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantResult, last.Result)
+
+			if tc.source == nil {
+				require.Nil(t, targetChain.restored)
+				return
+			}
+			// The stores are restored once the state has been verified, from
+			// the state restored by the snapshot.
+			require.Equal(t, payloads, targetChain.restored)
+			require.Equal(t, []byte("value-2"), targetChain.restoredValue)
+		})
+	}
+}
+
+// storesBlockchain is a blockchain writing the given beacon store payloads
+// to snapshots and recording those it restores.
+type storesBlockchain struct {
+	blockchain.BlockchainI
+
+	payloads   [][]byte
+	restoreErr error
+
+	restored      [][]byte
+	restoredValue []byte
+}
+
+func (b *storesBlockchain) SnapshotStores(_ sdk.Context, write func([]byte) error) error {
+	for _, bz := range b.payloads {
+		if err := write(bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *storesBlockchain) RestoreStores(ctx sdk.Context, payloads [][]byte) error {
+	b.restored = payloads
+	b.restoredValue = ctx.KVStore(storage.StoreKey).Get([]byte("key-2"))
+	return b.restoreErr
+}
+
+// newSourceWithSnapshot commits a few heights to a service over the given
+// blockchain and takes a snapshot of its latest state, returning the snapshot
+// as listed over ABCI.
+func newSourceWithSnapshot(
+	t *testing.T,
+	chain blockchain.BlockchainI,
+) (*cometbft.Service, *abci.Snapshot) {
+	t.Helper()
+	source := newTestChainService(t, chain, withTestSnapshotStore(t))
+
+	cms := source.CommitMultiStore()
+	for _, i := range []string{"1", "2"} {
+		kv := cms.GetCommitKVStore(storage.StoreKey)
+		kv.Set([]byte("key-"+i), []byte("value-"+i))
+		cms.Commit()
+	}
+
+	// Snapshots are taken asynchronously upon Commit, so create it directly.
+	_, err := source.SnapshotManager().Create(uint64(cms.LastCommitID().Version)) // #nosec G115
+	require.NoError(t, err)
+
+	list, err := source.ListSnapshots(context.Background(), &abci.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Snapshots, 1)
+	return source, list.Snapshots[0]
+}
+
+func loadChunk(
+	t *testing.T,
+	s *cometbft.Service,
+	snapshot *abci.Snapshot,
+	index uint32,
+) []byte {
+	t.Helper()
+	resp, err := s.LoadSnapshotChunk(context.Background(), &abci.LoadSnapshotChunkRequest{
+		Height: snapshot.Height,
+		Format: snapshot.Format,
+		Chunk:  index,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Chunk)
+	return resp.Chunk
+}
+
+func newTestSnapshotStore(t *testing.T) *snapshots.Store {
+	t.Helper()
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func withTestSnapshotStore(t *testing.T) func(*cometbft.Service) {
+	t.Helper()
+	return cometbft.SetSnapshot(
+		newTestSnapshotStore(t), snapshottypes.NewSnapshotOptions(0, 0),
+	)
+}
+
+func newTestService(t *testing.T, opts ...func(*cometbft.Service)) *cometbft.Service {
+	t.Helper()
+	return newTestChainService(t, nil, opts...)
+}
+
+func newTestChainService(
+	t *testing.T,
+	chain blockchain.BlockchainI,
+	opts ...func(*cometbft.Service),
+) *cometbft.Service {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	cmtCfg := cometbft.DefaultConfig()
+	cmtCfg.SetRoot(t.TempDir())
+	require.NoError(t, os.MkdirAll(cmtCfg.RootDir+"/config", 0o750))
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test-chain", json.RawMessage(`{}`))
+	appGenesis.Consensus.Params = cometbft.DefaultConsensusParams(crypto.CometBLSType, cs)
+	require.NoError(t, appGenesis.SaveAs(cmtCfg.GenesisFile()))
+
+	s := cometbft.NewService(
+		phuslu.NewLogger(io.Discard, nil),
+		dbm.NewMemDB(),
+		chain,
+		nil,
+		cs,
+		cmtCfg,
+		metrics.NewNoOpTelemetrySink(),
+		opts...,
+	)
+	s.ResetAppCtx(context.Background())
+	return s
+}
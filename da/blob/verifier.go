@@ -28,9 +28,11 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/da/kzg"
 	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/eip4844"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -61,12 +63,16 @@ func (bv *verifier) verifySidecars(
 	sidecars datypes.BlobSidecars,
 	blkHeader *ctypes.BeaconBlockHeader,
 	kzgCommitments eip4844.KZGCommitments[common.ExecutionHash],
-) error {
+) (err error) {
 	numSidecars := uint64(len(sidecars))
 	defer bv.metrics.measureVerifySidecarsDuration(
 		time.Now(), math.U64(numSidecars),
 		bv.proofVerifier.GetImplementation(),
 	)
+	ctx, span := tracing.Start(
+		ctx, "blob.VerifySidecars", attribute.Int("num_sidecars", len(sidecars)),
+	)
+	defer func() { tracing.End(span, err) }()
 
 	g, _ := errgroup.WithContext(ctx)
 
@@ -106,12 +112,12 @@ func (bv *verifier) verifySidecars(
 
 	// Verify the inclusion proofs on the blobs concurrently.
 	g.Go(func() error {
-		return bv.verifyInclusionProofs(sidecars)
+		return bv.verifyInclusionProofs(ctx, sidecars)
 	})
 
 	// Verify the KZG proofs on the blobs concurrently.
 	g.Go(func() error {
-		return bv.verifyKZGProofs(sidecars)
+		return bv.verifyKZGProofs(ctx, sidecars)
 	})
 
 	// Wait for all goroutines to finish and return the result.
@@ -119,25 +125,34 @@ func (bv *verifier) verifySidecars(
 }
 
 func (bv *verifier) verifyInclusionProofs(
+	ctx context.Context,
 	scs datypes.BlobSidecars,
-) error {
+) (err error) {
 	startTime := time.Now()
 	defer bv.metrics.measureVerifyInclusionProofsDuration(
 		startTime, math.U64(len(scs)),
 	)
+	_, span := tracing.Start(ctx, "blob.verifyInclusionProofs")
+	defer func() { tracing.End(span, err) }()
 
 	return scs.VerifyInclusionProofs()
 }
 
 // verifyKZGProofs verifies the sidecars.
 func (bv *verifier) verifyKZGProofs(
+	ctx context.Context,
 	scs datypes.BlobSidecars,
-) error {
+) (err error) {
 	start := time.Now()
 	defer bv.metrics.measureVerifyKZGProofsDuration(
 		start, math.U64(len(scs)),
 		bv.proofVerifier.GetImplementation(),
 	)
+	_, span := tracing.Start(
+		ctx, "blob.verifyKZGProofs",
+		attribute.String("implementation", bv.proofVerifier.GetImplementation()),
+	)
+	defer func() { tracing.End(span, err) }()
 
 	switch len(scs) {
 	case 0:
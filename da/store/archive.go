@@ -0,0 +1,392 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// A blob archive holds the blob sidecars of a range of slots in a single
+// file. Integers are little endian. The file is laid out as:
+//
+//	header:  magic (4) | version (4) | first slot (8) | last slot (8)
+//	records: for each slot with sidecars, its SSZ encoded sidecars, each
+//	         prefixed with its length (4)
+//	index:   for each slot with sidecars, in ascending order,
+//	         slot (8) | records offset (8) | records size (8) | records crc32c (4)
+//	trailer: index offset (8) | index entries (8) | index crc32c (4) | magic (4)
+//
+// Slots of the range missing from the index have no sidecars.
+const (
+	// ArchiveExtension is the file extension of blob archives.
+	ArchiveExtension = ".blobs"
+
+	archiveVersion     = 1
+	archiveHeaderSize  = 24
+	archiveEntrySize   = 28
+	archiveTrailerSize = 24
+	recordLengthSize   = 4
+)
+
+//nolint:gochecknoglobals // constant byte slice and table.
+var (
+	archiveMagic    = []byte{'b', 'k', 'b', 'a'}
+	archiveCRCTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// archiveEntry locates the sidecars of a slot in a blob archive.
+type archiveEntry struct {
+	slot     math.Slot
+	offset   uint64
+	size     uint64
+	checksum uint32
+}
+
+// WriteArchive writes the sidecars of the slots from first to last included,
+// as returned by sidecars, to a blob archive at path. The archive is written
+// to a temporary file which is renamed to path once complete. It returns the
+// number of archived sidecars.
+func WriteArchive(
+	path string,
+	first, last math.Slot,
+	sidecars func(slot math.Slot) (types.BlobSidecars, error),
+) (int, error) {
+	if first > last {
+		return 0, fmt.Errorf("invalid slot range [%d, %d]", first, last)
+	}
+
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create blob archive: %w", err)
+	}
+	count, err := writeArchive(f, first, last, sidecars)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return 0, err
+	}
+	return count, nil
+}
+
+// writeArchive writes the blob archive of the slots from first to last
+// included to w.
+func writeArchive(
+	w io.Writer,
+	first, last math.Slot,
+	sidecars func(slot math.Slot) (types.BlobSidecars, error),
+) (int, error) {
+	bw := bufio.NewWriter(w)
+	header := make([]byte, 0, archiveHeaderSize)
+	header = append(header, archiveMagic...)
+	header = binary.LittleEndian.AppendUint32(header, archiveVersion)
+	header = binary.LittleEndian.AppendUint64(header, first.Unwrap())
+	header = binary.LittleEndian.AppendUint64(header, last.Unwrap())
+	if _, err := bw.Write(header); err != nil {
+		return 0, err
+	}
+
+	var (
+		offset uint64 = archiveHeaderSize
+		index  []byte
+		count  int
+	)
+	for slot := first; ; slot++ {
+		scs, err := sidecars(slot)
+		if err != nil {
+			return count, fmt.Errorf("failed to read sidecars of slot %d: %w", slot, err)
+		}
+		if len(scs) > 0 {
+			var records []byte
+			for _, sc := range scs {
+				bz, marshalErr := sc.MarshalSSZ()
+				if marshalErr != nil {
+					return count, marshalErr
+				}
+				//#nosec:G115 // a sidecar is far smaller than 4GiB.
+				records = binary.LittleEndian.AppendUint32(records, uint32(len(bz)))
+				records = append(records, bz...)
+			}
+			if _, err = bw.Write(records); err != nil {
+				return count, err
+			}
+			index = binary.LittleEndian.AppendUint64(index, slot.Unwrap())
+			index = binary.LittleEndian.AppendUint64(index, offset)
+			index = binary.LittleEndian.AppendUint64(index, uint64(len(records)))
+			index = binary.LittleEndian.AppendUint32(index, crc32.Checksum(records, archiveCRCTable))
+			offset += uint64(len(records))
+			count += len(scs)
+		}
+		if slot == last {
+			break
+		}
+	}
+
+	trailer := make([]byte, 0, archiveTrailerSize)
+	trailer = binary.LittleEndian.AppendUint64(trailer, offset)
+	trailer = binary.LittleEndian.AppendUint64(trailer, uint64(len(index)/archiveEntrySize))
+	trailer = binary.LittleEndian.AppendUint32(trailer, crc32.Checksum(index, archiveCRCTable))
+	trailer = append(trailer, archiveMagic...)
+	if _, err := bw.Write(index); err != nil {
+		return count, err
+	}
+	if _, err := bw.Write(trailer); err != nil {
+		return count, err
+	}
+	return count, bw.Flush()
+}
+
+// ArchiveFile is a blob archive written by WriteArchive. Only its index is
+// kept in memory, sidecars are read from the file when requested.
+type ArchiveFile struct {
+	path    string
+	first   math.Slot
+	last    math.Slot
+	entries []archiveEntry
+}
+
+// OpenArchiveFile reads the header and the index of the blob archive at path.
+func OpenArchiveFile(path string) (*ArchiveFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size < archiveHeaderSize+archiveTrailerSize {
+		return nil, fmt.Errorf("%w: %s is truncated", ErrCorruptArchive, path)
+	}
+
+	header := make([]byte, archiveHeaderSize)
+	if _, err = f.ReadAt(header, 0); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(archiveMagic)], archiveMagic) {
+		return nil, fmt.Errorf("%w: %s is not a blob archive", ErrCorruptArchive, path)
+	}
+	if version := binary.LittleEndian.Uint32(header[4:]); version != archiveVersion {
+		return nil, fmt.Errorf(
+			"%w: %s has unsupported version %d", ErrCorruptArchive, path, version,
+		)
+	}
+	a := &ArchiveFile{
+		path:  path,
+		first: math.Slot(binary.LittleEndian.Uint64(header[8:])),
+		last:  math.Slot(binary.LittleEndian.Uint64(header[16:])),
+	}
+
+	trailer := make([]byte, archiveTrailerSize)
+	if _, err = f.ReadAt(trailer, size-archiveTrailerSize); err != nil {
+		return nil, err
+	}
+	var (
+		indexOffset = binary.LittleEndian.Uint64(trailer)
+		numEntries  = binary.LittleEndian.Uint64(trailer[8:])
+		checksum    = binary.LittleEndian.Uint32(trailer[16:])
+		//#nosec:G115 // size is at least the size of the header and trailer.
+		indexEnd = uint64(size - archiveTrailerSize)
+	)
+	if !bytes.Equal(trailer[20:], archiveMagic) ||
+		indexOffset < archiveHeaderSize || indexOffset > indexEnd ||
+		numEntries != (indexEnd-indexOffset)/archiveEntrySize ||
+		(indexEnd-indexOffset)%archiveEntrySize != 0 {
+		return nil, fmt.Errorf("%w: %s has a malformed trailer", ErrCorruptArchive, path)
+	}
+
+	index := make([]byte, indexEnd-indexOffset)
+	//#nosec:G115 // offset within the file.
+	if _, err = f.ReadAt(index, int64(indexOffset)); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(index, archiveCRCTable) != checksum {
+		return nil, fmt.Errorf("%w: %s index checksum mismatch", ErrCorruptArchive, path)
+	}
+
+	a.entries = make([]archiveEntry, 0, numEntries)
+	for ; len(index) > 0; index = index[archiveEntrySize:] {
+		entry := archiveEntry{
+			slot:     math.Slot(binary.LittleEndian.Uint64(index)),
+			offset:   binary.LittleEndian.Uint64(index[8:]),
+			size:     binary.LittleEndian.Uint64(index[16:]),
+			checksum: binary.LittleEndian.Uint32(index[24:]),
+		}
+		if entry.slot < a.first || entry.slot > a.last ||
+			(len(a.entries) > 0 && entry.slot <= a.entries[len(a.entries)-1].slot) ||
+			entry.offset < archiveHeaderSize || entry.offset > indexOffset ||
+			entry.size > indexOffset-entry.offset {
+			return nil, fmt.Errorf(
+				"%w: %s has a malformed index entry for slot %d",
+				ErrCorruptArchive, path, entry.slot,
+			)
+		}
+		a.entries = append(a.entries, entry)
+	}
+	return a, nil
+}
+
+// Path returns the path of the archive.
+func (a *ArchiveFile) Path() string {
+	return a.path
+}
+
+// Range returns the first and the last slot of the archive.
+func (a *ArchiveFile) Range() (math.Slot, math.Slot) {
+	return a.first, a.last
+}
+
+// Slots returns the archived slots which have sidecars, in ascending order.
+func (a *ArchiveFile) Slots() []math.Slot {
+	slots := make([]math.Slot, 0, len(a.entries))
+	for _, entry := range a.entries {
+		slots = append(slots, entry.slot)
+	}
+	return slots
+}
+
+// GetBlobSidecars reads the sidecars of a slot from the archive. It returns
+// ErrNotArchived if the slot is outside the range of the archive.
+func (a *ArchiveFile) GetBlobSidecars(slot math.Slot) (types.BlobSidecars, error) {
+	if slot < a.first || slot > a.last {
+		return nil, ErrNotArchived
+	}
+	i, found := slices.BinarySearchFunc(
+		a.entries, slot, func(e archiveEntry, s math.Slot) int {
+			return cmp.Compare(e.slot, s)
+		},
+	)
+	if !found {
+		return types.BlobSidecars{}, nil
+	}
+	entry := a.entries[i]
+
+	f, err := os.Open(a.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	records := make([]byte, entry.size)
+	//#nosec:G115 // offset within the file.
+	if _, err = f.ReadAt(records, int64(entry.offset)); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(records, archiveCRCTable) != entry.checksum {
+		return nil, fmt.Errorf(
+			"%w: %s checksum mismatch for slot %d", ErrCorruptArchive, a.path, slot,
+		)
+	}
+
+	var sidecars types.BlobSidecars
+	for len(records) > 0 {
+		if len(records) < recordLengthSize {
+			return nil, fmt.Errorf("%w: %s truncated record", ErrCorruptArchive, a.path)
+		}
+		n := uint64(binary.LittleEndian.Uint32(records))
+		records = records[recordLengthSize:]
+		if uint64(len(records)) < n {
+			return nil, fmt.Errorf("%w: %s truncated record", ErrCorruptArchive, a.path)
+		}
+		sidecar := new(types.BlobSidecar)
+		if err = ssz.Unmarshal(records[:n], sidecar); err != nil {
+			return nil, err
+		}
+		if sidecar.GetBeaconBlockHeader().GetSlot() != slot {
+			return nil, fmt.Errorf(
+				"%w: %s holds a sidecar of slot %d under slot %d",
+				ErrCorruptArchive, a.path, sidecar.GetBeaconBlockHeader().GetSlot(), slot,
+			)
+		}
+		sidecars = append(sidecars, sidecar)
+		records = records[n:]
+	}
+	return sidecars, nil
+}
+
+// Archive serves the sidecars of the blob archives of a directory.
+type Archive struct {
+	files []*ArchiveFile
+}
+
+// OpenArchive opens the blob archives of dir, that is its files with the
+// ArchiveExtension extension. Archives added to dir afterwards are ignored.
+func OpenArchive(dir string) (*Archive, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	a := &Archive{files: make([]*ArchiveFile, 0, len(dirEntries))}
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || !strings.HasSuffix(dirEntry.Name(), ArchiveExtension) {
+			continue
+		}
+		var file *ArchiveFile
+		if file, err = OpenArchiveFile(filepath.Join(dir, dirEntry.Name())); err != nil {
+			return nil, err
+		}
+		a.files = append(a.files, file)
+	}
+	return a, nil
+}
+
+// Files returns the blob archives of the directory.
+func (a *Archive) Files() []*ArchiveFile {
+	return a.files
+}
+
+// GetBlobSidecars reads the sidecars of a slot from the first archive of the
+// directory whose range includes the slot. It returns ErrNotArchived if there
+// is none.
+func (a *Archive) GetBlobSidecars(slot math.Slot) (types.BlobSidecars, error) {
+	for _, file := range a.files {
+		sidecars, err := file.GetBlobSidecars(slot)
+		if errors.Is(err, ErrNotArchived) {
+			continue
+		}
+		return sidecars, err
+	}
+	return nil, ErrNotArchived
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+// testSidecars returns n sidecars of the given slot.
+func testSidecars(slot math.Slot, n int) datypes.BlobSidecars {
+	sidecars := make(datypes.BlobSidecars, n)
+	for i := range sidecars {
+		sidecars[i] = &datypes.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: eip4844.KZGCommitment{byte(slot), byte(i + 1)},
+			SignedBeaconBlockHeader: &types.SignedBeaconBlockHeader{
+				Header: &types.BeaconBlockHeader{Slot: slot},
+			},
+			InclusionProof: make([]common.Root, types.KZGInclusionProofDepth),
+		}
+	}
+	return sidecars
+}
+
+// writeTestArchive writes an archive of slots 10 to 20, of which slots 12 and
+// 15 have sidecars.
+func writeTestArchive(t *testing.T, path string) map[math.Slot]datypes.BlobSidecars {
+	t.Helper()
+	stored := map[math.Slot]datypes.BlobSidecars{
+		12: testSidecars(12, 2),
+		15: testSidecars(15, 1),
+	}
+	count, err := store.WriteArchive(path, 10, 20, func(slot math.Slot) (datypes.BlobSidecars, error) {
+		return stored[slot], nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	return stored
+}
+
+func TestArchive_RoundTrip(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "blobs-10-20"+store.ArchiveExtension)
+	stored := writeTestArchive(t, path)
+
+	a, err := store.OpenArchiveFile(path)
+	require.NoError(t, err)
+	first, last := a.Range()
+	require.Equal(t, math.Slot(10), first)
+	require.Equal(t, math.Slot(20), last)
+	require.Equal(t, []math.Slot{12, 15}, a.Slots())
+
+	for slot, want := range stored {
+		got, getErr := a.GetBlobSidecars(slot)
+		require.NoError(t, getErr)
+		require.Equal(t, want, got)
+	}
+
+	// Slots of the range without sidecars are empty, others are not archived.
+	got, err := a.GetBlobSidecars(13)
+	require.NoError(t, err)
+	require.Empty(t, got)
+	_, err = a.GetBlobSidecars(21)
+	require.ErrorIs(t, err, store.ErrNotArchived)
+}
+
+func TestArchive_Corrupt(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "blobs"+store.ArchiveExtension)
+	writeTestArchive(t, path)
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// A flipped bit in the sidecars of a slot is caught when they are read.
+	corrupt := append([]byte{}, bz...)
+	corrupt[100] ^= 0x01
+	require.NoError(t, os.WriteFile(path, corrupt, 0600))
+	a, err := store.OpenArchiveFile(path)
+	require.NoError(t, err)
+	_, err = a.GetBlobSidecars(12)
+	require.ErrorIs(t, err, store.ErrCorruptArchive)
+	_, err = a.GetBlobSidecars(15)
+	require.NoError(t, err)
+
+	// A truncated archive is rejected.
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)-1], 0600))
+	_, err = store.OpenArchiveFile(path)
+	require.ErrorIs(t, err, store.ErrCorruptArchive)
+}
+
+func TestStore_GetArchivedBlobSidecars(t *testing.T) {
+	t.Parallel()
+	archiveDir := t.TempDir()
+	stored := writeTestArchive(t, filepath.Join(archiveDir, "blobs"+store.ArchiveExtension))
+	archive, err := store.OpenArchive(archiveDir)
+	require.NoError(t, err)
+	require.Len(t, archive.Files(), 1)
+
+	logger := log.NewNopLogger()
+	newStore := func(opts ...store.Option) *store.Store {
+		return store.New(
+			filedb.NewRangeDB(
+				filedb.NewDB(filedb.WithRootDirectory(t.TempDir()),
+					filedb.WithFileExtension("ssz"),
+					filedb.WithDirectoryPermissions(0700),
+					filedb.WithLogger(logger),
+				),
+			),
+			logger.With("service", "da-store"),
+			opts...,
+		)
+	}
+
+	// Without archives, pruned sidecars are not available.
+	s := newStore()
+	_, err = s.GetArchivedBlobSidecars(12)
+	require.ErrorIs(t, err, store.ErrNotArchived)
+
+	// Archived sidecars are served from the archives.
+	s = newStore(store.WithArchive(archive))
+	got, err := s.GetArchivedBlobSidecars(12)
+	require.NoError(t, err)
+	require.Equal(t, stored[12], got)
+	_, err = s.GetArchivedBlobSidecars(30)
+	require.ErrorIs(t, err, store.ErrNotArchived)
+
+	// In archive mode, the other sidecars are served from the store.
+	s = newStore(store.WithArchive(archive), store.WithArchiveMode(true))
+	require.NoError(t, s.Persist(testSidecars(30, 1)))
+	got, err = s.GetArchivedBlobSidecars(30)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, math.Slot(30), got[0].GetBeaconBlockHeader().GetSlot())
+}
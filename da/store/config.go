@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+// Config is the configuration of the blob sidecar store.
+type Config struct {
+	// ArchiveMode disables the pruning of blob sidecars past the data
+	// availability period, keeping every sidecar in the store.
+	ArchiveMode bool `mapstructure:"archive-mode"`
+	// ArchiveDir is the path to a read-only directory of blob archives,
+	// written by `blobs export`, from which the sidecars pruned from the
+	// store are served. Archives are not served if empty.
+	ArchiveDir string `mapstructure:"archive-dir"`
+}
+
+// DefaultConfig returns the default configuration of the blob sidecar store.
+func DefaultConfig() Config {
+	return Config{
+		ArchiveMode: false,
+		ArchiveDir:  "",
+	}
+}
@@ -34,4 +34,12 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrNotArchived is returned when the sidecars of a slot are neither
+	// archived nor kept in the store.
+	ErrNotArchived = errors.New("blob sidecars not archived")
+
+	// ErrCorruptArchive is returned when a blob archive is malformed or fails
+	// its checksums.
+	ErrCorruptArchive = errors.New("corrupt blob archive")
 )
@@ -34,4 +34,17 @@ type IndexDB interface {
 	// exist in the DB for any reason (pruned, invalid index), an empty list is
 	// returned with no error.
 	GetByIndex(index uint64) ([][]byte, error)
+
+	// IndexesAfter returns the populated indexes greater than index, in
+	// ascending order.
+	IndexesAfter(index uint64) ([]uint64, error)
+
+	// DeleteAfter removes all entries of the indexes greater than index.
+	DeleteAfter(index uint64) error
+
+	// Quarantine moves the partially written entries, and the corrupt entries
+	// of the recent highest indexes, out of the database. Entries stored
+	// without a checksum are checked with validate. It returns the number of
+	// quarantined entries.
+	Quarantine(recent int, validate func(value []byte) error) (int, error)
 }
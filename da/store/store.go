@@ -25,28 +25,44 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
+// quarantineRecentSlots is the number of most recent slots whose sidecars are
+// verified at startup. Sidecars are persisted one slot at a time, so a crash
+// can only have left partial sidecars in the last slots written.
+const quarantineRecentSlots = 4
+
 // Store is the default implementation of the AvailabilityStore.
 type Store struct {
 	// IndexDB is a basic database interface.
 	IndexDB
 	// logger is used for logging.
 	logger log.Logger
+	// archiveMode is set if the sidecars are kept past the data availability
+	// period.
+	archiveMode bool
+	// archive serves the sidecars pruned from the store, if set.
+	archive *Archive
 }
 
 // New creates a new instance of the AvailabilityStore.
 func New(
 	db IndexDB,
 	logger log.Logger,
+	opts ...Option,
 ) *Store {
-	return &Store{
+	s := &Store{
 		IndexDB: db,
 		logger:  logger,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // IsDataAvailable ensures that all blobs referenced in the block are
@@ -66,6 +82,23 @@ func (s *Store) IsDataAvailable(
 	return true
 }
 
+// QuarantineCorruptSidecars moves the sidecars which were partially written
+// before a crash, or are corrupt in the most recent slots, out of the store so
+// that they are no longer reported as available. It is meant to be run at
+// startup.
+func (s *Store) QuarantineCorruptSidecars() error {
+	quarantined, err := s.IndexDB.Quarantine(quarantineRecentSlots, func(bz []byte) error {
+		return ssz.Unmarshal(bz, new(types.BlobSidecar))
+	})
+	if err != nil {
+		return err
+	}
+	if quarantined > 0 {
+		s.logger.Warn("Quarantined corrupt blob sidecars", "count", quarantined)
+	}
+	return nil
+}
+
 // GetBlobSidecars fetches the sidecars for a specific slot.
 func (s *Store) GetBlobSidecars(slot math.Slot) (types.BlobSidecars, error) {
 	sidecarBzs, err := s.IndexDB.GetByIndex(slot.Unwrap())
@@ -85,6 +118,22 @@ func (s *Store) GetBlobSidecars(slot math.Slot) (types.BlobSidecars, error) {
 	return sidecars, nil
 }
 
+// GetArchivedBlobSidecars fetches the sidecars of a slot past the data
+// availability period, from the blob archives or, in archive mode, from the
+// store. It returns ErrNotArchived if neither holds the slot.
+func (s *Store) GetArchivedBlobSidecars(slot math.Slot) (types.BlobSidecars, error) {
+	if s.archive != nil {
+		sidecars, err := s.archive.GetBlobSidecars(slot)
+		if !errors.Is(err, ErrNotArchived) {
+			return sidecars, err
+		}
+	}
+	if !s.archiveMode {
+		return nil, ErrNotArchived
+	}
+	return s.GetBlobSidecars(slot)
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store) Persist(sidecars types.BlobSidecars) error {
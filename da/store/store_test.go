@@ -21,6 +21,9 @@
 package store_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -28,6 +31,8 @@ import (
 	"github.com/berachain/beacon-kit/da/store"
 	datypes "github.com/berachain/beacon-kit/da/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/storage/filedb"
 	"github.com/stretchr/testify/require"
@@ -87,3 +92,53 @@ func TestStore_PersistRace(t *testing.T) {
 	err = s.Persist(sidecars)
 	require.NoError(t, err)
 }
+
+func TestStore_QuarantineCorruptSidecars(t *testing.T) {
+	t.Parallel()
+	tmpFilePath := t.TempDir()
+	logger := log.NewNopLogger()
+	s := store.New(
+		filedb.NewRangeDB(
+			filedb.NewDB(filedb.WithRootDirectory(tmpFilePath),
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(0700),
+				filedb.WithLogger(logger),
+			),
+		),
+		logger.With("service", "da-store"),
+	)
+
+	sidecars := make(datypes.BlobSidecars, 2)
+	for i := range sidecars {
+		sidecars[i] = &datypes.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: eip4844.KZGCommitment{byte(i + 1)},
+			SignedBeaconBlockHeader: &types.SignedBeaconBlockHeader{
+				Header: &types.BeaconBlockHeader{Slot: 1},
+			},
+			InclusionProof: make([]common.Root, types.KZGInclusionProofDepth),
+		}
+	}
+	require.NoError(t, s.Persist(sidecars))
+	body := &types.BeaconBlockBody{
+		BlobKzgCommitments: []eip4844.KZGCommitment{
+			sidecars[0].KzgCommitment, sidecars[1].KzgCommitment,
+		},
+	}
+	require.True(t, s.IsDataAvailable(context.Background(), 1, body))
+
+	// A sidecar truncated by a crash before writes were atomic.
+	bz, err := sidecars[1].MarshalSSZ()
+	require.NoError(t, err)
+	path := filepath.Join(
+		tmpFilePath, "1", hex.EncodeBytes(sidecars[1].KzgCommitment[:])+".ssz",
+	)
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)/2], 0600))
+
+	require.NoError(t, s.QuarantineCorruptSidecars())
+	require.False(t, s.IsDataAvailable(context.Background(), 1, body))
+	stored, err := s.GetBlobSidecars(1)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.Equal(t, sidecars[0].KzgCommitment, stored[0].KzgCommitment)
+}
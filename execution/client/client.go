@@ -26,9 +26,9 @@ import (
 	"sync"
 	"time"
 
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
 	"github.com/berachain/beacon-kit/errors"
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
-	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/net/http"
@@ -37,6 +37,7 @@ import (
 
 // EngineClient is a struct that holds a pointer to an Eth1Client.
 type EngineClient struct {
+	// Client forwards calls to the active endpoint.
 	*ethclient.Client
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
@@ -46,12 +47,22 @@ type EngineClient struct {
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
-	// connected will be set to true when we have successfully connected
-	// to the execution client.
-	connectedMu sync.RWMutex
-	connected   bool
+	// endpoints are the execution client endpoints, in order of priority.
+	// The first one is the configured dial url, followed by the fallbacks.
+	endpoints []*endpoint
+	// activeMu protects active for concurrent access.
+	activeMu sync.RWMutex
+	// active is the endpoint engine calls are sent to.
+	active *endpoint
+	// activeSwitched is closed when the active endpoint is switched.
+	activeSwitched chan struct{}
+	// payloadMu protects payloadEndpoints for concurrent access.
+	payloadMu sync.Mutex
+	// payloadEndpoints are the endpoints that started the most recent
+	// payload builds, as payload IDs are local to an execution client.
+	payloadEndpoints map[engineprimitives.PayloadID]*endpoint
+	// payloadIDs are the keys of payloadEndpoints, oldest first.
+	payloadIDs []engineprimitives.PayloadID
 }
 
 // New creates a new engine client EngineClient.
@@ -64,11 +75,10 @@ func New(
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
 ) *EngineClient {
-	ethClient := ethclientrpc.NewClient(
-		cfg.RPCDialURL.String(),
-		jwtSecret,
-		cfg.RPCJWTRefreshInterval,
-	)
+	endpoints := []*endpoint{newEndpoint(cfg.RPCDialURL, cfg, jwtSecret)}
+	for _, u := range cfg.RPCFallbackDialURLs {
+		endpoints = append(endpoints, newEndpoint(u, cfg, jwtSecret))
+	}
 
 	// Enforcing minimum rpc timeout
 	// The reason we do it is that we previously suggested a
@@ -86,19 +96,35 @@ func New(
 	}
 	cfg.RPCTimeout = max(MinRPCTimeout, cfg.RPCTimeout)
 
+	// Configs predating health checks do not set their interval.
+	if cfg.RPCHealthCheckInterval <= 0 {
+		cfg.RPCHealthCheckInterval = defaultRPCHealthCheckInterval
+	}
+
+	if len(cfg.RPCFallbackDialURLs) > 0 && !cfg.RPCShadowNewPayload {
+		logger.Warn(
+			"Fallback execution clients are not kept in sync without rpc-shadow-new-payload, " +
+				"failing over will wait for them to sync",
+		)
+	}
+
 	if cfg.DeprecatedRPCRetries != 0 {
 		logger.Warn("rpc-retries is deprecated and the configured value will be ignored")
 	}
 
-	return &EngineClient{
-		cfg:          cfg,
-		logger:       logger,
-		Client:       ethclient.New(ethClient),
-		capabilities: make(map[string]struct{}),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
-		connected:    false,
+	s := &EngineClient{
+		cfg:         cfg,
+		logger:      logger,
+		eth1ChainID: eth1ChainID,
+		metrics:     newClientMetrics(telemetrySink, logger),
+		endpoints:   endpoints,
+		active:      endpoints[0],
+
+		activeSwitched:   make(chan struct{}),
+		payloadEndpoints: make(map[engineprimitives.PayloadID]*endpoint),
 	}
+	s.Client = ethclient.New(activeRPC{s: s})
+	return s
 }
 
 // Name returns the name of the engine client.
@@ -114,11 +140,13 @@ func (s *EngineClient) Start(ctx context.Context) error {
 	s.logger.Info(
 		"Initializing connection to the execution client...",
 		"dial_url", s.cfg.RPCDialURL.String(),
+		"fallbacks", len(s.cfg.RPCFallbackDialURLs),
 	)
 
 	// If the connection connection succeeds, we can skip the
 	// connection initialization loop.
-	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+	if s.checkEndpoints(ctx) {
+		go s.healthCheckLoop(ctx)
 		return nil
 	}
 
@@ -134,43 +162,129 @@ func (s *EngineClient) Start(ctx context.Context) error {
 				"Waiting for execution client to start... 🍺🕔",
 				"dial_url", s.cfg.RPCDialURL,
 			)
-			if err := s.verifyChainIDAndConnection(ctx); err != nil {
-				if errors.Is(err, ErrMismatchedEth1ChainID) {
-					s.logger.Error(err.Error())
-				}
+			if !s.checkEndpoints(ctx) {
 				continue
 			}
-			s.connectedMu.Lock()
-			s.connected = true
-			s.connectedMu.Unlock()
+			go s.healthCheckLoop(ctx)
 			return nil
 		}
 	}
 }
 
+// Stop closes the connections to the execution clients.
 func (s *EngineClient) Stop() error {
-	return nil
+	return s.Client.Close()
 }
 
+// IsConnected returns true if the active endpoint is connected.
 func (s *EngineClient) IsConnected() bool {
-	s.connectedMu.RLock()
-	defer s.connectedMu.RUnlock()
-	return s.connected
+	return s.activeEndpoint().isHealthy()
 }
 
+// HasCapability returns true if the active endpoint has the capability.
 func (s *EngineClient) HasCapability(capability string) bool {
-	_, ok := s.capabilities[capability]
-	return ok
+	return s.activeEndpoint().hasCapability(capability)
 }
 
 /* -------------------------------------------------------------------------- */
 /*                                   Helpers                                  */
 /* -------------------------------------------------------------------------- */
 
+// activeEndpoint returns the endpoint engine calls are sent to.
+func (s *EngineClient) activeEndpoint() *endpoint {
+	s.activeMu.RLock()
+	defer s.activeMu.RUnlock()
+	return s.active
+}
+
+// activeEndpointUntilSwitch returns the endpoint engine calls are sent to,
+// along with a channel closed when it is switched.
+func (s *EngineClient) activeEndpointUntilSwitch() (*endpoint, <-chan struct{}) {
+	s.activeMu.RLock()
+	defer s.activeMu.RUnlock()
+	return s.active, s.activeSwitched
+}
+
+// setActiveEndpoint makes ep the endpoint engine calls are sent to.
+func (s *EngineClient) setActiveEndpoint(ep *endpoint) {
+	s.activeMu.Lock()
+	defer s.activeMu.Unlock()
+	if s.active == ep {
+		return
+	}
+	s.logger.Warn(
+		"Switching execution client endpoint 🔀",
+		"from", s.active.url.String(),
+		"to", ep.url.String(),
+	)
+	s.metrics.incrementEndpointSwitchCounter()
+	s.active = ep
+	close(s.activeSwitched)
+	s.activeSwitched = make(chan struct{})
+}
+
+// healthCheckLoop periodically checks the health of all endpoints.
+func (s *EngineClient) healthCheckLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.RPCHealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkEndpoints(ctx)
+		}
+	}
+}
+
+// checkEndpoints checks the health of all endpoints and activates the
+// healthy endpoint with the highest priority. It returns false if no
+// endpoint is healthy.
+func (s *EngineClient) checkEndpoints(ctx context.Context) bool {
+	var best *endpoint
+	for _, ep := range s.endpoints {
+		if s.checkEndpoint(ctx, ep) == nil && best == nil {
+			best = ep
+		}
+	}
+	if best == nil {
+		return false
+	}
+	s.setActiveEndpoint(best)
+	return true
+}
+
+// checkEndpoint checks the health of the endpoint. Healthy endpoints are
+// probed for their chain ID, while unhealthy ones are fully verified again.
+func (s *EngineClient) checkEndpoint(ctx context.Context, ep *endpoint) error {
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	if !ep.isHealthy() {
+		if err := s.verifyChainIDAndConnection(cctx, ep); err != nil {
+			return err
+		}
+		ep.setHealthy(true)
+		return nil
+	}
+
+	if _, err := ep.client.ChainID(cctx); err != nil {
+		s.logger.Warn(
+			"Execution client endpoint is unhealthy",
+			"dial_url", ep.url.String(),
+			"err", err,
+		)
+		ep.setHealthy(false)
+		return err
+	}
+	return nil
+}
+
 // verifyChainID dials the execution client and
 // ensures the chain ID is correct.
 func (s *EngineClient) verifyChainIDAndConnection(
 	ctx context.Context,
+	ep *endpoint,
 ) error {
 	var (
 		err     error
@@ -179,12 +293,12 @@ func (s *EngineClient) verifyChainIDAndConnection(
 
 	defer func() {
 		if err != nil {
-			err = s.Client.Close()
+			err = ep.rpc.Close()
 		}
 	}()
 
 	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
+	chainID, err = ep.client.ChainID(ctx)
 	if err != nil {
 		if errors.Is(err, http.ErrUnauthorized) {
 			// We always log this error as it is a critical error.
@@ -209,19 +323,20 @@ func (s *EngineClient) verifyChainIDAndConnection(
 			s.eth1ChainID,
 			chainID,
 		)
+		s.logger.Error(err.Error())
 		return err
 	}
 
 	// Log the chain ID.
 	s.logger.Info(
 		"Connected to execution client 🔌",
-		"dial_url", s.cfg.RPCDialURL.String(),
+		"dial_url", ep.url.String(),
 		"chain_id", chainID.Unwrap(),
 		"required_chain_id", s.eth1ChainID,
 	)
 
 	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
+	if err = s.exchangeCapabilities(ctx, ep); err != nil {
 		s.logger.Error("failed to exchange capabilities", "err", err)
 		return err
 	}
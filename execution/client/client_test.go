@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/execution/client"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/testing/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testEth1ChainID = 80094
+
+// stubEL is a stand-in execution client answering the engine API calls made
+// by the engine client with a configurable payload status.
+type stubEL struct {
+	*httptest.Server
+	status      atomic.Value
+	payloadID   engineprimitives.PayloadID
+	newPayloads atomic.Int64
+	forkchoices atomic.Int64
+	getPayloads atomic.Int64
+}
+
+func newStubEL(t *testing.T, status string) *stubEL {
+	t.Helper()
+	return newStubELWithPayloadID(t, status, engineprimitives.PayloadID{})
+}
+
+// newStubELWithPayloadID creates a stub execution client starting payload
+// builds with the given ID.
+func newStubELWithPayloadID(
+	t *testing.T, status string, payloadID engineprimitives.PayloadID,
+) *stubEL {
+	t.Helper()
+	el := &stubEL{payloadID: payloadID}
+	el.status.Store(status)
+	el.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     int    `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		payloadStatus := map[string]any{
+			"status":          el.status.Load(),
+			"latestValidHash": common.ExecutionHash{0x01},
+		}
+		var result any
+		switch {
+		case req.Method == "eth_chainId":
+			result = hexutil.EncodeUint64(testEth1ChainID)
+		case req.Method == "engine_exchangeCapabilities":
+			result = []string{}
+		case strings.HasPrefix(req.Method, "engine_newPayload"):
+			el.newPayloads.Add(1)
+			result = payloadStatus
+		case strings.HasPrefix(req.Method, "engine_forkchoiceUpdated"):
+			el.forkchoices.Add(1)
+			result = map[string]any{"payloadStatus": payloadStatus, "payloadId": el.payloadID}
+		case strings.HasPrefix(req.Method, "engine_getPayload"):
+			el.getPayloads.Add(1)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0", "id": req.ID, "result": result,
+		})
+	}))
+	t.Cleanup(el.Close)
+	return el
+}
+
+// countingSink is a telemetry sink counting the incremented counters.
+type countingSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (s *countingSink) IncrementCounter(key string, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key]++
+}
+
+func (s *countingSink) MeasureSince(string, time.Time, ...string) {}
+
+func (s *countingSink) count(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[key]
+}
+
+func TestEngineClientFailover(t *testing.T) {
+	t.Parallel()
+	primary := newStubEL(t, engineprimitives.PayloadStatusValid)
+	fallback := newStubEL(t, engineprimitives.PayloadStatusSyncing)
+
+	primaryURL, err := url.NewFromRaw(primary.URL)
+	require.NoError(t, err)
+	fallbackURL, err := url.NewFromRaw(fallback.URL)
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = primaryURL
+	cfg.RPCFallbackDialURLs = []*url.ConnectionURL{fallbackURL}
+	cfg.RPCShadowNewPayload = true
+	cfg.RPCHealthCheckInterval = time.Hour
+
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	sink := &countingSink{counters: make(map[string]int)}
+	ec := client.New(&cfg, noop.NewLogger[any](), secret, sink, big.NewInt(testEth1ChainID))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, ec.Start(ctx))
+	require.True(t, ec.IsConnected())
+
+	req, err := ctypes.BuildNewPayloadRequestFromFork(
+		utils.GenerateValidBeaconBlock(t, version.Deneb1()), nil,
+	)
+	require.NoError(t, err)
+
+	// The primary serves the payload and the fallback disagrees in the
+	// background.
+	_, err = ec.NewPayload(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), primary.newPayloads.Load())
+	require.Eventually(t, func() bool {
+		return sink.count("beacon_kit.execution.client.shadow_new_payload_mismatch") == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, int64(1), fallback.newPayloads.Load())
+
+	// Once the primary goes down, calls fail over to the fallback.
+	primary.Close()
+	fallback.status.Store(engineprimitives.PayloadStatusValid)
+	_, err = ec.NewPayload(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), fallback.newPayloads.Load())
+	require.Equal(t, 1, sink.count("beacon_kit.execution.client.endpoint_switch"))
+	require.True(t, ec.IsConnected())
+
+	// Subsequent calls go straight to the fallback.
+	_, err = ec.ForkchoiceUpdated(
+		ctx, &engineprimitives.ForkchoiceStateV1{}, nil, version.Deneb1(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), fallback.forkchoices.Load())
+	require.Zero(t, primary.forkchoices.Load())
+}
+
+func TestEngineClientGetPayloadFromBuildingEndpoint(t *testing.T) {
+	t.Parallel()
+	primaryID := engineprimitives.PayloadID{0x01}
+	fallbackID := engineprimitives.PayloadID{0x02}
+	primary := newStubELWithPayloadID(t, engineprimitives.PayloadStatusValid, primaryID)
+	fallback := newStubELWithPayloadID(t, engineprimitives.PayloadStatusValid, fallbackID)
+
+	primaryURL, err := url.NewFromRaw(primary.URL)
+	require.NoError(t, err)
+	fallbackURL, err := url.NewFromRaw(fallback.URL)
+	require.NoError(t, err)
+	cfg := client.DefaultConfig()
+	cfg.RPCDialURL = primaryURL
+	cfg.RPCFallbackDialURLs = []*url.ConnectionURL{fallbackURL}
+	cfg.RPCShadowNewPayload = true
+	cfg.RPCHealthCheckInterval = time.Hour
+
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	sink := &countingSink{counters: make(map[string]int)}
+	ec := client.New(&cfg, noop.NewLogger[any](), secret, sink, big.NewInt(testEth1ChainID))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, ec.Start(ctx))
+
+	attrs, err := engineprimitives.NewPayloadAttributes(
+		version.Deneb1(), 1, common.Bytes32{0x01}, common.ExecutionAddress{0x01},
+		engineprimitives.Withdrawals{}, common.Root{}, nil,
+	)
+	require.NoError(t, err)
+	fcState := &engineprimitives.ForkchoiceStateV1{}
+
+	// The primary starts the build, and the fallback follows the forkchoice
+	// without building.
+	payloadID, err := ec.ForkchoiceUpdated(ctx, fcState, attrs, version.Deneb1())
+	require.NoError(t, err)
+	require.Equal(t, primaryID, *payloadID)
+	require.Eventually(t, func() bool {
+		return fallback.forkchoices.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// After failing over, the payload is still requested from the primary
+	// that built it.
+	req, err := ctypes.BuildNewPayloadRequestFromFork(
+		utils.GenerateValidBeaconBlock(t, version.Deneb1()), nil,
+	)
+	require.NoError(t, err)
+	primary.Close()
+	_, err = ec.NewPayload(ctx, req)
+	require.NoError(t, err)
+	_, err = ec.GetPayload(ctx, primaryID, version.Deneb1())
+	require.Error(t, err)
+	require.Zero(t, fallback.getPayloads.Load())
+
+	// Builds started by the fallback are retrieved from it.
+	payloadID, err = ec.ForkchoiceUpdated(ctx, fcState, attrs, version.Deneb1())
+	require.NoError(t, err)
+	require.Equal(t, fallbackID, *payloadID)
+	_, _ = ec.GetPayload(ctx, fallbackID, version.Deneb1())
+	require.Equal(t, int64(1), fallback.getPayloads.Load())
+}
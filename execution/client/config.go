@@ -34,6 +34,8 @@ const (
 	defaultRPCMaxRetryInterval     = 10 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 30 * time.Second
+	defaultRPCHealthCheckInterval  = 5 * time.Second
+	defaultDepositFetchMaxSpan     = 1000
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -49,14 +51,25 @@ func DefaultConfig() Config {
 		RPCTimeout:              MinRPCTimeout,
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
+		RPCHealthCheckInterval:  defaultRPCHealthCheckInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		DepositFetchMaxSpan:     defaultDepositFetchMaxSpan,
 	}
 }
 
 // Config is the configuration struct for the execution client.
 type Config struct {
-	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
+	// RPCDialURL is the url of the execution client JSON-RPC endpoint, over
+	// HTTP(S), WebSocket or IPC.
 	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
+	// RPCFallbackDialURLs are the urls of execution clients to fail over to,
+	// in order of priority, when the execution client at RPCDialURL is
+	// unavailable.
+	RPCFallbackDialURLs []*url.ConnectionURL `mapstructure:"rpc-fallback-dial-urls"`
+	// RPCShadowNewPayload also sends new payloads and forkchoice updates to
+	// the healthy fallback execution clients, reporting any disagreement on
+	// the payload status. Fallbacks are only kept in sync when it is set.
+	RPCShadowNewPayload bool `mapstructure:"rpc-shadow-new-payload"`
 	// DeprecatedRPCRetries is deprecated.
 	DeprecatedRPCRetries uint64 `mapstructure:"rpc-retries"`
 	// RPCRetryInterval is the initial RPC backoff for repeated execution client calls.
@@ -69,6 +82,12 @@ type Config struct {
 	RPCStartupCheckInterval time.Duration `mapstructure:"rpc-startup-check-interval"`
 	// JWTRefreshInterval is the Interval for the JWT refresh.
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
+	// RPCHealthCheckInterval is the interval at which the health of the
+	// execution clients is checked.
+	RPCHealthCheckInterval time.Duration `mapstructure:"rpc-health-check-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// DepositFetchMaxSpan is the maximum number of EL blocks whose deposit logs
+	// are queried in a single call.
+	DepositFetchMaxSpan uint64 `mapstructure:"deposit-fetch-max-span"`
 }
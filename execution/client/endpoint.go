@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
+	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/berachain/beacon-kit/primitives/net/url"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// endpoint is an execution client endpoint along with its health.
+type endpoint struct {
+	// url is the dial url of the endpoint.
+	url *url.ConnectionURL
+	// rpc is the transport used to reach the endpoint.
+	rpc ethclientrpc.Client
+	// client is the eth client over rpc.
+	client *ethclient.Client
+
+	// mu protects the fields below for concurrent access.
+	mu sync.RWMutex
+	// healthy is true while the endpoint is connected and on the right chain.
+	healthy bool
+	// capabilities is a map of capabilities that the endpoint has.
+	capabilities map[string]struct{}
+}
+
+// newEndpoint creates an endpoint for the given url, picking the transport
+// from its scheme.
+func newEndpoint(u *url.ConnectionURL, cfg *Config, jwtSecret *jwt.Secret) *endpoint {
+	var rpc ethclientrpc.Client
+	switch {
+	case u.IsIPC():
+		rpc = ethclientrpc.NewIPCClient(u.IPCPath())
+	case u.IsWebSocket():
+		rpc = ethclientrpc.NewWebSocketClient(u.String(), jwtSecret)
+	default:
+		rpc = ethclientrpc.NewClient(u.String(), jwtSecret, cfg.RPCJWTRefreshInterval)
+	}
+	return &endpoint{
+		url:          u,
+		rpc:          rpc,
+		client:       ethclient.New(rpc),
+		capabilities: make(map[string]struct{}),
+	}
+}
+
+func (e *endpoint) isHealthy() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.healthy
+}
+
+func (e *endpoint) setHealthy(healthy bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.healthy = healthy
+}
+
+func (e *endpoint) hasCapability(capability string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.capabilities[capability]
+	return ok
+}
+
+func (e *endpoint) setCapabilities(capabilities []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.capabilities = make(map[string]struct{}, len(capabilities))
+	for _, capability := range capabilities {
+		e.capabilities[capability] = struct{}{}
+	}
+}
+
+var _ ethclientrpc.SubscriptionClient = (*activeRPC)(nil)
+
+// activeRPC is an rpc client forwarding every call to the active endpoint of
+// the engine client, so that plain eth calls follow failovers.
+type activeRPC struct {
+	s *EngineClient
+}
+
+// Start starts the rpc clients of all endpoints.
+func (a activeRPC) Start(ctx context.Context) {
+	for _, ep := range a.s.endpoints {
+		go ep.rpc.Start(ctx)
+	}
+	<-ctx.Done()
+}
+
+// Call calls the given method on the active endpoint.
+func (a activeRPC) Call(ctx context.Context, target any, method string, params ...any) error {
+	return a.s.activeEndpoint().rpc.Call(ctx, target, method, params...)
+}
+
+// Subscribe subscribes to notifications on the active endpoint. The
+// subscription fails with ErrEndpointSwitched when the active endpoint is
+// switched, as the notifications of the previous endpoint may have stopped.
+func (a activeRPC) Subscribe(
+	ctx context.Context, namespace string, channel any, args ...any,
+) (ethereum.Subscription, error) {
+	ep, switched := a.s.activeEndpointUntilSwitch()
+	sc, ok := ep.rpc.(ethclientrpc.SubscriptionClient)
+	if !ok {
+		return nil, ethclient.ErrSubscriptionsNotSupported
+	}
+	sub, err := sc.Subscribe(ctx, namespace, channel, args...)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		select {
+		case err = <-sub.Err():
+			return err
+		case <-switched:
+			return ErrEndpointSwitched
+		case <-quit:
+			return nil
+		}
+	}), nil
+}
+
+// Close closes the rpc clients of all endpoints.
+func (a activeRPC) Close() error {
+	var errs []error
+	for _, ep := range a.s.endpoints {
+		if err := ep.rpc.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
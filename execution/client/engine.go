@@ -29,7 +29,9 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
 	"github.com/berachain/beacon-kit/errors"
 	ethclient "github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 /* -------------------------------------------------------------------------- */
@@ -40,21 +42,28 @@ import (
 func (s *EngineClient) NewPayload(
 	ctx context.Context,
 	req ctypes.NewPayloadRequest,
-) (*common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+) (_ *common.ExecutionHash, err error) {
+	startTime := time.Now()
 	defer s.metrics.measureNewPayloadDuration(startTime)
-	defer cancel()
+	ctx, span := tracing.Start(
+		ctx, "engine.NewPayload",
+		attribute.Int64("block_number", int64(req.GetExecutionPayload().GetNumber().Unwrap())), // #nosec G115
+	)
+	defer func() { tracing.End(span, err) }()
 
 	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Client.NewPayload(cctx, req)
+	var result *engineprimitives.PayloadStatusV1
+	ep, err := s.withFailover(
+		ctx,
+		s.metrics.incrementNewPayloadTimeout,
+		func(cctx context.Context, ep *endpoint) error {
+			var callErr error
+			result, callErr = ep.client.NewPayload(cctx, req)
+			return callErr
+		},
+	)
 	if err != nil {
-		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
-			s.metrics.incrementNewPayloadTimeout()
-		}
-		return nil, s.handleRPCError(err)
+		return nil, err
 	}
 	if result == nil {
 		return nil, engineerrors.ErrNilPayloadStatus
@@ -70,6 +79,10 @@ func (s *EngineClient) NewPayload(
 		)
 	}
 
+	if s.cfg.RPCShadowNewPayload {
+		s.shadowNewPayload(ep, req, result)
+	}
+
 	return processPayloadStatusResult(result)
 }
 
@@ -83,13 +96,14 @@ func (s *EngineClient) ForkchoiceUpdated(
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs *engineprimitives.PayloadAttributes,
 	forkVersion common.Version,
-) (*engineprimitives.PayloadID, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+) (_ *engineprimitives.PayloadID, err error) {
+	startTime := time.Now()
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
-	defer cancel()
+	ctx, span := tracing.Start(
+		ctx, "engine.ForkchoiceUpdated",
+		attribute.Bool("has_attributes", attrs != nil),
+	)
+	defer func() { tracing.End(span, err) }()
 
 	// If the suggested fee recipient is not set, log a warning.
 	if attrs != nil &&
@@ -100,12 +114,18 @@ func (s *EngineClient) ForkchoiceUpdated(
 		)
 	}
 
-	result, err := s.Client.ForkchoiceUpdated(cctx, state, attrs, forkVersion)
+	var result *engineprimitives.ForkchoiceResponseV1
+	ep, err := s.withFailover(
+		ctx,
+		s.metrics.incrementForkchoiceUpdateTimeout,
+		func(cctx context.Context, ep *endpoint) error {
+			var callErr error
+			result, callErr = ep.client.ForkchoiceUpdated(cctx, state, attrs, forkVersion)
+			return callErr
+		},
+	)
 	if err != nil {
-		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
-			s.metrics.incrementForkchoiceUpdateTimeout()
-		}
-		return nil, s.handleRPCError(err)
+		return nil, err
 	}
 	if result == nil {
 		return nil, engineerrors.ErrNilForkchoiceResponse
@@ -115,6 +135,13 @@ func (s *EngineClient) ForkchoiceUpdated(
 	if err != nil {
 		return nil, err
 	}
+	if attrs != nil && result.PayloadID != nil {
+		s.recordPayloadEndpoint(*result.PayloadID, ep)
+	}
+
+	if s.cfg.RPCShadowNewPayload {
+		s.shadowForkchoiceUpdated(ep, state, forkVersion)
+	}
 	return result.PayloadID, nil
 }
 
@@ -128,16 +155,20 @@ func (s *EngineClient) GetPayload(
 	ctx context.Context,
 	payloadID engineprimitives.PayloadID,
 	forkVersion common.Version,
-) (ctypes.BuiltExecutionPayloadEnv, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+) (_ ctypes.BuiltExecutionPayloadEnv, err error) {
+	startTime := time.Now()
 	defer s.metrics.measureGetPayloadDuration(startTime)
+	ctx, span := tracing.Start(ctx, "engine.GetPayload")
+	defer func() { tracing.End(span, err) }()
+
+	cctx, cancel := s.createContextWithTimeout(ctx)
 	defer cancel()
 
-	// Call and check for errors.
-	result, err := s.Client.GetPayload(cctx, payloadID, forkVersion)
+	// Payload IDs are local to the execution client that started the build,
+	// so the payload is retrieved from that endpoint without failover.
+	ep := s.payloadEndpoint(payloadID)
+	span.SetAttributes(attribute.String("dial_url", ep.url.String()))
+	result, err := ep.client.GetPayload(cctx, payloadID, forkVersion)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementGetPayloadTimeout()
@@ -155,32 +186,54 @@ func (s *EngineClient) GetPayload(
 }
 
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
-// JSON-RPC.
+// JSON-RPC on the active endpoint.
 func (s *EngineClient) ExchangeCapabilities(
 	ctx context.Context,
-) ([]string, error) {
-	result, err := s.Client.ExchangeCapabilities(
+) (_ []string, err error) {
+	ctx, span := tracing.Start(ctx, "engine.ExchangeCapabilities")
+	defer func() { tracing.End(span, err) }()
+
+	ep := s.activeEndpoint()
+	if err = s.exchangeCapabilities(ctx, ep); err != nil {
+		return nil, err
+	}
+	ep.mu.RLock()
+	defer ep.mu.RUnlock()
+	result := make([]string, 0, len(ep.capabilities))
+	for capability := range ep.capabilities {
+		result = append(result, capability)
+	}
+	return result, nil
+}
+
+// exchangeCapabilities exchanges capabilities with the endpoint and records
+// them.
+func (s *EngineClient) exchangeCapabilities(ctx context.Context, ep *endpoint) error {
+	result, err := ep.client.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Capture and log the capabilities that the execution client has.
+	ep.setCapabilities(result)
 	for _, capability := range result {
-		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		s.logger.Info(
+			"Exchanged capability", "capability", capability, "dial_url", ep.url.String(),
+		)
 	}
 
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if !s.HasCapability(capability) {
+		if !ep.hasCapability(capability) {
 			s.logger.Warn(
 				"Your execution client may require an update 🚸",
 				"unsupported_capability", capability,
+				"dial_url", ep.url.String(),
 			)
 		}
 	}
 
-	return result, nil
+	return nil
 }
@@ -42,6 +42,10 @@ var (
 	// ErrBadConnection indicates that the http.Client was unable to
 	// establish a connection.
 	ErrBadConnection = errors.New("connection error")
+
+	// ErrEndpointSwitched is returned by subscriptions when the active
+	// endpoint is switched.
+	ErrEndpointSwitched = errors.New("active execution client endpoint switched")
 )
 
 // Handles errors received from the RPC server according to the specification.
@@ -33,4 +33,14 @@ var (
 	// ErrInvalidVersion is an error that is returned when the version is
 	// invalid.
 	ErrInvalidVersion = errors.New("invalid version")
+
+	// ErrBlockNotFound is an error that is returned when the execution client
+	// has no canonical block at the requested number.
+	ErrBlockNotFound = errors.New("block not found")
+
+	// ErrSubscriptionsNotSupported is an error that is returned when
+	// subscribing over a transport without subscription support.
+	ErrSubscriptionsNotSupported = errors.New(
+		"subscriptions require an ipc or websocket connection",
+	)
 )
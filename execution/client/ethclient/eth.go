@@ -26,7 +26,9 @@ import (
 	"math/big"
 
 	"github.com/berachain/beacon-kit/errors"
+	ethclientrpc "github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/geth-primitives/rpc"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -44,6 +46,59 @@ func (s *Client) ChainID(
 	return result, nil
 }
 
+// BlockHashByNumber retrieves the hash of the canonical block at the given
+// number. It returns ErrBlockNotFound if the chain has no such block.
+func (s *Client) BlockHashByNumber(
+	ctx context.Context,
+	number math.U64,
+) (common.ExecutionHash, error) {
+	var result *struct {
+		Hash common.ExecutionHash `json:"hash"`
+	}
+	if err := s.Call(
+		ctx, &result, "eth_getBlockByNumber", hexutil.EncodeUint64(number.Unwrap()), false,
+	); err != nil {
+		return common.ExecutionHash{}, err
+	}
+	if result == nil {
+		return common.ExecutionHash{}, ErrBlockNotFound
+	}
+	return result.Hash, nil
+}
+
+// LatestBlockHash retrieves the hash of the head of the canonical chain.
+func (s *Client) LatestBlockHash(ctx context.Context) (common.ExecutionHash, error) {
+	var result *struct {
+		Hash common.ExecutionHash `json:"hash"`
+	}
+	if err := s.Call(ctx, &result, "eth_getBlockByNumber", "latest", false); err != nil {
+		return common.ExecutionHash{}, err
+	}
+	if result == nil {
+		return common.ExecutionHash{}, ErrBlockNotFound
+	}
+	return result.Hash, nil
+}
+
+// SetHead rewinds the canonical chain to the block at the given number. It
+// requires the execution client to expose the debug namespace.
+func (s *Client) SetHead(ctx context.Context, number math.U64) error {
+	return s.Call(ctx, nil, "debug_setHead", hexutil.EncodeUint64(number.Unwrap()))
+}
+
+// BlockReceipts retrieves the receipts of the transactions of the block with
+// the given hash.
+func (s *Client) BlockReceipts(
+	ctx context.Context,
+	blockHash common.ExecutionHash,
+) ([]*types.Receipt, error) {
+	var result []*types.Receipt
+	if err := s.Call(ctx, &result, "eth_getBlockReceipts", blockHash); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
@@ -59,15 +114,22 @@ func (s *Client) FilterLogs(
 	return result, s.Call(ctx, &result, "eth_getLogs", arg)
 }
 
-// SubscribeFilterLogs(ctx context.Context, q FilterQuery, ch chan<- types.Log)
-// (Subscription, error)
-
+// SubscribeFilterLogs subscribes to the results of a streaming filter query.
+// Subscriptions require an IPC or WebSocket connection.
 func (s *Client) SubscribeFilterLogs(
-	context.Context,
-	ethereum.FilterQuery,
-	chan<- types.Log,
+	ctx context.Context,
+	q ethereum.FilterQuery,
+	ch chan<- types.Log,
 ) (ethereum.Subscription, error) {
-	return nil, errors.New("not implemented")
+	sc, ok := s.Client.(ethclientrpc.SubscriptionClient)
+	if !ok {
+		return nil, ErrSubscriptionsNotSupported
+	}
+	arg, err := toFilterArg(q)
+	if err != nil {
+		return nil, err
+	}
+	return sc.Subscribe(ctx, "eth", ch, "logs", arg)
 }
 
 func toFilterArg(q ethereum.FilterQuery) (interface{}, error) {
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ethclient_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
+	"github.com/berachain/beacon-kit/execution/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChainID     = 80094
+	testBlockNumber = 42
+)
+
+var testBlockHash = gethcommon.Hash{0x42}
+
+// ethService is a stand-in for the eth namespace of an execution client.
+type ethService struct{}
+
+func (ethService) ChainId() hexutil.Uint64 { //nolint:revive // JSON-RPC method name.
+	return testChainID
+}
+
+// GetBlockByNumber knows a single block, testBlockNumber.
+func (ethService) GetBlockByNumber(number hexutil.Uint64, _ bool) map[string]any {
+	if number != testBlockNumber {
+		return nil
+	}
+	return map[string]any{"hash": testBlockHash}
+}
+
+func (ethService) Logs(ctx context.Context, _ map[string]any) (*gethrpc.Subscription, error) {
+	notifier, ok := gethrpc.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethrpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	go func() {
+		_ = notifier.Notify(sub.ID, &types.Log{
+			Address:     gethcommon.Address{0x01},
+			Topics:      []gethcommon.Hash{{0x02}},
+			Data:        []byte{},
+			BlockNumber: 7,
+		})
+	}()
+	return sub, nil
+}
+
+func newTestRPCServer(t *testing.T) *gethrpc.Server {
+	t.Helper()
+	srv := gethrpc.NewServer()
+	require.NoError(t, srv.RegisterName("eth", ethService{}))
+	t.Cleanup(srv.Stop)
+	return srv
+}
+
+func TestSocketTransports(t *testing.T) {
+	t.Parallel()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	srv := newTestRPCServer(t)
+
+	// IPC.
+	ipcPath := filepath.Join(t.TempDir(), "geth.ipc")
+	listener, err := net.Listen("unix", ipcPath)
+	require.NoError(t, err)
+	go func() { _ = srv.ServeListener(listener) }()
+
+	// WebSocket, rejecting connections without a JWT token.
+	wsSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		srv.WebsocketHandler([]string{"*"}).ServeHTTP(w, r)
+	}))
+	t.Cleanup(wsSrv.Close)
+
+	tests := []struct {
+		name   string
+		client rpc.Client
+	}{
+		{name: "ipc", client: rpc.NewIPCClient(ipcPath)},
+		{name: "ws", client: rpc.NewWebSocketClient("ws"+strings.TrimPrefix(wsSrv.URL, "http"), secret)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			c := ethclient.New(tc.client)
+			t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+			chainID, err := c.ChainID(ctx)
+			require.NoError(t, err)
+			require.Equal(t, uint64(testChainID), chainID.Unwrap())
+
+			// The connection is re-established after being closed.
+			require.NoError(t, c.Close())
+			chainID, err = c.ChainID(ctx)
+			require.NoError(t, err)
+			require.Equal(t, uint64(testChainID), chainID.Unwrap())
+
+			logs := make(chan types.Log, 1)
+			sub, err := c.SubscribeFilterLogs(ctx, ethereum.FilterQuery{}, logs)
+			require.NoError(t, err)
+			defer sub.Unsubscribe()
+			select {
+			case log := <-logs:
+				require.Equal(t, gethcommon.Address{0x01}, log.Address)
+				require.Equal(t, uint64(7), log.BlockNumber)
+			case err = <-sub.Err():
+				require.NoError(t, err)
+			case <-ctx.Done():
+				require.FailNow(t, "timed out waiting for log")
+			}
+		})
+	}
+}
+
+func TestSubscribeFilterLogsOverHTTP(t *testing.T) {
+	t.Parallel()
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	c := ethclient.New(rpc.NewClient("http://localhost:8551", secret, time.Minute))
+
+	_, err = c.SubscribeFilterLogs(
+		context.Background(), ethereum.FilterQuery{}, make(chan types.Log),
+	)
+	require.ErrorIs(t, err, ethclient.ErrSubscriptionsNotSupported)
+}
+
+func TestBlockHashByNumber(t *testing.T) {
+	t.Parallel()
+	srv := newTestRPCServer(t)
+	ipcPath := filepath.Join(t.TempDir(), "geth.ipc")
+	listener, err := net.Listen("unix", ipcPath)
+	require.NoError(t, err)
+	go func() { _ = srv.ServeListener(listener) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c := ethclient.New(rpc.NewIPCClient(ipcPath))
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	hash, err := c.BlockHashByNumber(ctx, testBlockNumber)
+	require.NoError(t, err)
+	require.Equal(t, testBlockHash.Bytes(), hash[:])
+
+	_, err = c.BlockHashByNumber(ctx, testBlockNumber+1)
+	require.ErrorIs(t, err, ethclient.ErrBlockNotFound)
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/berachain/beacon-kit/primitives/net/jwt"
+	"github.com/ethereum/go-ethereum"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+var _ SubscriptionClient = (*socketClient)(nil)
+
+// SubscriptionClient is a Client whose transport supports subscriptions.
+type SubscriptionClient interface {
+	Client
+	// Subscribe subscribes to notifications of the given namespace, which are
+	// delivered on channel until the subscription is cancelled.
+	Subscribe(
+		ctx context.Context, namespace string, channel any, args ...any,
+	) (ethereum.Subscription, error)
+}
+
+// socketClient is an Ethereum RPC client over a persistent connection, either
+// an IPC socket or a WebSocket. The connection is established on first use and
+// re-established transparently when lost.
+type socketClient struct {
+	// url is the dial url, a socket path for IPC.
+	url string
+	// opts are the options used when dialing.
+	opts []gethrpc.ClientOption
+
+	// mu protects conn for concurrent access.
+	mu sync.Mutex
+	// conn is the underlying connection, nil until first dialed.
+	conn *gethrpc.Client
+}
+
+// NewIPCClient creates a new rpc client connecting to the IPC socket at path.
+// IPC connections are local, so no JWT authentication is performed.
+func NewIPCClient(path string) SubscriptionClient {
+	return &socketClient{url: path}
+}
+
+// NewWebSocketClient creates a new rpc client connecting to the given ws:// or
+// wss:// url. A fresh JWT token is presented on every (re)connection.
+func NewWebSocketClient(url string, secret *jwt.Secret) SubscriptionClient {
+	return &socketClient{
+		url: url,
+		opts: []gethrpc.ClientOption{
+			gethrpc.WithHTTPAuth(func(h http.Header) error {
+				token, err := secret.BuildSignedToken()
+				if err != nil {
+					return err
+				}
+				h.Set("Authorization", "Bearer "+token)
+				return nil
+			}),
+		},
+	}
+}
+
+// Start closes the connection once ctx is done.
+func (rpc *socketClient) Start(ctx context.Context) {
+	<-ctx.Done()
+	_ = rpc.Close()
+}
+
+// Close closes the RPC client. The connection is re-established on next use.
+func (rpc *socketClient) Close() error {
+	rpc.mu.Lock()
+	defer rpc.mu.Unlock()
+	if rpc.conn != nil {
+		rpc.conn.Close()
+		rpc.conn = nil
+	}
+	return nil
+}
+
+// Call calls the given method with the given parameters.
+func (rpc *socketClient) Call(
+	ctx context.Context,
+	target any,
+	method string,
+	params ...any,
+) error {
+	conn, err := rpc.dial(ctx)
+	if err != nil {
+		return err
+	}
+	return conn.CallContext(ctx, target, method, params...)
+}
+
+// Subscribe subscribes to notifications of the given namespace.
+func (rpc *socketClient) Subscribe(
+	ctx context.Context,
+	namespace string,
+	channel any,
+	args ...any,
+) (ethereum.Subscription, error) {
+	conn, err := rpc.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Subscribe(ctx, namespace, channel, args...)
+}
+
+// dial returns the connection, establishing it if needed.
+func (rpc *socketClient) dial(ctx context.Context) (*gethrpc.Client, error) {
+	rpc.mu.Lock()
+	defer rpc.mu.Unlock()
+	if rpc.conn != nil {
+		return rpc.conn, nil
+	}
+	conn, err := gethrpc.DialOptions(ctx, rpc.url, rpc.opts...)
+	if err != nil {
+		return nil, err
+	}
+	rpc.conn = conn
+	return conn, nil
+}
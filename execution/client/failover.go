@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	engineerrors "github.com/berachain/beacon-kit/engine-primitives/errors"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/net/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxPayloadEndpoints is the number of recent payload builds whose endpoint
+// is remembered.
+const maxPayloadEndpoints = 32
+
+// withFailover calls the active endpoint, failing over to the other healthy
+// endpoints in order of priority on connection errors and timeouts. Each
+// attempt gets its own timeout. It returns the endpoint that served the call.
+func (s *EngineClient) withFailover(
+	ctx context.Context,
+	onTimeout func(),
+	call func(context.Context, *endpoint) error,
+) (*endpoint, error) {
+	var err error
+	for _, ep := range s.failoverOrder() {
+		cctx, cancel := s.createContextWithTimeout(ctx)
+		err = call(cctx, ep)
+		cancel()
+		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
+			onTimeout()
+		}
+		err = s.handleRPCError(err)
+		if !isFailoverError(err) {
+			s.setActiveEndpoint(ep)
+			trace.SpanFromContext(ctx).SetAttributes(
+				attribute.String("dial_url", ep.url.String()),
+			)
+			return ep, err
+		}
+		if ctx.Err() != nil {
+			// The caller gave up, there is no point in trying further.
+			break
+		}
+
+		s.logger.Warn(
+			"Execution client endpoint failed, failing over",
+			"dial_url", ep.url.String(),
+			"err", err,
+		)
+		trace.SpanFromContext(ctx).AddEvent("failover", trace.WithAttributes(
+			attribute.String("dial_url", ep.url.String()),
+			attribute.String("err", err.Error()),
+		))
+		ep.setHealthy(false)
+	}
+	return nil, err
+}
+
+// failoverOrder returns the active endpoint followed by the other healthy
+// endpoints in order of priority.
+func (s *EngineClient) failoverOrder() []*endpoint {
+	active := s.activeEndpoint()
+	order := []*endpoint{active}
+	for _, ep := range s.endpoints {
+		if ep != active && ep.isHealthy() {
+			order = append(order, ep)
+		}
+	}
+	return order
+}
+
+// isFailoverError returns true if the error indicates that the endpoint
+// could not be reached or did not answer in time.
+func isFailoverError(err error) bool {
+	return errors.IsAny(
+		err,
+		ErrBadConnection,
+		engineerrors.ErrEngineAPITimeout,
+		http.ErrTimeout,
+	)
+}
+
+// shadowNewPayload sends the payload to all other healthy endpoints in the
+// background and reports any disagreement with the status returned by the
+// serving endpoint.
+func (s *EngineClient) shadowNewPayload(
+	served *endpoint,
+	req ctypes.NewPayloadRequest,
+	expected *engineprimitives.PayloadStatusV1,
+) {
+	for _, ep := range s.endpoints {
+		if ep == served || !ep.isHealthy() {
+			continue
+		}
+		go func() {
+			// The request context ends with the caller, so shadow calls
+			// are bounded by their own timeout.
+			ctx, cancel := s.createContextWithTimeout(context.Background())
+			defer cancel()
+
+			result, err := ep.client.NewPayload(ctx, req)
+			if err == nil && result == nil {
+				err = engineerrors.ErrNilPayloadStatus
+			}
+			if err != nil {
+				s.metrics.incrementShadowNewPayloadErrorCounter()
+				s.logger.Warn(
+					"Shadow newPayload failed",
+					"dial_url", ep.url.String(),
+					"err", err,
+				)
+				return
+			}
+			if !samePayloadStatus(expected, result) {
+				s.metrics.incrementShadowNewPayloadMismatchCounter()
+				s.logger.Error(
+					"Shadow newPayload status mismatch",
+					"dial_url", ep.url.String(),
+					"block_hash", req.GetExecutionPayload().GetBlockHash(),
+					"expected_status", expected.Status,
+					"expected_latest_valid_hash", expected.LatestValidHash,
+					"status", result.Status,
+					"latest_valid_hash", result.LatestValidHash,
+				)
+			}
+		}()
+	}
+}
+
+// shadowForkchoiceUpdated sends the forkchoice state, without payload
+// attributes, to all other healthy endpoints in the background, so that their
+// head follows the serving endpoint.
+func (s *EngineClient) shadowForkchoiceUpdated(
+	served *endpoint,
+	state *engineprimitives.ForkchoiceStateV1,
+	forkVersion common.Version,
+) {
+	for _, ep := range s.endpoints {
+		if ep == served || !ep.isHealthy() {
+			continue
+		}
+		go func() {
+			ctx, cancel := s.createContextWithTimeout(context.Background())
+			defer cancel()
+
+			if _, err := ep.client.ForkchoiceUpdated(ctx, state, nil, forkVersion); err != nil {
+				s.logger.Warn(
+					"Shadow forkchoiceUpdated failed",
+					"dial_url", ep.url.String(),
+					"err", err,
+				)
+			}
+		}()
+	}
+}
+
+// recordPayloadEndpoint records that ep started the payload build with the
+// given ID, forgetting the oldest builds beyond maxPayloadEndpoints.
+func (s *EngineClient) recordPayloadEndpoint(
+	payloadID engineprimitives.PayloadID, ep *endpoint,
+) {
+	s.payloadMu.Lock()
+	defer s.payloadMu.Unlock()
+	if _, found := s.payloadEndpoints[payloadID]; !found {
+		s.payloadIDs = append(s.payloadIDs, payloadID)
+	}
+	s.payloadEndpoints[payloadID] = ep
+	if len(s.payloadIDs) > maxPayloadEndpoints {
+		delete(s.payloadEndpoints, s.payloadIDs[0])
+		s.payloadIDs = s.payloadIDs[1:]
+	}
+}
+
+// payloadEndpoint returns the endpoint that started the payload build with
+// the given ID, or the active endpoint if the build is unknown.
+func (s *EngineClient) payloadEndpoint(payloadID engineprimitives.PayloadID) *endpoint {
+	s.payloadMu.Lock()
+	ep, found := s.payloadEndpoints[payloadID]
+	s.payloadMu.Unlock()
+	if !found {
+		return s.activeEndpoint()
+	}
+	return ep
+}
+
+// samePayloadStatus returns true if both payload statuses agree on the
+// status and the latest valid hash.
+func samePayloadStatus(a, b *engineprimitives.PayloadStatusV1) bool {
+	if a.Status != b.Status {
+		return false
+	}
+	if a.LatestValidHash == nil || b.LatestValidHash == nil {
+		return a.LatestValidHash == b.LatestValidHash
+	}
+	return *a.LatestValidHash == *b.LatestValidHash
+}
@@ -189,3 +189,21 @@ func (cm *clientMetrics) incrementInternalServerErrorCounter() {
 func (cm *clientMetrics) incrementErrorCounter(metricName string) {
 	cm.sink.IncrementCounter(metricName)
 }
+
+// incrementEndpointSwitchCounter increments the counter of switches of the
+// active execution client endpoint.
+func (cm *clientMetrics) incrementEndpointSwitchCounter() {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.endpoint_switch")
+}
+
+// incrementShadowNewPayloadErrorCounter increments the counter of failed
+// shadow new payload calls.
+func (cm *clientMetrics) incrementShadowNewPayloadErrorCounter() {
+	cm.incrementErrorCounter("beacon_kit.execution.client.shadow_new_payload_error")
+}
+
+// incrementShadowNewPayloadMismatchCounter increments the counter of shadow
+// new payload calls whose status disagrees with the active endpoint.
+func (cm *clientMetrics) incrementShadowNewPayloadMismatchCounter() {
+	cm.incrementErrorCounter("beacon_kit.execution.client.shadow_new_payload_mismatch")
+}
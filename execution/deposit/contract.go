@@ -26,24 +26,28 @@ import (
 	"fmt"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/execution/client/ethclient"
 	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
 	"github.com/berachain/beacon-kit/geth-primitives/bind"
 	"github.com/berachain/beacon-kit/geth-primitives/deposit"
 	"github.com/berachain/beacon-kit/primitives/bytes"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/ethereum/go-ethereum/event"
 )
 
 // WrappedDepositContract is a struct that holds a pointer to an ABI.
 type WrappedDepositContract struct {
 	// DepositContractFilterer is a pointer to the codegen ABI binding.
 	deposit.DepositContractFilterer
+	// client is used to look up canonical block hashes.
+	client Client
 }
 
 // NewWrappedDepositContract creates a new DepositContract.
 func NewWrappedDepositContract(
 	address common.ExecutionAddress,
-	client bind.ContractFilterer,
+	client Client,
 ) (*WrappedDepositContract, error) {
 	contract, err := deposit.NewDepositContractFilterer(
 		gethprimitives.ExecutionAddress(address), client,
@@ -57,15 +61,17 @@ func NewWrappedDepositContract(
 
 	return &WrappedDepositContract{
 		DepositContractFilterer: *contract,
+		client:                  client,
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads deposits from the deposit contract, grouped by the EL
+// block that emitted them.
 func (dc *WrappedDepositContract) ReadDeposits(
 	ctx context.Context,
 	fromBlock math.U64,
 	toBlock math.U64,
-) ([]*ctypes.Deposit, error) {
+) ([]*Batch, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
@@ -77,34 +83,102 @@ func (dc *WrappedDepositContract) ReadDeposits(
 		return nil, err
 	}
 
-	deposits := make([]*ctypes.Deposit, 0)
+	batches := make([]*Batch, 0)
 	for logs.Next() {
-		var (
-			cred   bytes.B32
-			pubKey bytes.B48
-			sign   bytes.B96
-		)
-		pubKey, err = bytes.ToBytes48(logs.Event.Pubkey)
+		var deposit *ctypes.Deposit
+		deposit, err = newDeposit(logs.Event)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading pub key: %w", err)
+			return nil, err
 		}
-		cred, err = bytes.ToBytes32(logs.Event.Credentials)
-		if err != nil {
-			return nil, fmt.Errorf("failed reading credentials: %w", err)
-		}
-		sign, err = bytes.ToBytes96(logs.Event.Signature)
-		if err != nil {
-			return nil, fmt.Errorf("failed reading signature: %w", err)
+		blockHash := common.ExecutionHash(logs.Event.Raw.BlockHash)
+		if len(batches) == 0 || batches[len(batches)-1].BlockHash != blockHash {
+			batches = append(batches, &Batch{
+				BlockNumber: math.U64(logs.Event.Raw.BlockNumber),
+				BlockHash:   blockHash,
+			})
 		}
-		deposit := &ctypes.Deposit{
-			Pubkey:      pubKey,
-			Credentials: ctypes.WithdrawalCredentials(cred),
-			Amount:      math.U64(logs.Event.Amount),
-			Signature:   sign,
-			Index:       logs.Event.Index,
+		last := batches[len(batches)-1]
+		last.Deposits = append(last.Deposits, deposit)
+	}
+
+	return batches, nil
+}
+
+// WatchDeposits streams the deposits of the EL blocks becoming or leaving
+// canonical to sink. Streaming requires an IPC or WebSocket connection to the
+// EL.
+func (dc *WrappedDepositContract) WatchDeposits(
+	ctx context.Context,
+	sink chan<- *Log,
+) (event.Subscription, error) {
+	events := make(chan *deposit.DepositContractDeposit)
+	sub, err := dc.WatchDeposit(&bind.WatchOpts{Context: ctx}, events)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-events:
+				d, err := newDeposit(ev)
+				if err != nil {
+					return err
+				}
+				log := &Log{
+					BlockNumber: math.U64(ev.Raw.BlockNumber),
+					BlockHash:   common.ExecutionHash(ev.Raw.BlockHash),
+					Deposit:     d,
+					Removed:     ev.Raw.Removed,
+				}
+				select {
+				case sink <- log:
+				case err = <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err = <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
 		}
-		deposits = append(deposits, deposit)
+	}), nil
+}
+
+// CanonicalBlockHash returns the hash of the canonical EL block at the given
+// number, or the zero hash if the EL has no block at that number.
+func (dc *WrappedDepositContract) CanonicalBlockHash(
+	ctx context.Context,
+	number math.U64,
+) (common.ExecutionHash, error) {
+	hash, err := dc.client.BlockHashByNumber(ctx, number)
+	if errors.Is(err, ethclient.ErrBlockNotFound) {
+		return common.ExecutionHash{}, nil
 	}
+	return hash, err
+}
 
-	return deposits, nil
+// newDeposit decodes the deposit of a Deposit event.
+func newDeposit(ev *deposit.DepositContractDeposit) (*ctypes.Deposit, error) {
+	pubKey, err := bytes.ToBytes48(ev.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading pub key: %w", err)
+	}
+	cred, err := bytes.ToBytes32(ev.Credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading credentials: %w", err)
+	}
+	sign, err := bytes.ToBytes96(ev.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading signature: %w", err)
+	}
+	return &ctypes.Deposit{
+		Pubkey:      pubKey,
+		Credentials: ctypes.WithdrawalCredentials(cred),
+		Amount:      math.U64(ev.Amount),
+		Signature:   sign,
+		Index:       ev.Index,
+	}, nil
 }
@@ -24,15 +24,64 @@ import (
 	"context"
 
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/geth-primitives/bind"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/ethereum/go-ethereum/event"
 )
 
+// Batch is the deposits emitted by a single EL block.
+type Batch struct {
+	// BlockNumber is the number of the EL block.
+	BlockNumber math.U64
+	// BlockHash is the hash of the EL block.
+	BlockHash common.ExecutionHash
+	// Deposits are the deposits emitted by the block, in index order.
+	Deposits []*ctypes.Deposit
+}
+
+// Log is a deposit streamed from the deposit contract.
+type Log struct {
+	// BlockNumber is the number of the EL block that emitted the deposit.
+	BlockNumber math.U64
+	// BlockHash is the hash of the EL block that emitted the deposit.
+	BlockHash common.ExecutionHash
+	// Deposit is the deposit.
+	Deposit *ctypes.Deposit
+	// Removed is set when the block left the canonical chain.
+	Removed bool
+}
+
 // Contract is the ABI for the deposit contract.
 type Contract interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads deposits from the deposit contract, grouped by the
+	// EL block that emitted them.
 	ReadDeposits(
 		ctx context.Context,
 		fromBlock math.U64,
 		toBlock math.U64,
-	) ([]*ctypes.Deposit, error)
+	) ([]*Batch, error)
+	// WatchDeposits streams the deposits of the EL blocks becoming or
+	// leaving canonical to sink.
+	WatchDeposits(
+		ctx context.Context,
+		sink chan<- *Log,
+	) (event.Subscription, error)
+	// CanonicalBlockHash returns the hash of the canonical EL block at the
+	// given number, or the zero hash if there is no such block.
+	CanonicalBlockHash(
+		ctx context.Context,
+		number math.U64,
+	) (common.ExecutionHash, error)
+}
+
+// Client is the execution client used to read the deposit contract.
+type Client interface {
+	bind.ContractFilterer
+	// BlockHashByNumber retrieves the hash of the canonical block at the
+	// given number.
+	BlockHashByNumber(
+		ctx context.Context,
+		number math.U64,
+	) (common.ExecutionHash, error)
 }
@@ -27,4 +27,5 @@ type (
 	ContractFilterer = bind.ContractFilterer
 	FilterOpts       = bind.FilterOpts
 	TransactOpts     = bind.TransactOpts
+	WatchOpts        = bind.WatchOpts
 )
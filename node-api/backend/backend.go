@@ -21,14 +21,20 @@
 package backend
 
 import (
+	"context"
 	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
@@ -37,9 +43,13 @@ import (
 // It serves as a wrapper around the storage backend and provides an abstraction
 // over building the query context for a given state.
 type Backend struct {
-	sb   *storage.Backend
-	cs   chain.Spec
-	node types.ConsensusService
+	sb     *storage.Backend
+	cs     chain.Spec
+	ec     ExecutionClient
+	pp     ProposerPreparer
+	sp     StateProcessor
+	relays Relays
+	node   types.ConsensusService
 
 	// genesisValidatorsRoot is cached in the backend.
 	genesisValidatorsRoot atomic.Pointer[common.Root]
@@ -51,15 +61,55 @@ type Backend struct {
 	genesisForkVersion atomic.Pointer[common.Version]
 }
 
+// ExecutionClient is the client to the execution layer.
+type ExecutionClient interface {
+	// IsConnected returns true if the execution client is reachable.
+	IsConnected() bool
+	// BlockReceipts retrieves the receipts of the transactions of the block
+	// with the given hash.
+	BlockReceipts(ctx context.Context, blockHash common.ExecutionHash) ([]*gethprimitives.Receipt, error)
+}
+
+// ProposerPreparer records the fee recipients of the validators proposing
+// blocks through this node.
+type ProposerPreparer interface {
+	// PrepareProposer sets the fee recipient of the validator with the
+	// given pubkey.
+	PrepareProposer(pubkey crypto.BLSPubkey, feeRecipient common.ExecutionAddress)
+}
+
+// StateProcessor processes the slots of states.
+type StateProcessor interface {
+	// ProcessSlots processes the state up to the given slot.
+	ProcessSlots(st *statedb.StateDB, slot math.Slot) (transition.ValidatorUpdates, error)
+}
+
+// Relays registers validators with the relays of external block builders.
+type Relays interface {
+	// Enabled returns whether any relay is configured.
+	Enabled() bool
+	// RegisterValidators registers validators with all relays, once their
+	// registrations are checked to be signed by them.
+	RegisterValidators(ctx context.Context, regs []*ctypes.SignedValidatorRegistration) error
+}
+
 // New creates and returns a new Backend instance.
 func New(
 	storageBackend *storage.Backend,
 	cs chain.Spec,
 	cmtCfg *cmtcfg.Config,
+	ec ExecutionClient,
+	pp ProposerPreparer,
+	sp StateProcessor,
+	relays Relays,
 ) (*Backend, error) {
 	b := &Backend{
-		sb: storageBackend,
-		cs: cs,
+		sb:     storageBackend,
+		cs:     cs,
+		ec:     ec,
+		pp:     pp,
+		sp:     sp,
+		relays: relays,
 	}
 
 	// Load the genesis file from cometbft config.
@@ -28,8 +28,10 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/chain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/berachain/beacon-kit/storage/beacondb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -43,10 +45,21 @@ type testConsensusService struct {
 	cms     storetypes.CommitMultiStore
 	kvStore *beacondb.KVStore
 	cs      chain.Spec
+
+	// heightStores, if set, hold the state of the given heights in place of cms.
+	heightStores map[int64]storetypes.CommitMultiStore
+
+	// nextHeight and upcomingProposers are returned by UpcomingProposers.
+	nextHeight        int64
+	upcomingProposers []crypto.BLSPubkey
 }
 
 func (t *testConsensusService) CreateQueryContext(height int64, _ bool) (sdk.Context, error) {
-	sdkCtx := sdk.NewContext(t.cms.CacheMultiStore(), false, log.NewNopLogger())
+	cms := t.cms
+	if heightStore, ok := t.heightStores[height]; ok {
+		cms = heightStore
+	}
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), false, log.NewNopLogger())
 
 	// there validations mimics consensus service, not sure if they are necessary
 	tmpState := statedb.NewBeaconStateFromDB(
@@ -79,3 +92,32 @@ func (t *testConsensusService) Name() string {
 func (t *testConsensusService) LastBlockHeight() int64 {
 	panic(errTestMemberNotImplemented)
 }
+
+func (t *testConsensusService) IsSyncing() bool {
+	panic(errTestMemberNotImplemented)
+}
+
+func (t *testConsensusService) SyncingToHeight() int64 {
+	panic(errTestMemberNotImplemented)
+}
+
+func (t *testConsensusService) UpcomingProposers(count int) (int64, []crypto.BLSPubkey, error) {
+	if t.upcomingProposers == nil {
+		return 0, nil, errTestMemberNotImplemented
+	}
+	return t.nextHeight, t.upcomingProposers[:min(count, len(t.upcomingProposers))], nil
+}
+
+// The light client members return data tagged with the requested height.
+
+func (t *testConsensusService) LightClientCommit(height int64) (*ctypes.LightClientCommit, error) {
+	return &ctypes.LightClientCommit{Height: uint64(height)}, nil // #nosec G115
+}
+
+func (t *testConsensusService) LightClientValidators(height int64) ([]*ctypes.LightClientValidator, error) {
+	return []*ctypes.LightClientValidator{{VotingPower: uint64(height)}}, nil // #nosec G115
+}
+
+func (t *testConsensusService) LightClientCometBFTHeader(height int64) (*ctypes.LightClientCometBFTHeader, error) {
+	return &ctypes.LightClientCometBFTHeader{TxTotal: uint64(height)}, nil // #nosec G115
+}
@@ -24,6 +24,8 @@ import (
 	"errors"
 	"fmt"
 
+	dastore "github.com/berachain/beacon-kit/da/store"
+	datypes "github.com/berachain/beacon-kit/da/types"
 	apitypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -42,14 +44,6 @@ func (b *Backend) BlobSidecarsByIndices(slot math.Slot, indices []uint64) ([]*ap
 		slot = math.Slot(currentSlot)
 	}
 
-	// Validate the requested slot is within the Data Availability Period.
-	if !b.cs.WithinDAPeriod(slot, math.Slot(currentSlot)) {
-		return nil, fmt.Errorf(
-			"requested slot (%d) is not within Data Availability Period (previous %d epochs)",
-			slot, b.cs.MinEpochsForBlobsSidecarsRequest(),
-		)
-	}
-
 	// Validate request indices.
 	if uint64(len(indices)) >= b.cs.MaxBlobsPerBlock() {
 		return nil, errors.New("too many indices requested")
@@ -60,7 +54,23 @@ func (b *Backend) BlobSidecarsByIndices(slot math.Slot, indices []uint64) ([]*ap
 		}
 	}
 
-	blobSidecars, err := b.sb.AvailabilityStore().GetBlobSidecars(slot)
+	// Sidecars of slots past the Data Availability Period have been pruned,
+	// unless they are archived.
+	var (
+		blobSidecars datypes.BlobSidecars
+		err          error
+	)
+	if b.cs.WithinDAPeriod(slot, math.Slot(currentSlot)) {
+		blobSidecars, err = b.sb.AvailabilityStore().GetBlobSidecars(slot)
+	} else {
+		blobSidecars, err = b.sb.AvailabilityStore().GetArchivedBlobSidecars(slot)
+		if errors.Is(err, dastore.ErrNotArchived) {
+			return nil, fmt.Errorf(
+				"requested slot (%d) is not within Data Availability Period (previous %d epochs)",
+				slot, b.cs.MinEpochsForBlobsSidecarsRequest(),
+			)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
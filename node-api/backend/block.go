@@ -23,7 +23,6 @@ package backend
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
-	types "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
@@ -45,6 +44,18 @@ func (b *Backend) BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader,
 	return blockHeader, nil
 }
 
+// SignedBlockAtSlot returns the signed block finalized at the given slot,
+// resolving an input slot of 0 to the latest slot.
+func (b *Backend) SignedBlockAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlock, error) {
+	if slot == 0 {
+		var err error
+		if _, slot, err = b.StateAtSlot(slot); err != nil {
+			return nil, errors.Wrapf(err, "failed to get latest slot")
+		}
+	}
+	return b.sb.BlockStore().GetBlockBySlot(slot)
+}
+
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b *Backend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
 	st, _, err := b.StateAtSlot(slot)
@@ -62,15 +73,3 @@ func (b *Backend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
 	blockHeader.SetStateRoot(st.HashTreeRoot())
 	return blockHeader.HashTreeRoot(), nil
 }
-
-// TODO: Implement this.
-func (b *Backend) BlockRewardsAtSlot(_ math.Slot) (*types.BlockRewardsData, error) {
-	return &types.BlockRewardsData{
-		ProposerIndex:     1,
-		Total:             1,
-		Attestations:      1,
-		SyncAggregate:     1,
-		ProposerSlashings: 1,
-		AttesterSlashings: 1,
-	}, nil
-}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	payloadtime "github.com/berachain/beacon-kit/beacon/payload-time"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ErrProposalSlotOutOfRange is returned when expected withdrawals are
+// requested for a slot which does not follow the state within an epoch.
+var ErrProposalSlotOutOfRange = errors.New("proposal slot out of range")
+
+// ExpectedWithdrawals returns the withdrawals expected in the payload of the
+// block proposed at proposalSlot on top of the state at the given slot, or at
+// the slot following the state if proposalSlot is zero.
+//
+// The payload timestamp is only known once the block is proposed, so the
+// earliest timestamp the payload could have from now on is assumed.
+func (b *Backend) ExpectedWithdrawals(
+	slot, proposalSlot math.Slot,
+) (engineprimitives.Withdrawals, error) {
+	st, stateSlot, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	if proposalSlot == 0 {
+		proposalSlot = stateSlot + 1
+	}
+	if proposalSlot <= stateSlot || proposalSlot > stateSlot+math.Slot(b.cs.SlotsPerEpoch()) {
+		return nil, fmt.Errorf(
+			"%w: proposal slot %d, state slot %d", ErrProposalSlotOutOfRange, proposalSlot, stateSlot,
+		)
+	}
+	if _, err = b.sp.ProcessSlots(st, proposalSlot); err != nil {
+		return nil, errors.Wrapf(err, "failed to process slots up to %d", proposalSlot)
+	}
+
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get latest execution payload header")
+	}
+	//#nosec: G115 // Unix time will never be negative.
+	timestamp := payloadtime.Next(math.U64(time.Now().Unix()), lph.GetTimestamp(), false)
+	withdrawals, _, err := st.ExpectedWithdrawals(timestamp)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get expected withdrawals")
+	}
+	return withdrawals, nil
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	nooplog "github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/backend/mocks"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/block"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const builderTestHeadSlot = math.Slot(10)
+
+func TestExpectedWithdrawals(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	sp := mocks.NewStateProcessor(t)
+	b := newBuilderTestBackend(t, cs, sp, nil, nil)
+
+	t.Run("next slot", func(t *testing.T) {
+		withdrawals, errW := b.ExpectedWithdrawals(0, 0)
+		require.NoError(t, errW)
+		// The first withdrawal is always the EVM inflation withdrawal.
+		require.NotEmpty(t, withdrawals)
+		require.Equal(t,
+			//#nosec: G115 // Unix time will never be negative.
+			cs.EVMInflationAddress(math.U64(time.Now().Unix())),
+			withdrawals[0].GetAddress(),
+		)
+	})
+
+	t.Run("proposal slot within an epoch", func(t *testing.T) {
+		_, errW := b.ExpectedWithdrawals(0, builderTestHeadSlot+math.Slot(cs.SlotsPerEpoch()))
+		require.NoError(t, errW)
+	})
+
+	t.Run("proposal slot out of range", func(t *testing.T) {
+		for _, proposalSlot := range []math.Slot{
+			builderTestHeadSlot,
+			builderTestHeadSlot + math.Slot(cs.SlotsPerEpoch()) + 1,
+		} {
+			_, errW := b.ExpectedWithdrawals(0, proposalSlot)
+			require.ErrorIs(t, errW, backend.ErrProposalSlotOutOfRange)
+		}
+	})
+
+	sp.AssertExpectations(t)
+}
+
+func TestRegisterValidators(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+
+	known := &ctypes.SignedValidatorRegistration{
+		Message: &ctypes.ValidatorRegistration{
+			FeeRecipient: common.ExecutionAddress{0xaa},
+			Pubkey:       crypto.BLSPubkey{0x01},
+		},
+	}
+	unknown := &ctypes.SignedValidatorRegistration{
+		Message: &ctypes.ValidatorRegistration{
+			FeeRecipient: common.ExecutionAddress{0xbb},
+			Pubkey:       crypto.BLSPubkey{0xff},
+		},
+	}
+
+	t.Run("known validators", func(t *testing.T) {
+		t.Parallel()
+		relays := &testRelays{enabled: true}
+		pp := testProposerPreparer{}
+		b := newBuilderTestBackend(t, cs, nil, relays, pp)
+		require.NoError(t, b.RegisterValidators(
+			context.Background(), []*ctypes.SignedValidatorRegistration{known, unknown},
+		))
+		require.Equal(t, []*ctypes.SignedValidatorRegistration{known}, relays.registered)
+		require.Equal(t, map[crypto.BLSPubkey]common.ExecutionAddress{
+			known.Message.Pubkey: known.Message.FeeRecipient,
+		}, map[crypto.BLSPubkey]common.ExecutionAddress(pp))
+	})
+
+	t.Run("no relays", func(t *testing.T) {
+		t.Parallel()
+		b := newBuilderTestBackend(t, cs, nil, &testRelays{}, testProposerPreparer{})
+		errR := b.RegisterValidators(
+			context.Background(), []*ctypes.SignedValidatorRegistration{known},
+		)
+		require.ErrorIs(t, errR, backend.ErrNoRelays)
+	})
+}
+
+// newBuilderTestBackend returns a backend whose head state, at
+// builderTestHeadSlot, has a single validator with pubkey 0x01.
+func newBuilderTestBackend(
+	t *testing.T,
+	cs chain.Spec,
+	sp *mocks.StateProcessor,
+	relays backend.Relays,
+	pp backend.ProposerPreparer,
+) *backend.Backend {
+	t.Helper()
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	blockStore := block.NewStore(dbm.NewMemDB(), nooplog.NewLogger[any](), 1000, false)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, blockStore, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	tmpDir := t.TempDir()
+	cmtCfg := cmtcfg.DefaultConfig()
+	cmtCfg.SetRoot(tmpDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config"), 0o755))
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test-chain", []byte("{}"))
+	require.NoError(t, appGenesis.SaveAs(cmtCfg.GenesisFile()))
+
+	var stateProcessor backend.StateProcessor
+	if sp != nil {
+		// The state is left at the slot of the head state.
+		sp.EXPECT().ProcessSlots(mock.Anything, mock.Anything).Return(nil, nil)
+		stateProcessor = sp
+	}
+	b, err := backend.New(sb, cs, cmtCfg, nil, pp, stateProcessor, relays)
+	require.NoError(t, err)
+	b.AttachQueryBackend(&testConsensusService{cms: cms, kvStore: kvStore, cs: cs})
+
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+	val, err := types.ValidatorToConsensus(&types.Validator{
+		PublicKey:                  crypto.BLSPubkey{0x01}.String(),
+		WithdrawalCredentials:      common.Bytes32{}.String(),
+		EffectiveBalance:           cs.MaxEffectiveBalance().Base10(),
+		ActivationEligibilityEpoch: "0",
+		ActivationEpoch:            "0",
+		ExitEpoch:                  constants.FarFutureEpoch.Base10(),
+		WithdrawableEpoch:          constants.FarFutureEpoch.Base10(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, st.AddValidator(val))
+	require.NoError(t, st.SetBalance(0, cs.MaxEffectiveBalance()))
+	require.NoError(t, st.SetPendingPartialWithdrawals(nil))
+	setupStateDummyParts(t, cs, st, builderTestHeadSlot)
+	//nolint:errcheck // false positive as this has no return value
+	sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+	return b
+}
+
+// testRelays records the registrations submitted to relays.
+type testRelays struct {
+	enabled    bool
+	registered []*ctypes.SignedValidatorRegistration
+}
+
+func (r *testRelays) Enabled() bool { return r.enabled }
+
+func (r *testRelays) RegisterValidators(
+	_ context.Context, regs []*ctypes.SignedValidatorRegistration,
+) error {
+	r.registered = append(r.registered, regs...)
+	return nil
+}
+
+// testProposerPreparer records the fee recipients of validators.
+type testProposerPreparer map[crypto.BLSPubkey]common.ExecutionAddress
+
+func (p testProposerPreparer) PrepareProposer(
+	pubkey crypto.BLSPubkey, feeRecipient common.ExecutionAddress,
+) {
+	p[pubkey] = feeRecipient
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	configtypes "github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// ForkSchedule returns the forks of the chain spec, starting from the genesis
+// fork version.
+//
+// Forks are activated by timestamp rather than by epoch. The fork recorded in
+// the head state reports its recorded epoch. Other active forks, such as Deneb1
+// which does not update the state fork, report the epoch of their activation
+// block, the first block at or after their activation time, as found in the
+// block store. Forks whose activation block has been pruned from the block
+// store and forks not active yet report an estimated epoch, which is labelled
+// as such.
+func (b *Backend) ForkSchedule() ([]*configtypes.ScheduledFork, error) {
+	st, _, err := b.StateAtSlot(utils.Head)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get head state")
+	}
+	stateFork, err := st.GetFork()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get head state fork")
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get latest execution payload header")
+	}
+	headTime := header.GetTimestamp().Unwrap()
+	headSlot, err := st.GetSlot()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get head slot")
+	}
+
+	genesisVersion, err := b.GenesisForkVersion()
+	if err != nil {
+		return nil, err
+	}
+	genesisTime := b.genesisTime.Load().Unwrap()
+
+	forks := b.cs.ForkSchedule()
+	schedule := make([]*configtypes.ScheduledFork, 0, len(forks))
+	for _, fork := range forks {
+		if version.IsBefore(fork.Version, genesisVersion) {
+			continue
+		}
+		if len(schedule) == 0 {
+			schedule = append(schedule, &configtypes.ScheduledFork{
+				Fork: ctypes.NewFork(genesisVersion, genesisVersion, constants.GenesisEpoch),
+			})
+			continue
+		}
+
+		prev := schedule[len(schedule)-1]
+		scheduled := &configtypes.ScheduledFork{
+			Fork: ctypes.NewFork(prev.CurrentVersion, fork.Version, constants.GenesisEpoch),
+		}
+		switch {
+		case headTime < fork.Time:
+			scheduled.Epoch = b.estimateEpoch(fork.Time, headTime, headSlot, prev.Epoch)
+			scheduled.Estimated = true
+		case version.Equals(fork.Version, stateFork.CurrentVersion):
+			scheduled.Epoch = stateFork.Epoch
+		default:
+			slot, errSlot := b.sb.BlockStore().GetFirstSlotFromTimestamp(math.U64(fork.Time))
+			if errSlot != nil {
+				// The fork is known to be active, so it activated by the head slot.
+				scheduled.Epoch = min(
+					b.estimateEpoch(fork.Time, genesisTime, constants.GenesisSlot, prev.Epoch),
+					max(b.cs.SlotToEpoch(headSlot), prev.Epoch),
+				)
+				scheduled.Estimated = true
+				break
+			}
+			scheduled.Epoch = b.cs.SlotToEpoch(slot)
+		}
+		schedule = append(schedule, scheduled)
+	}
+	return schedule, nil
+}
+
+// estimateEpoch estimates the epoch of the first block at or after forkTime,
+// assuming blocks are produced every TargetSecondsPerEth1Block seconds after
+// the block at refSlot, with timestamp refTime. The estimate is bounded by the
+// epoch of the preceding fork.
+func (b *Backend) estimateEpoch(
+	forkTime, refTime uint64, refSlot math.Slot, prevEpoch math.Epoch,
+) math.Epoch {
+	blockTime := b.cs.TargetSecondsPerEth1Block()
+	slot := refSlot
+	if forkTime > refTime && blockTime > 0 {
+		slot += math.Slot((forkTime - refTime + blockTime - 1) / blockTime)
+	}
+	return max(b.cs.SlotToEpoch(slot), prevEpoch)
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	configtypes "github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/block"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkSchedule(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	blockTime := cs.TargetSecondsPerEth1Block()
+	slotsPerEpoch := cs.SlotsPerEpoch()
+
+	// The Deneb1 activation block is set apart from the slot estimated from
+	// the target block time, to tell the two apart.
+	deneb1Time := cs.ForkTime(version.Deneb1())
+	deneb1Slot := math.Slot(3 * slotsPerEpoch)
+	slotAt := func(ts uint64) math.Slot {
+		return math.Slot((ts - cs.GenesisTime() + blockTime - 1) / blockTime)
+	}
+	electraEpoch := cs.SlotToEpoch(slotAt(cs.ForkTime(version.Electra()))) + 1
+	headSlot := math.Slot(electraEpoch.Unwrap() * slotsPerEpoch)
+	headTime := cs.ForkTime(version.Electra()) + 1
+
+	tests := []struct {
+		name        string
+		archiveMode bool
+		deneb1      *configtypes.ScheduledFork
+	}{
+		{
+			name:        "activation block in the block store",
+			archiveMode: true,
+			deneb1: &configtypes.ScheduledFork{
+				Fork: ctypes.NewFork(version.Deneb(), version.Deneb1(), cs.SlotToEpoch(deneb1Slot)),
+			},
+		},
+		{
+			// Deneb1 does not update the state fork, so its epoch is estimated
+			// from its activation time once its activation block is pruned.
+			name: "activation block pruned",
+			deneb1: &configtypes.ScheduledFork{
+				Fork: ctypes.NewFork(
+					version.Deneb(), version.Deneb1(),
+					cs.SlotToEpoch(slotAt(deneb1Time)),
+				),
+				Estimated: true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cms, kvStore, depositStore, errStores := statetransition.BuildTestStores()
+			require.NoError(t, errStores)
+			blockStore := block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 2, tc.archiveMode)
+			for _, blk := range []struct {
+				slot math.Slot
+				ts   uint64
+			}{
+				{deneb1Slot - 1, deneb1Time - 1},
+				{deneb1Slot, deneb1Time + 1},
+				{headSlot - 1, headTime - blockTime},
+				{headSlot, headTime},
+			} {
+				b, errBlk := ctypes.NewBeaconBlockWithVersion(blk.slot, 0, common.Root{}, version.Deneb1())
+				require.NoError(t, errBlk)
+				b.Body.ExecutionPayload.Timestamp = math.U64(blk.ts)
+				require.NoError(t, blockStore.Set(&ctypes.SignedBeaconBlock{BeaconBlock: b}))
+			}
+			sb := storage.NewBackend(
+				cs, nil, kvStore, depositStore, blockStore, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+			)
+
+			tmpDir := t.TempDir()
+			cmtCfg := cmtcfg.DefaultConfig()
+			cmtCfg.SetRoot(tmpDir)
+			require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config"), 0o755))
+			appGenesis := genutiltypes.NewAppGenesisWithVersion("test-chain", []byte("{}"))
+			appGenesis.GenesisTime = time.Unix(int64(cs.GenesisTime()), 0) // #nosec G115
+			require.NoError(t, appGenesis.SaveAs(cmtCfg.GenesisFile()))
+
+			b, errB := backend.New(sb, cs, cmtCfg, nil, nil, nil, nil)
+			require.NoError(t, errB)
+			b.AttachQueryBackend(&testConsensusService{cms: cms, kvStore: kvStore, cs: cs})
+
+			// Head is past the Electra fork but before the Electra1 fork.
+			sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+			st := statedb.NewBeaconStateFromDB(
+				kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+			)
+			require.NoError(t, st.SetSlot(headSlot))
+			require.NoError(t, st.SetFork(ctypes.NewFork(version.Deneb(), version.Electra(), electraEpoch)))
+			header, errH := ctypes.DefaultGenesisExecutionPayloadHeader(version.Electra())
+			require.NoError(t, errH)
+			header.Timestamp = math.U64(headTime)
+			require.NoError(t, st.SetLatestExecutionPayloadHeader(header))
+			//nolint:errcheck // false positive as this has no return value
+			sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+
+			// Electra1 is estimated from the head.
+			electra1Slot := headSlot + math.Slot((cs.ForkTime(version.Electra1())-headTime+blockTime-1)/blockTime)
+			forks, errF := b.ForkSchedule()
+			require.NoError(t, errF)
+			require.Equal(t, []*configtypes.ScheduledFork{
+				{Fork: ctypes.NewFork(version.Deneb(), version.Deneb(), constants.GenesisEpoch)},
+				tc.deneb1,
+				{Fork: ctypes.NewFork(version.Deneb1(), version.Electra(), electraEpoch)},
+				{
+					Fork:      ctypes.NewFork(version.Electra(), version.Electra1(), cs.SlotToEpoch(electra1Slot)),
+					Estimated: true,
+				},
+			}, forks)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/math"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
+)
+
+// DepositsAtSlot returns all deposits processed by the beacon state at the
+// given slot, whose root is the deposit root of the state, along with the EL
+// block that emitted the last of them. The block is nil if it is unknown, as
+// for genesis deposits.
+func (b *Backend) DepositsAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (ctypes.Deposits, *depositstorecommon.Block, error) {
+	st, _, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return nil, nil, fmt.Errorf("GetEth1DepositIndex failed: %w", err)
+	}
+
+	depositStore := b.sb.DepositStore()
+	deposits, _, err := depositStore.GetDepositsByIndex(ctx, constants.FirstDepositIndex, depositIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(deposits)) != depositIndex {
+		return nil, nil, fmt.Errorf(
+			"deposit store holds %d of %d deposits", len(deposits), depositIndex,
+		)
+	}
+	if depositIndex == 0 {
+		return deposits, nil, nil
+	}
+
+	block, found, err := depositStore.GetDepositBlock(ctx, depositIndex-1)
+	if err != nil || !found {
+		return deposits, nil, err
+	}
+	return deposits, &block, nil
+}
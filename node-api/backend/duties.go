@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/errors"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// ErrEpochOutOfRange is returned when duties are requested for an epoch
+// beyond the next one.
+var ErrEpochOutOfRange = errors.New("epoch out of range")
+
+// ProposerDutiesAtEpoch returns the proposers of the slots of the given epoch,
+// which must not be beyond the next one, and the dependent root of the duties.
+//
+// Proposers of slots up to head are read from the block store, when still
+// available. Proposers of later slots are projected from CometBFT's validator
+// set and proposer priorities, on a best-effort basis.
+func (b *Backend) ProposerDutiesAtEpoch(
+	epoch math.Epoch,
+) (common.Root, []*validatortypes.ProposerDuty, error) {
+	st, headSlot, err := b.StateAtSlot(0)
+	if err != nil {
+		return common.Root{}, nil, errors.Wrapf(err, "failed to get head state")
+	}
+	if headEpoch := b.cs.SlotToEpoch(headSlot); epoch > headEpoch+1 {
+		return common.Root{}, nil, fmt.Errorf(
+			"%w: requested epoch %d, head epoch %d", ErrEpochOutOfRange, epoch, headEpoch,
+		)
+	}
+
+	var (
+		startSlot = math.Slot(epoch.Unwrap() * b.cs.SlotsPerEpoch())
+		endSlot   = startSlot + math.Slot(b.cs.SlotsPerEpoch())
+	)
+	dependentRoot, err := b.dependentRoot(st, startSlot, headSlot)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+
+	duties := make([]*validatortypes.ProposerDuty, 0, b.cs.SlotsPerEpoch())
+
+	// Slots up to head, skipping genesis which has no proposer.
+	for slot := max(startSlot, 1); slot < endSlot && slot <= headSlot; slot++ {
+		blk, errBlk := b.sb.BlockStore().GetBlockBySlot(slot)
+		if errBlk != nil {
+			// The block may have been pruned already.
+			continue
+		}
+		index := blk.GetBeaconBlock().GetProposerIndex()
+		validator, errVal := st.ValidatorByIndex(index)
+		if errVal != nil {
+			return common.Root{}, nil, errors.Wrapf(errVal, "failed to get validator %d", index)
+		}
+		duties = append(duties, &validatortypes.ProposerDuty{
+			Pubkey:         validator.GetPubkey(),
+			ValidatorIndex: index.Unwrap(),
+			Slot:           slot.Unwrap(),
+		})
+	}
+
+	// Upcoming slots.
+	if endSlot > headSlot+1 {
+		upcoming, errUp := b.upcomingProposerDuties(st, headSlot, max(startSlot, headSlot+1), endSlot)
+		if errUp != nil {
+			return common.Root{}, nil, errUp
+		}
+		duties = append(duties, upcoming...)
+	}
+	return dependentRoot, duties, nil
+}
+
+// upcomingProposerDuties projects the proposers of the slots in [from, to),
+// which are all after headSlot.
+func (b *Backend) upcomingProposerDuties(
+	st *statedb.StateDB,
+	headSlot, from, to math.Slot,
+) ([]*validatortypes.ProposerDuty, error) {
+	// Slots are CometBFT heights. CometBFT may be one height ahead of the
+	// state at head, so the projection is matched to slots by height.
+	count := int(to.Unwrap() - headSlot.Unwrap() - 1) // #nosec G115 -- bounded by two epochs.
+	firstHeight, proposers, err := b.node.UpcomingProposers(count)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to project upcoming proposers")
+	}
+
+	duties := make([]*validatortypes.ProposerDuty, 0, len(proposers))
+	for i, pubkey := range proposers {
+		slot := math.Slot(firstHeight) + math.Slot(i) // #nosec G115 -- heights are positive.
+		if slot < from || slot >= to {
+			continue
+		}
+		index, errIdx := st.ValidatorIndexByPubkey(pubkey)
+		if errIdx != nil {
+			return nil, errors.Wrapf(errIdx, "failed to get index of validator %s", pubkey)
+		}
+		duties = append(duties, &validatortypes.ProposerDuty{
+			Pubkey:         pubkey,
+			ValidatorIndex: index.Unwrap(),
+			Slot:           slot.Unwrap(),
+		})
+	}
+	return duties, nil
+}
+
+// dependentRoot returns the root of the last block before the epoch starting
+// at startSlot, or the genesis block root for the genesis epoch. If that
+// block is yet to come, duties depend on the head block.
+func (b *Backend) dependentRoot(
+	st *statedb.StateDB,
+	startSlot, headSlot math.Slot,
+) (common.Root, error) {
+	var depSlot math.Slot
+	if startSlot > 0 {
+		depSlot = startSlot - 1
+	}
+	if depSlot >= headSlot {
+		root, err := b.BlockRootAtSlot(headSlot)
+		if err != nil {
+			return common.Root{}, errors.Wrapf(err, "failed to get head block root")
+		}
+		return root, nil
+	}
+
+	slotsPerHistoricalRoot := b.cs.SlotsPerHistoricalRoot()
+	if headSlot.Unwrap()-depSlot.Unwrap() > slotsPerHistoricalRoot {
+		return common.Root{}, fmt.Errorf(
+			"%w: block root at slot %d no longer available", ErrEpochOutOfRange, depSlot,
+		)
+	}
+	return st.GetBlockRootAtIndex(depSlot.Unwrap() % slotsPerHistoricalRoot)
+}
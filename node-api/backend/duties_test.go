@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/block"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposerDutiesAtEpoch(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	blockStore := block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 1000, false)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, blockStore, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	tmpDir := t.TempDir()
+	cmtCfg := cmtcfg.DefaultConfig()
+	cmtCfg.SetRoot(tmpDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config"), 0o755))
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test-chain", []byte("{}"))
+	require.NoError(t, appGenesis.SaveAs(cmtCfg.GenesisFile()))
+
+	b, err := backend.New(sb, cs, cmtCfg, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	var (
+		slotsPerEpoch = math.Slot(cs.SlotsPerEpoch())
+		// Head is a few slots into epoch 1.
+		headSlot = slotsPerEpoch + 2
+		pubkeys  = []crypto.BLSPubkey{{0x01}, {0x02}}
+		depRoot  = common.Root{0xaa}
+		headRoot = common.Root{0xbb}
+	)
+
+	// Blocks of epoch 1 up to head are proposed by validator 1.
+	for slot := slotsPerEpoch; slot <= headSlot; slot++ {
+		blk, errBlk := ctypes.NewBeaconBlockWithVersion(slot, 1, common.Root{}, version.Deneb1())
+		require.NoError(t, errBlk)
+		require.NoError(t, blockStore.Set(&ctypes.SignedBeaconBlock{BeaconBlock: blk}))
+	}
+
+	// Projected proposers alternate, starting from the slot after head.
+	upcoming := make([]crypto.BLSPubkey, 2*slotsPerEpoch)
+	for i := range upcoming {
+		upcoming[i] = pubkeys[i%2]
+	}
+	b.AttachQueryBackend(&testConsensusService{
+		cms:               cms,
+		kvStore:           kvStore,
+		cs:                cs,
+		nextHeight:        int64(headSlot) + 1, // #nosec G115
+		upcomingProposers: upcoming,
+	})
+
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+	for i, pk := range pubkeys {
+		val := &types.Validator{
+			PublicKey:                  pk.String(),
+			WithdrawalCredentials:      common.Bytes32{}.String(),
+			EffectiveBalance:           cs.MaxEffectiveBalance().Base10(),
+			ActivationEligibilityEpoch: "0",
+			ActivationEpoch:            "0",
+			ExitEpoch:                  constants.FarFutureEpoch.Base10(),
+			WithdrawableEpoch:          constants.FarFutureEpoch.Base10(),
+		}
+		consensusVal, errVal := types.ValidatorToConsensus(val)
+		require.NoError(t, errVal)
+		require.NoError(t, st.AddValidator(consensusVal))
+		require.NoError(t, st.SetBalance(math.ValidatorIndex(i), cs.MaxEffectiveBalance()))
+	}
+	setupStateDummyParts(t, cs, st, headSlot)
+	require.NoError(t, st.UpdateBlockRootAtIndex(
+		(slotsPerEpoch-1).Unwrap()%cs.SlotsPerHistoricalRoot(), depRoot,
+	))
+	require.NoError(t, st.UpdateBlockRootAtIndex(
+		headSlot.Unwrap()%cs.SlotsPerHistoricalRoot(), headRoot,
+	))
+	//nolint:errcheck // false positive as this has no return value
+	sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+
+	t.Run("current epoch", func(t *testing.T) {
+		t.Parallel()
+		root, duties, errDuties := b.ProposerDutiesAtEpoch(1)
+		require.NoError(t, errDuties)
+		require.Equal(t, depRoot, root)
+		require.Len(t, duties, int(slotsPerEpoch))
+		for i, duty := range duties {
+			slot := slotsPerEpoch + math.Slot(i)
+			require.Equal(t, slot.Unwrap(), duty.Slot)
+			expectedIndex := uint64(1)
+			if slot > headSlot {
+				expectedIndex = (slot - headSlot - 1).Unwrap() % 2
+			}
+			require.Equal(t, expectedIndex, duty.ValidatorIndex)
+			require.Equal(t, pubkeys[expectedIndex], duty.Pubkey)
+		}
+	})
+
+	t.Run("next epoch", func(t *testing.T) {
+		t.Parallel()
+		_, duties, errDuties := b.ProposerDutiesAtEpoch(2)
+		require.NoError(t, errDuties)
+		require.Len(t, duties, int(slotsPerEpoch))
+		require.Equal(t, (2 * slotsPerEpoch).Unwrap(), duties[0].Slot)
+	})
+
+	t.Run("epoch beyond next", func(t *testing.T) {
+		t.Parallel()
+		_, _, errDuties := b.ProposerDutiesAtEpoch(3)
+		require.ErrorIs(t, errDuties, backend.ErrEpochOutOfRange)
+	})
+}
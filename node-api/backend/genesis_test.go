@@ -101,7 +101,7 @@ func TestGetGenesisData(t *testing.T) {
 	err = appGenesis.SaveAs(genesisFile)
 	require.NoError(t, err)
 
-	b, err := backend.New(sb, cs, cmtCfg)
+	b, err := backend.New(sb, cs, cmtCfg, nil, nil, nil, nil)
 	require.NoError(t, err)
 	tcs := &testConsensusService{
 		cms:     cms,
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// MaxLightClientUpdates is the maximum number of light client updates served
+// by a single request.
+const MaxLightClientUpdates = 128
+
+// LightClientBootstrap returns the bootstrap of the block with the given root,
+// together with the validator set signing the following block.
+func (b *Backend) LightClientBootstrap(root common.Root) (*ctypes.LightClientBootstrap, error) {
+	slot, err := b.GetSlotByBlockRoot(root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get slot of block %s", root)
+	}
+	header, err := b.lightClientHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := b.node.LightClientValidators(int64(slot.Unwrap()) + 1) // #nosec G115 -- slots fit heights.
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.LightClientBootstrap{
+		Header:            header,
+		CurrentValidators: vals,
+	}, nil
+}
+
+// LightClientUpdates returns up to count updates, one per period starting at
+// startPeriod.
+//
+// A period is a span of heights signed by the same CometBFT validator set.
+// Validator updates are only computed on the first slot of an epoch and
+// CometBFT applies the updates of height h from height h+2, so period p spans
+// heights p*SLOTS_PER_EPOCH+2 to (p+1)*SLOTS_PER_EPOCH+1, starting at height 1
+// for period 0. The update of a period attests its last block, or the head
+// block for the ongoing period. It is signed by the validator set of the
+// period and carries the validator set of the next period.
+func (b *Backend) LightClientUpdates(
+	startPeriod math.Epoch,
+	count uint64,
+) ([]*ctypes.LightClientUpdate, error) {
+	_, headSlot, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get head slot")
+	}
+
+	count = min(count, MaxLightClientUpdates)
+	updates := make([]*ctypes.LightClientUpdate, 0, count)
+	for period := startPeriod; period < startPeriod+math.Epoch(count); period++ {
+		firstSlot, lastSlot := b.lightClientPeriodSlots(period)
+		if firstSlot > headSlot {
+			break
+		}
+		update, errUpd := b.lightClientUpdateAtSlot(min(lastSlot, headSlot))
+		if errUpd != nil {
+			return nil, errors.Wrapf(errUpd, "failed to build update of period %d", period)
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+// lightClientPeriodSlots returns the first and the last slot of the given
+// light client period.
+func (b *Backend) lightClientPeriodSlots(period math.Epoch) (math.Slot, math.Slot) {
+	// Validator updates of the first slot of an epoch sign from two slots on.
+	const updateDelay = 2
+	spe := b.cs.SlotsPerEpoch()
+	firstSlot := math.Slot(period.Unwrap()*spe + updateDelay)
+	if period == 0 {
+		// The genesis block carries no commit.
+		firstSlot = 1
+	}
+	return firstSlot, math.Slot((period.Unwrap()+1)*spe + updateDelay - 1)
+}
+
+// LightClientFinalityUpdate returns the finality update of the head block.
+// Every committed block is final, so the attested header is the finalized one.
+func (b *Backend) LightClientFinalityUpdate() (*ctypes.LightClientFinalityUpdate, error) {
+	header, commit, err := b.lightClientHeadCommit()
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.LightClientFinalityUpdate{
+		AttestedHeader:  header,
+		FinalizedHeader: header,
+		Commit:          commit,
+		SignatureSlot:   header.Beacon.GetSlot() + 1,
+	}, nil
+}
+
+// LightClientOptimisticUpdate returns the optimistic update of the head block.
+func (b *Backend) LightClientOptimisticUpdate() (*ctypes.LightClientOptimisticUpdate, error) {
+	header, commit, err := b.lightClientHeadCommit()
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.LightClientOptimisticUpdate{
+		AttestedHeader: header,
+		Commit:         commit,
+		SignatureSlot:  header.Beacon.GetSlot() + 1,
+	}, nil
+}
+
+// lightClientUpdateAtSlot builds the update attesting the block at the given
+// slot.
+func (b *Backend) lightClientUpdateAtSlot(slot math.Slot) (*ctypes.LightClientUpdate, error) {
+	header, commit, err := b.lightClientCommitAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := b.node.LightClientValidators(int64(slot.Unwrap()) + 1) // #nosec G115 -- slots fit heights.
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.LightClientUpdate{
+		AttestedHeader: header,
+		NextValidators: vals,
+		Commit:         commit,
+		SignatureSlot:  slot + 1,
+	}, nil
+}
+
+// lightClientHeadCommit returns the header and the commit of the head block.
+func (b *Backend) lightClientHeadCommit() (*ctypes.LightClientHeader, *ctypes.LightClientCommit, error) {
+	_, headSlot, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get head slot")
+	}
+	return b.lightClientCommitAtSlot(headSlot)
+}
+
+// lightClientCommitAtSlot returns the header and the commit of the block at
+// the given slot.
+func (b *Backend) lightClientCommitAtSlot(
+	slot math.Slot,
+) (*ctypes.LightClientHeader, *ctypes.LightClientCommit, error) {
+	header, err := b.lightClientHeaderAtSlot(slot)
+	if err != nil {
+		return nil, nil, err
+	}
+	commit, err := b.node.LightClientCommit(int64(slot.Unwrap())) // #nosec G115 -- slots fit heights.
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, commit, nil
+}
+
+// lightClientHeaderAtSlot returns the light client header of the block at the
+// given slot, which must still be available in the block store.
+func (b *Backend) lightClientHeaderAtSlot(slot math.Slot) (*ctypes.LightClientHeader, error) {
+	blk, err := b.sb.BlockStore().GetBlockBySlot(slot)
+	if err != nil {
+		return nil, fmt.Errorf("block at slot %d is not available: %w", slot, err)
+	}
+	cometBFT, err := b.node.LightClientCometBFTHeader(int64(slot.Unwrap())) // #nosec G115 -- slots fit heights.
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewLightClientHeader(blk.GetBeaconBlock(), cometBFT)
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/block"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	testutils "github.com/berachain/beacon-kit/testing/utils"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLightClientUpdates(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	blockStore := block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 1000, true)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, blockStore, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	tmpDir := t.TempDir()
+	cmtCfg := cmtcfg.DefaultConfig()
+	cmtCfg.SetRoot(tmpDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config"), 0o755))
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test-chain", []byte("{}"))
+	require.NoError(t, appGenesis.SaveAs(cmtCfg.GenesisFile()))
+
+	b, err := backend.New(sb, cs, cmtCfg, nil, nil, nil, nil)
+	require.NoError(t, err)
+	b.AttachQueryBackend(&testConsensusService{cms: cms, kvStore: kvStore, cs: cs})
+
+	// Validator updates of the first slot of an epoch sign from two slots on,
+	// so the last block signed by the validator set of period p is at slot
+	// (p+1)*SLOTS_PER_EPOCH+1. The head is in the middle of period 2.
+	var (
+		slotsPerEpoch = math.Slot(cs.SlotsPerEpoch())
+		headSlot      = 2*slotsPerEpoch + 5
+		attested      = []math.Slot{slotsPerEpoch + 1, 2*slotsPerEpoch + 1, headSlot}
+	)
+	for _, slot := range attested {
+		blk := testutils.GenerateValidBeaconBlock(t, version.Electra())
+		blk.Slot = slot
+		require.NoError(t, blockStore.Set(&ctypes.SignedBeaconBlock{BeaconBlock: blk}))
+	}
+
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+	require.NoError(t, st.SetSlot(headSlot))
+	//nolint:errcheck // false positive as this has no return value
+	sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+
+	updates, err := b.LightClientUpdates(0, 4)
+	require.NoError(t, err)
+	require.Len(t, updates, len(attested))
+	for i, update := range updates {
+		slot := attested[i]
+		require.Equal(t, slot, update.AttestedHeader.Beacon.GetSlot())
+		require.Equal(t, slot.Unwrap(), update.AttestedHeader.CometBFT.TxTotal)
+		require.Equal(t, slot.Unwrap(), update.Commit.Height)
+		require.Equal(t, slot+1, update.SignatureSlot)
+		// The next validators sign the block after the attested one.
+		require.Equal(t, slot.Unwrap()+1, update.NextValidators[0].VotingPower)
+	}
+
+	updates, err = b.LightClientUpdates(2, 2)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, headSlot, updates[0].AttestedHeader.Beacon.GetSlot())
+}
@@ -14,8 +14,6 @@ import (
 	state "github.com/berachain/beacon-kit/state-transition/core/state"
 
 	store "github.com/berachain/beacon-kit/da/store"
-
-	types "github.com/berachain/beacon-kit/consensus-types/types"
 )
 
 // StorageBackend is an autogenerated mock type for the StorageBackend type
@@ -79,19 +77,19 @@ func (_c *StorageBackend_AvailabilityStore_Call) RunAndReturn(run func() *store.
 }
 
 // BlockStore provides a mock function with given fields:
-func (_m *StorageBackend) BlockStore() *block.KVStore[*types.BeaconBlock] {
+func (_m *StorageBackend) BlockStore() *block.KVStore {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
 		panic("no return value specified for BlockStore")
 	}
 
-	var r0 *block.KVStore[*types.BeaconBlock]
-	if rf, ok := ret.Get(0).(func() *block.KVStore[*types.BeaconBlock]); ok {
+	var r0 *block.KVStore
+	if rf, ok := ret.Get(0).(func() *block.KVStore); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*block.KVStore[*types.BeaconBlock])
+			r0 = ret.Get(0).(*block.KVStore)
 		}
 	}
 
@@ -115,12 +113,12 @@ func (_c *StorageBackend_BlockStore_Call) Run(run func()) *StorageBackend_BlockS
 	return _c
 }
 
-func (_c *StorageBackend_BlockStore_Call) Return(_a0 *block.KVStore[*types.BeaconBlock]) *StorageBackend_BlockStore_Call {
+func (_c *StorageBackend_BlockStore_Call) Return(_a0 *block.KVStore) *StorageBackend_BlockStore_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StorageBackend_BlockStore_Call) RunAndReturn(run func() *block.KVStore[*types.BeaconBlock]) *StorageBackend_BlockStore_Call {
+func (_c *StorageBackend_BlockStore_Call) RunAndReturn(run func() *block.KVStore) *StorageBackend_BlockStore_Call {
 	_c.Call.Return(run)
 	return _c
 }
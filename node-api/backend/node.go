@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/errors"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// SyncingData returns the sync status of the node. The head slot is the slot
+// of the latest committed state, while the sync distance is measured against
+// the tip of the chain as known by CometBFT.
+func (b *Backend) SyncingData() (*nodetypes.SyncingData, error) {
+	_, headSlot, err := b.StateAtSlot(0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get head slot")
+	}
+
+	// Slots and heights match in BeaconKit.
+	//#nosec: G115 // heights are never negative.
+	tip := math.Slot(b.node.SyncingToHeight())
+	var syncDistance math.Slot
+	if tip > headSlot {
+		syncDistance = tip - headSlot
+	}
+
+	return &nodetypes.SyncingData{
+		HeadSlot:     headSlot.Unwrap(),
+		SyncDistance: syncDistance.Unwrap(),
+		IsSyncing:    b.node.IsSyncing(),
+		// Only finalized blocks are ever served.
+		IsOptimistic: false,
+		ELOffline:    !b.ec.IsConnected(),
+	}, nil
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ErrNoRelays is returned when validators are registered with the relays of
+// external block builders, but none is configured.
+var ErrNoRelays = errors.New("no builder relays configured")
+
+// PrepareBeaconProposers sets the fee recipients of the validators with the
+// given indices. Indices unknown to the head state are skipped, since
+// validator clients prepare their validators before they are deposited.
+func (b *Backend) PrepareBeaconProposers(
+	preparations []*validatortypes.ProposerPreparation,
+) error {
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get head state")
+	}
+	for _, p := range preparations {
+		index := math.ValidatorIndex(p.ValidatorIndex)
+		validator, errVal := st.ValidatorByIndex(index)
+		switch {
+		case errVal == nil:
+		case errors.Is(errVal, collections.ErrNotFound):
+			continue
+		default:
+			return errors.Wrapf(errVal, "failed to get validator by index %d", index)
+		}
+		b.pp.PrepareProposer(validator.GetPubkey(), p.FeeRecipient)
+	}
+	return nil
+}
+
+// RegisterValidators registers the validators with the relays of external
+// block builders, and sets their fee recipients as PrepareBeaconProposers
+// does. Registrations of validators unknown to the head state are skipped.
+func (b *Backend) RegisterValidators(
+	ctx context.Context, regs []*ctypes.SignedValidatorRegistration,
+) error {
+	if !b.relays.Enabled() {
+		return ErrNoRelays
+	}
+	st, _, err := b.StateAtSlot(0)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get head state")
+	}
+	known := make([]*ctypes.SignedValidatorRegistration, 0, len(regs))
+	for _, reg := range regs {
+		_, errVal := st.ValidatorIndexByPubkey(reg.Message.Pubkey)
+		switch {
+		case errVal == nil:
+		case errors.Is(errVal, collections.ErrNotFound):
+			continue
+		default:
+			return errors.Wrapf(errVal, "failed to get validator %s", reg.Message.Pubkey)
+		}
+		known = append(known, reg)
+	}
+	if len(known) == 0 {
+		return nil
+	}
+
+	if err = b.relays.RegisterValidators(ctx, known); err != nil {
+		return err
+	}
+	for _, reg := range known {
+		b.pp.PrepareProposer(reg.Message.Pubkey, reg.Message.FeeRecipient)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"math/big"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// BlockRewardsAtSlot returns the rewards of the block at the given slot,
+// resolving an input slot of 0 to the latest slot.
+//
+// The total reward is the EVM inflation minted by every block plus the
+// execution fees paid to the fee recipient, which are the priority fees of the
+// transactions of the payload as reported by their receipts. Balance changes
+// are computed between the post-states of the parent block and of the block,
+// and are not reported for the first block since the genesis state cannot be
+// queried.
+func (b *Backend) BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error) {
+	st, resolvedSlot, err := b.StateAtSlot(slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get state from slot %d", slot)
+	}
+	slot = resolvedSlot
+	blockHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get latest block header")
+	}
+	payloadHeader, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get latest execution payload header")
+	}
+
+	executionFees, err := b.executionFees(payloadHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	// The EVM inflation is the first withdrawal of every block.
+	inflation := st.EVMInflationWithdrawal(payloadHeader.GetTimestamp())
+	rewards := &types.BlockRewardsData{
+		ProposerIndex:       blockHeader.GetProposerIndex().Unwrap(),
+		Total:               inflation.GetAmount().Unwrap() + executionFees.Unwrap(),
+		EVMInflationAddress: inflation.GetAddress().String(),
+		EVMInflation:        inflation.GetAmount().Unwrap(),
+		FeeRecipient:        payloadHeader.GetFeeRecipient().String(),
+		ExecutionFees:       executionFees.Unwrap(),
+		BalanceChanges:      []*types.BalanceChange{},
+	}
+	if slot <= 1 {
+		return rewards, nil
+	}
+
+	preState, _, err := b.StateAtSlot(slot - 1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get state from slot %d", slot-1)
+	}
+	rewards.BalanceChanges, err = balanceChanges(preState, st)
+	if err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+// executionFees returns the priority fees paid to the fee recipient by the
+// transactions of the given payload, in Gwei.
+func (b *Backend) executionFees(payloadHeader *ctypes.ExecutionPayloadHeader) (math.Gwei, error) {
+	blockHash := payloadHeader.GetBlockHash()
+	receipts, err := b.ec.BlockReceipts(context.Background(), blockHash)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get receipts of execution block %s", blockHash)
+	}
+
+	baseFee := payloadHeader.GetBaseFeePerGas().ToBig()
+	fees, tip := new(big.Int), new(big.Int)
+	for _, receipt := range receipts {
+		if receipt.EffectiveGasPrice == nil {
+			continue
+		}
+		tip.Sub(receipt.EffectiveGasPrice, baseFee)
+		if tip.Sign() <= 0 {
+			continue
+		}
+		fees.Add(fees, tip.Mul(tip, new(big.Int).SetUint64(receipt.GasUsed)))
+	}
+	return math.GweiFromWei(fees)
+}
+
+// balanceChanges returns the non-zero changes of validator balances from
+// preState to postState. Validators added by the block start from a zero
+// balance.
+func balanceChanges(preState, postState *statedb.StateDB) ([]*types.BalanceChange, error) {
+	pre, err := preState.GetBalances()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get pre-state balances")
+	}
+	post, err := postState.GetBalances()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get post-state balances")
+	}
+
+	changes := make([]*types.BalanceChange, 0)
+	for i, balance := range post {
+		var prev uint64
+		if i < len(pre) {
+			prev = pre[i]
+		}
+		if balance == prev {
+			continue
+		}
+		changes = append(changes, &types.BalanceChange{
+			ValidatorIndex: uint64(i),
+			Delta:          int64(balance - prev), // #nosec G115 -- two's complement gives the signed delta.
+		})
+	}
+	return changes, nil
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package backend_test
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	gethprimitives "github.com/berachain/beacon-kit/geth-primitives"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	"github.com/berachain/beacon-kit/storage/beacondb"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockRewardsAtSlot(t *testing.T) {
+	t.Parallel()
+
+	cs, err := spec.MainnetChainSpec()
+	require.NoError(t, err)
+	cms, kvStore, depositStore, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	preCms, _, _, err := statetransition.BuildTestStores()
+	require.NoError(t, err)
+	sb := storage.NewBackend(
+		cs, nil, kvStore, depositStore, nil, log.NewNopLogger(), metrics.NewNoOpTelemetrySink(),
+	)
+
+	tmpDir := t.TempDir()
+	cmtCfg := cmtcfg.DefaultConfig()
+	cmtCfg.SetRoot(tmpDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "config"), 0o755))
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test-chain", []byte("{}"))
+	require.NoError(t, appGenesis.SaveAs(cmtCfg.GenesisFile()))
+
+	var (
+		slot         = math.Slot(10)
+		proposer     = math.ValidatorIndex(1)
+		feeRecipient = common.ExecutionAddress{0xfe}
+		timestamp    = math.U64(cs.ForkTime(version.Deneb1()) + 1)
+		gwei         = big.NewInt(1e9)
+	)
+
+	// The payload has a base fee of 1 Gwei. Transactions pay priority fees of
+	// 2 and 0 Gwei per gas, and one has no effective gas price.
+	ec := &testExecutionClient{receipts: []*gethprimitives.Receipt{
+		{GasUsed: 21000, EffectiveGasPrice: new(big.Int).Mul(big.NewInt(3), gwei)},
+		{GasUsed: 50000, EffectiveGasPrice: gwei},
+		{GasUsed: 10000},
+	}}
+	b, err := backend.New(sb, cs, cmtCfg, ec, nil, nil, nil)
+	require.NoError(t, err)
+	b.AttachQueryBackend(&testConsensusService{
+		cms:          cms,
+		kvStore:      kvStore,
+		cs:           cs,
+		heightStores: map[int64]storetypes.CommitMultiStore{int64(slot) - 1: preCms},
+	})
+
+	// Validator 0 is withdrawn from, validator 1 is unchanged and validator 2
+	// is added by the block.
+	writeRewardsState(t, preCms, kvStore, cs, slot-1, proposer, timestamp-2, feeRecipient, []uint64{40e9, 32e9})
+	writeRewardsState(t, cms, kvStore, cs, slot, proposer, timestamp, feeRecipient, []uint64{35e9, 32e9, 10e9})
+
+	rewards, err := b.BlockRewardsAtSlot(slot)
+	require.NoError(t, err)
+	inflation := cs.EVMInflationPerBlock(timestamp).Unwrap()
+	executionFees := uint64(2 * 21000)
+	require.Equal(t, &types.BlockRewardsData{
+		ProposerIndex:       proposer.Unwrap(),
+		Total:               inflation + executionFees,
+		EVMInflationAddress: cs.EVMInflationAddress(timestamp).String(),
+		EVMInflation:        inflation,
+		FeeRecipient:        feeRecipient.String(),
+		ExecutionFees:       executionFees,
+		BalanceChanges: []*types.BalanceChange{
+			{ValidatorIndex: 0, Delta: -5e9},
+			{ValidatorIndex: 2, Delta: 10e9},
+		},
+	}, rewards)
+}
+
+// testExecutionClient serves the same receipts for every block.
+type testExecutionClient struct {
+	receipts []*gethprimitives.Receipt
+}
+
+func (c *testExecutionClient) IsConnected() bool { return true }
+
+func (c *testExecutionClient) BlockReceipts(
+	context.Context, common.ExecutionHash,
+) ([]*gethprimitives.Receipt, error) {
+	return c.receipts, nil
+}
+
+func writeRewardsState(
+	t *testing.T,
+	cms storetypes.CommitMultiStore,
+	kvStore *beacondb.KVStore,
+	cs chain.Spec,
+	slot math.Slot,
+	proposer math.ValidatorIndex,
+	timestamp math.U64,
+	feeRecipient common.ExecutionAddress,
+	balances []uint64,
+) {
+	t.Helper()
+	sdkCtx := sdk.NewContext(cms.CacheMultiStore(), true, log.NewNopLogger())
+	st := statedb.NewBeaconStateFromDB(
+		kvStore.WithContext(sdkCtx), cs, sdkCtx.Logger(), metrics.NewNoOpTelemetrySink(),
+	)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetLatestBlockHeader(
+		ctypes.NewBeaconBlockHeader(slot, proposer, common.Root{}, common.Root{}, common.Root{}),
+	))
+	header, err := ctypes.DefaultGenesisExecutionPayloadHeader(version.Deneb1())
+	require.NoError(t, err)
+	header.Timestamp = timestamp
+	header.FeeRecipient = feeRecipient
+	header.BaseFeePerGas = math.NewU256(1e9)
+	require.NoError(t, st.SetLatestExecutionPayloadHeader(header))
+	for i, balance := range balances {
+		require.NoError(t, st.SetBalance(math.ValidatorIndex(i), math.Gwei(balance)))
+	}
+	//nolint:errcheck // false positive as this has no return value
+	sdkCtx.MultiStore().(storetypes.CacheMultiStore).Write()
+}
@@ -82,7 +82,7 @@ func TestFilteredValidators(t *testing.T) {
 	err = appGenesis.SaveAs(genesisFile)
 	require.NoError(t, err)
 
-	b, err := backend.New(sb, cs, cmtCfg)
+	b, err := backend.New(sb, cs, cmtCfg, nil, nil, nil, nil)
 	require.NoError(t, err)
 	tcs := &testConsensusService{
 		cms:     cms,
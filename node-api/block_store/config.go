@@ -30,6 +30,9 @@ type Config struct {
 	Enabled bool `mapstructure:"enabled"`
 	// AvailabilityWindow is the number of slots to keep in the store.
 	AvailabilityWindow int `mapstructure:"availability-window"`
+	// ArchiveMode keeps every finalized block in the store, ignoring the
+	// availability window.
+	ArchiveMode bool `mapstructure:"archive-mode"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
@@ -37,5 +40,6 @@ func DefaultConfig() Config {
 	return Config{
 		Enabled:            false,
 		AvailabilityWindow: DefaultAvailabilityWindow,
+		ArchiveMode:        false,
 	}
 }
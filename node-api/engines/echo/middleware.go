@@ -40,6 +40,10 @@ type ErrorResponse struct {
 func responseMiddleware(handler *handlers.Route) echo.HandlerFunc {
 	return func(c handlers.Context) error {
 		data, err := handler.Handler(c)
+		if c.Response().Committed {
+			// The handler wrote the response itself, e.g. an event stream.
+			return nil
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
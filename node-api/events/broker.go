@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+)
+
+const defaultBufferSize = 16
+
+var (
+	// ErrUnsupportedTopic is returned when subscribing to an unknown topic.
+	ErrUnsupportedTopic = errors.New("unsupported event topic")
+	// ErrNoTopics is returned when subscribing without any topic.
+	ErrNoTopics = errors.New("no event topics requested")
+)
+
+// Event is a single message delivered to subscribers.
+type Event struct {
+	Topic string
+	Data  any
+}
+
+// Subscription is a buffered stream of events for a set of topics. The
+// events channel is closed when the subscription is cancelled or when the
+// subscriber falls too far behind and is dropped by the broker.
+type Subscription struct {
+	id     uint64
+	topics map[string]struct{}
+	events chan Event
+}
+
+// Events returns the channel events are delivered on.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Broker fans out events to subscribers. Publishing never blocks: a
+// subscriber whose buffer is full is dropped so that slow API consumers
+// cannot stall block finalization.
+type Broker struct {
+	logger     log.Logger
+	bufferSize int
+
+	mu     sync.RWMutex
+	nextID uint64
+	subs   map[uint64]*Subscription
+}
+
+// NewBroker creates a new broker whose subscriptions buffer up to
+// bufferSize events each.
+func NewBroker(logger log.Logger, bufferSize int) *Broker {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	return &Broker{
+		logger:     logger,
+		bufferSize: bufferSize,
+		subs:       make(map[uint64]*Subscription),
+	}
+}
+
+// Subscribe registers a new subscription for the given topics.
+func (b *Broker) Subscribe(topics ...string) (*Subscription, error) {
+	if len(topics) == 0 {
+		return nil, ErrNoTopics
+	}
+	set := make(map[string]struct{}, len(topics))
+	for _, topic := range topics {
+		if !IsSupportedTopic(topic) {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedTopic, topic)
+		}
+		set[topic] = struct{}{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.nextID++
+	sub := &Subscription{
+		id:     b.nextID,
+		topics: set,
+		events: make(chan Event, b.bufferSize),
+	}
+	b.subs[sub.id] = sub
+	return sub, nil
+}
+
+// Unsubscribe cancels the subscription and closes its events channel. It is
+// safe to call on a subscription that was already dropped.
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(sub)
+}
+
+// HasSubscribers returns true if at least one subscription listens to topic.
+func (b *Broker) HasSubscribers(topic string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, sub := range b.subs {
+		if _, ok := sub.topics[topic]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish delivers data to every subscription listening to topic.
+func (b *Broker) Publish(topic string, data any) {
+	var slow []*Subscription
+
+	b.mu.RLock()
+	for _, sub := range b.subs {
+		if _, ok := sub.topics[topic]; !ok {
+			continue
+		}
+		select {
+		case sub.events <- Event{Topic: topic, Data: data}:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	if len(slow) == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, sub := range slow {
+		if b.remove(sub) {
+			b.logger.Warn(
+				"Dropping slow event stream subscriber",
+				"topic", topic, "buffer_size", b.bufferSize,
+			)
+		}
+	}
+}
+
+// remove deletes the subscription and closes its channel. It must be called
+// with the write lock held and reports whether the subscription was present.
+func (b *Broker) remove(sub *Subscription) bool {
+	if _, ok := b.subs[sub.id]; !ok {
+		return false
+	}
+	delete(b.subs, sub.id)
+	close(sub.events)
+	return true
+}
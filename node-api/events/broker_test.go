@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrokerDeliversSubscribedTopics(t *testing.T) {
+	t.Parallel()
+	b := events.NewBroker(noop.NewLogger[log.Logger](), 4)
+
+	sub, err := b.Subscribe(events.TopicHead, events.TopicBlock)
+	require.NoError(t, err)
+	require.True(t, b.HasSubscribers(events.TopicHead))
+	require.False(t, b.HasSubscribers(events.TopicBlobSidecar))
+
+	b.Publish(events.TopicBlobSidecar, "ignored")
+	b.Publish(events.TopicBlock, "block")
+	b.Publish(events.TopicHead, "head")
+
+	ev := <-sub.Events()
+	require.Equal(t, events.Event{Topic: events.TopicBlock, Data: "block"}, ev)
+	ev = <-sub.Events()
+	require.Equal(t, events.Event{Topic: events.TopicHead, Data: "head"}, ev)
+	require.Empty(t, sub.Events())
+
+	b.Unsubscribe(sub)
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+	require.False(t, b.HasSubscribers(events.TopicHead))
+
+	// Unsubscribing twice must be harmless.
+	b.Unsubscribe(sub)
+}
+
+func TestBrokerRejectsInvalidTopics(t *testing.T) {
+	t.Parallel()
+	b := events.NewBroker(noop.NewLogger[log.Logger](), 4)
+
+	_, err := b.Subscribe()
+	require.ErrorIs(t, err, events.ErrNoTopics)
+
+	_, err = b.Subscribe(events.TopicHead, "chain_reorg_of_doom")
+	require.ErrorIs(t, err, events.ErrUnsupportedTopic)
+	require.False(t, b.HasSubscribers(events.TopicHead))
+}
+
+func TestBrokerDropsSlowSubscribers(t *testing.T) {
+	t.Parallel()
+	const bufferSize = 2
+	b := events.NewBroker(noop.NewLogger[log.Logger](), bufferSize)
+
+	slow, err := b.Subscribe(events.TopicBlock)
+	require.NoError(t, err)
+	fast, err := b.Subscribe(events.TopicBlock)
+	require.NoError(t, err)
+
+	// Publishing must never block, even once the slow subscriber is full.
+	for i := range bufferSize + 1 {
+		b.Publish(events.TopicBlock, i)
+		require.Equal(t, i, (<-fast.Events()).Data)
+	}
+
+	// The slow subscriber keeps its buffered events and is then closed.
+	for i := range bufferSize {
+		require.Equal(t, i, (<-slow.Events()).Data)
+	}
+	_, ok := <-slow.Events()
+	require.False(t, ok)
+
+	// The fast subscriber is unaffected.
+	b.Publish(events.TopicBlock, "next")
+	require.Equal(t, "next", (<-fast.Events()).Data)
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"strconv"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/payload/builder"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+// ChainSpec is the chain spec required by the publisher.
+type ChainSpec interface {
+	SlotsPerEpoch() uint64
+	SlotsPerHistoricalRoot() uint64
+	SlotToEpoch(slot math.Slot) math.Epoch
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+}
+
+// Publisher converts chain data into event stream payloads and hands them to
+// the broker.
+type Publisher struct {
+	broker            *Broker
+	chainSpec         ChainSpec
+	attributesFactory builder.AttributesFactory
+	logger            log.Logger
+}
+
+// NewPublisher creates a new publisher on top of the given broker.
+func NewPublisher(
+	broker *Broker,
+	chainSpec ChainSpec,
+	attributesFactory builder.AttributesFactory,
+	logger log.Logger,
+) *Publisher {
+	return &Publisher{
+		broker:            broker,
+		chainSpec:         chainSpec,
+		attributesFactory: attributesFactory,
+		logger:            logger,
+	}
+}
+
+// PublishFinalizedBlock publishes the head, block and finalized_checkpoint
+// events for a block that was just finalized. Since CometBFT provides single
+// slot finality, every block is immediately the new head and finalized.
+func (p *Publisher) PublishFinalizedBlock(
+	st *statedb.StateDB,
+	blk *ctypes.BeaconBlock,
+) {
+	var (
+		slot      = blk.GetSlot()
+		blockRoot = blk.HashTreeRoot()
+		stateRoot = blk.GetStateRoot()
+		epoch     = p.chainSpec.SlotToEpoch(slot)
+	)
+
+	if p.broker.HasSubscribers(TopicHead) {
+		prevDependentRoot, currDependentRoot, err := p.dutyDependentRoots(st, epoch)
+		if err != nil {
+			p.logger.Warn(
+				"Failed to compute duty dependent roots for head event",
+				"slot", slot.Base10(), "error", err,
+			)
+		}
+		p.broker.Publish(TopicHead, &HeadData{
+			Slot:                      slot.Base10(),
+			Block:                     blockRoot,
+			State:                     stateRoot,
+			EpochTransition:           slot.Unwrap()%p.chainSpec.SlotsPerEpoch() == 0,
+			PreviousDutyDependentRoot: prevDependentRoot,
+			CurrentDutyDependentRoot:  currDependentRoot,
+		})
+	}
+
+	p.broker.Publish(TopicBlock, &BlockData{
+		Slot:  slot.Base10(),
+		Block: blockRoot,
+	})
+
+	p.broker.Publish(TopicFinalizedCheckpoint, &FinalizedCheckpointData{
+		Block: blockRoot,
+		State: stateRoot,
+		Epoch: epoch.Base10(),
+	})
+}
+
+// PublishBlobSidecars publishes a blob_sidecar event for each sidecar.
+func (p *Publisher) PublishBlobSidecars(sidecars datypes.BlobSidecars) {
+	if !p.broker.HasSubscribers(TopicBlobSidecar) {
+		return
+	}
+	for _, sc := range sidecars {
+		header := sc.GetBeaconBlockHeader()
+		commitment := sc.GetKzgCommitment()
+		versionedHash := commitment.ToVersionedHash()
+		p.broker.Publish(TopicBlobSidecar, &BlobSidecarData{
+			BlockRoot:     header.HashTreeRoot(),
+			Index:         strconv.FormatUint(sc.GetIndex(), 10),
+			Slot:          header.GetSlot().Base10(),
+			KZGCommitment: hex.EncodeBytes(commitment[:]),
+			VersionedHash: hex.EncodeBytes(versionedHash[:]),
+		})
+	}
+}
+
+// WantsPayloadAttributes returns true if anyone is listening for
+// payload_attributes events. Computing the attributes of the next payload
+// requires processing a copy of the state, so callers should skip it when
+// nobody is listening.
+func (p *Publisher) WantsPayloadAttributes() bool {
+	return p.broker.HasSubscribers(TopicPayloadAttributes)
+}
+
+// PublishPayloadAttributes publishes the attributes the next payload will be
+// built with.
+func (p *Publisher) PublishPayloadAttributes(
+	data *builder.RequestPayloadData,
+	parentBlockNumber math.U64,
+) {
+	attrs, err := p.attributesFactory.BuildPayloadAttributes(
+		data.Timestamp,
+		data.PayloadWithdrawals,
+		data.PrevRandao,
+		data.ParentBlockRoot,
+		data.ParentProposerPubkey,
+	)
+	if err != nil {
+		p.logger.Warn(
+			"Failed to build payload attributes for event",
+			"slot", data.Slot.Base10(), "error", err,
+		)
+		return
+	}
+
+	withdrawals := make([]*Withdrawal, len(attrs.Withdrawals))
+	for i, w := range attrs.Withdrawals {
+		withdrawals[i] = withdrawalFromEngine(w)
+	}
+
+	p.broker.Publish(TopicPayloadAttributes, &PayloadAttributesEvent{
+		Version: version.Name(p.chainSpec.ActiveForkVersionForTimestamp(data.Timestamp)),
+		Data: &PayloadAttributesData{
+			ProposalSlot:      data.Slot.Base10(),
+			ParentBlockNumber: parentBlockNumber.Base10(),
+			ParentBlockRoot:   data.ParentBlockRoot,
+			ParentBlockHash:   data.HeadEth1BlockHash.Hex(),
+			PayloadAttributes: &PayloadAttributes{
+				Timestamp:             attrs.Timestamp.Base10(),
+				PrevRandao:            attrs.PrevRandao.String(),
+				SuggestedFeeRecipient: attrs.SuggestedFeeRecipient.String(),
+				Withdrawals:           withdrawals,
+				ParentBeaconBlockRoot: attrs.ParentBeaconBlockRoot,
+			},
+		},
+	})
+}
+
+// dutyDependentRoots returns the block roots at the last slot of the epochs
+// preceding epoch-1 and epoch respectively, clamped to the genesis block.
+func (p *Publisher) dutyDependentRoots(
+	st *statedb.StateDB,
+	epoch math.Epoch,
+) (common.Root, common.Root, error) {
+	var (
+		slotsPerEpoch = p.chainSpec.SlotsPerEpoch()
+		currStart     = epoch.Unwrap() * slotsPerEpoch
+		prevStart     = uint64(0)
+	)
+	if currStart >= slotsPerEpoch {
+		prevStart = currStart - slotsPerEpoch
+	}
+
+	prev, err := p.blockRootBefore(st, prevStart)
+	if err != nil {
+		return common.Root{}, common.Root{}, err
+	}
+	curr, err := p.blockRootBefore(st, currStart)
+	if err != nil {
+		return common.Root{}, common.Root{}, err
+	}
+	return prev, curr, nil
+}
+
+// blockRootBefore returns the root of the block preceding slot, or the
+// genesis block root if slot is the genesis slot.
+func (p *Publisher) blockRootBefore(st *statedb.StateDB, slot uint64) (common.Root, error) {
+	if slot > 0 {
+		slot--
+	}
+	return st.GetBlockRootAtIndex(slot % p.chainSpec.SlotsPerHistoricalRoot())
+}
+
+func withdrawalFromEngine(w *engineprimitives.Withdrawal) *Withdrawal {
+	return &Withdrawal{
+		Index:          w.Index.Base10(),
+		ValidatorIndex: w.Validator.Base10(),
+		Address:        w.Address.String(),
+		Amount:         w.Amount.Base10(),
+	}
+}
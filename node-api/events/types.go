@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+)
+
+// HeadData is the payload of the head topic.
+type HeadData struct {
+	Slot                      string      `json:"slot"`
+	Block                     common.Root `json:"block"`
+	State                     common.Root `json:"state"`
+	EpochTransition           bool        `json:"epoch_transition"`
+	PreviousDutyDependentRoot common.Root `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  common.Root `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool        `json:"execution_optimistic"`
+}
+
+// BlockData is the payload of the block topic.
+type BlockData struct {
+	Slot                string      `json:"slot"`
+	Block               common.Root `json:"block"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+// FinalizedCheckpointData is the payload of the finalized_checkpoint topic.
+type FinalizedCheckpointData struct {
+	Block               common.Root `json:"block"`
+	State               common.Root `json:"state"`
+	Epoch               string      `json:"epoch"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+}
+
+// BlobSidecarData is the payload of the blob_sidecar topic.
+type BlobSidecarData struct {
+	BlockRoot     common.Root `json:"block_root"`
+	Index         string      `json:"index"`
+	Slot          string      `json:"slot"`
+	KZGCommitment string      `json:"kzg_commitment"`
+	VersionedHash string      `json:"versioned_hash"`
+}
+
+// PayloadAttributesEvent is the payload of the payload_attributes topic.
+type PayloadAttributesEvent struct {
+	Version string                 `json:"version"`
+	Data    *PayloadAttributesData `json:"data"`
+}
+
+// PayloadAttributesData carries the attributes the next payload will be built
+// with. The proposer_index field of the spec is not populated: proposers are
+// elected by CometBFT and are not known ahead of the proposal.
+type PayloadAttributesData struct {
+	ProposalSlot      string             `json:"proposal_slot"`
+	ParentBlockNumber string             `json:"parent_block_number"`
+	ParentBlockRoot   common.Root        `json:"parent_block_root"`
+	ParentBlockHash   string             `json:"parent_block_hash"`
+	PayloadAttributes *PayloadAttributes `json:"payload_attributes"`
+}
+
+// PayloadAttributes is the spec representation of the engine payload
+// attributes.
+type PayloadAttributes struct {
+	Timestamp             string        `json:"timestamp"`
+	PrevRandao            string        `json:"prev_randao"`
+	SuggestedFeeRecipient string        `json:"suggested_fee_recipient"`
+	Withdrawals           []*Withdrawal `json:"withdrawals"`
+	ParentBeaconBlockRoot common.Root   `json:"parent_beacon_block_root"`
+}
+
+// Withdrawal is the spec representation of a withdrawal.
+type Withdrawal struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validator_index"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+}
@@ -33,6 +33,7 @@ type Backend interface {
 	GenesisBackend
 	BlobBackend
 	BlockBackend
+	LightClientBackend
 	RandaoBackend
 	StateBackend
 	ValidatorBackend
@@ -61,6 +62,14 @@ type BlockBackend interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+	SignedBlockAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlock, error)
+}
+
+type LightClientBackend interface {
+	LightClientBootstrap(root common.Root) (*ctypes.LightClientBootstrap, error)
+	LightClientUpdates(startPeriod math.Epoch, count uint64) ([]*ctypes.LightClientUpdate, error)
+	LightClientFinalityUpdate() (*ctypes.LightClientFinalityUpdate, error)
+	LightClientOptimisticUpdate() (*ctypes.LightClientOptimisticUpdate, error)
 }
 
 type StateBackend interface {
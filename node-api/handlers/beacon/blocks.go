@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// GetBlock returns the signed block identified by the given block ID, either
+// JSON or SSZ encoded.
+func (h *Handler) GetBlock(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	return h.makeBlockResponse(c, req.BlockID, false /*blinded*/)
+}
+
+// GetBlindedBlock returns the signed block identified by the given block ID,
+// with the execution payload replaced by its header, either JSON or SSZ
+// encoded.
+func (h *Handler) GetBlindedBlock(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlindedBlockRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	return h.makeBlockResponse(c, req.BlockID, true /*blinded*/)
+}
+
+func (h *Handler) makeBlockResponse(c handlers.Context, blockID string, blinded bool) (any, error) {
+	slot, err := utils.SlotFromBlockID(blockID, h.backend)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed retrieving slot from block ID %s: %w", handlertypes.ErrNotFound, blockID, err)
+	}
+	blk, err := h.backend.SignedBlockAtSlot(slot)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed retrieving block at slot %d: %w", handlertypes.ErrNotFound, slot, err)
+	}
+	forkName := version.Name(blk.GetForkVersion())
+
+	if utils.WantsSSZ(c) {
+		var bz []byte
+		if blinded {
+			var blindedBlk *ctypes.SignedBlindedBeaconBlock
+			if blindedBlk, err = ctypes.NewSignedBlindedBeaconBlock(blk); err != nil {
+				return nil, err
+			}
+			bz, err = blindedBlk.MarshalSSZ()
+		} else {
+			bz, err = blk.MarshalSSZ()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed encoding block at slot %d: %w", slot, err)
+		}
+		return nil, utils.WriteSSZ(c, forkName, bz)
+	}
+
+	data, err := beacontypes.SignedBeaconBlockFromConsensus(blk, blinded)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting block at slot %d: %w", slot, err)
+	}
+	c.Response().Header().Set(utils.HeaderEthConsensusVersion, forkName)
+	return beacontypes.BlockResponse{
+		Version:         forkName,
+		GenericResponse: beacontypes.NewResponse(data),
+	}, nil
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	beaconecho "github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/mocks"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/version"
+	testutils "github.com/berachain/beacon-kit/testing/utils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlock(t *testing.T) {
+	t.Parallel()
+
+	blk := &types.SignedBeaconBlock{
+		BeaconBlock: testutils.GenerateValidBeaconBlock(t, version.Electra()),
+		Signature:   [96]byte{1, 2, 3},
+	}
+	slot := blk.GetSlot()
+
+	testCases := []struct {
+		name                string
+		blinded             bool
+		accept              string
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, rec *httptest.ResponseRecorder, res any, err error)
+	}{
+		{
+			name: "json",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SignedBlockAtSlot(slot).Return(blk, nil)
+			},
+			check: func(t *testing.T, _ *httptest.ResponseRecorder, res any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				bz, err := json.Marshal(res)
+				require.NoError(t, err)
+
+				var got struct {
+					Version string `json:"version"`
+					Data    struct {
+						Message struct {
+							Slot string `json:"slot"`
+							Body struct {
+								ExecutionPayload map[string]any `json:"execution_payload"`
+							} `json:"body"`
+						} `json:"message"`
+					} `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(bz, &got))
+				require.Equal(t, version.Name(version.Electra()), got.Version)
+				require.Equal(t, slot.Base10(), got.Data.Message.Slot)
+				require.Contains(t, got.Data.Message.Body.ExecutionPayload, "transactions")
+			},
+		},
+		{
+			name:    "json blinded",
+			blinded: true,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SignedBlockAtSlot(slot).Return(blk, nil)
+			},
+			check: func(t *testing.T, _ *httptest.ResponseRecorder, res any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				bz, err := json.Marshal(res)
+				require.NoError(t, err)
+				require.Contains(t, string(bz), "execution_payload_header")
+				require.Contains(t, string(bz), "transactions_root")
+			},
+		},
+		{
+			name:   "ssz",
+			accept: echo.MIMEOctetStream,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SignedBlockAtSlot(slot).Return(blk, nil)
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder, res any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Nil(t, res)
+				expected, err := blk.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, expected, rec.Body.Bytes())
+				require.Equal(t, echo.MIMEOctetStream, rec.Header().Get(echo.HeaderContentType))
+				require.Equal(t, version.Name(version.Electra()), rec.Header().Get(utils.HeaderEthConsensusVersion))
+			},
+		},
+		{
+			name:    "ssz blinded",
+			blinded: true,
+			accept:  echo.MIMEOctetStream,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SignedBlockAtSlot(slot).Return(blk, nil)
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder, _ any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				blindedBlk, err := types.NewSignedBlindedBeaconBlock(blk)
+				require.NoError(t, err)
+				expected, err := blindedBlk.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, expected, rec.Body.Bytes())
+			},
+		},
+		{
+			name: "not found",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().SignedBlockAtSlot(slot).Return(nil, errors.New("block not found"))
+			},
+			check: func(t *testing.T, _ *httptest.ResponseRecorder, _ any, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// setup test
+			backend := mocks.NewBackend(t)
+			h := beacon.NewHandler(backend)
+			h.SetLogger(noop.NewLogger[log.Logger]())
+			e := echo.New()
+			e.Validator = &beaconecho.CustomValidator{
+				Validator: beaconecho.ConstructValidator(),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.accept != "" {
+				req.Header.Set(echo.HeaderAccept, tc.accept)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("block_id")
+			c.SetParamValues(slot.Base10())
+
+			tc.setMockExpectations(backend)
+
+			var (
+				res any
+				err error
+			)
+			if tc.blinded {
+				res, err = h.GetBlindedBlock(c)
+			} else {
+				res, err = h.GetBlock(c)
+			}
+			tc.check(t, rec, res, err)
+		})
+	}
+}
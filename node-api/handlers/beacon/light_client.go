@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/http"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/labstack/echo/v4"
+)
+
+// forkDigestLength is the length of the fork digest prefixing each SSZ
+// encoded light client update.
+const forkDigestLength = 4
+
+// GetLightClientBootstrap returns the light client bootstrap of the block with
+// the given root, either JSON or SSZ encoded.
+func (h *Handler) GetLightClientBootstrap(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetLightClientBootstrapRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	root, err := common.NewRootFromHex(req.BlockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid block root %s: %w", handlertypes.ErrInvalidRequest, req.BlockRoot, err)
+	}
+	bootstrap, err := h.backend.LightClientBootstrap(root)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed building bootstrap of block %s: %w", handlertypes.ErrNotFound, root, err)
+	}
+	return h.makeLightClientResponse(
+		c, bootstrap.Header, bootstrap,
+		beacontypes.LightClientBootstrapFromConsensus(bootstrap),
+	)
+}
+
+// GetLightClientUpdates returns the light client updates of count periods
+// starting at start_period. A period spans the heights signed by one CometBFT
+// validator set, which changes at most once per epoch. The SSZ response is a sequence of updates, each
+// prefixed by its length and fork digest.
+func (h *Handler) GetLightClientUpdates(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetLightClientUpdatesRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	startPeriod, err := math.U64FromString(req.StartPeriod)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid start period %s: %w", handlertypes.ErrInvalidRequest, req.StartPeriod, err)
+	}
+	count, err := math.U64FromString(req.Count)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid count %s: %w", handlertypes.ErrInvalidRequest, req.Count, err)
+	}
+	updates, err := h.backend.LightClientUpdates(startPeriod, count.Unwrap())
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed building updates: %w", handlertypes.ErrNotFound, err)
+	}
+
+	if utils.WantsSSZ(c) {
+		var genesisValidatorsRoot common.Root
+		if genesisValidatorsRoot, err = h.backend.GenesisValidatorsRoot(); err != nil {
+			return nil, err
+		}
+		var bz []byte
+		for _, update := range updates {
+			var chunk []byte
+			if chunk, err = update.MarshalSSZ(); err != nil {
+				return nil, fmt.Errorf("failed encoding update: %w", err)
+			}
+			forkDigest := ctypes.NewForkData(
+				update.AttestedHeader.GetForkVersion(), genesisValidatorsRoot,
+			).HashTreeRoot()
+			bz = binary.LittleEndian.AppendUint64(bz, uint64(forkDigestLength+len(chunk)))
+			bz = append(bz, forkDigest[:forkDigestLength]...)
+			bz = append(bz, chunk...)
+		}
+		return nil, c.Blob(http.StatusOK, echo.MIMEOctetStream, bz)
+	}
+
+	res := make([]beacontypes.LightClientResponse, len(updates))
+	for i, update := range updates {
+		res[i] = beacontypes.LightClientResponse{
+			Version: version.Name(update.AttestedHeader.GetForkVersion()),
+			Data:    beacontypes.LightClientUpdateFromConsensus(update),
+		}
+	}
+	return res, nil
+}
+
+// GetLightClientFinalityUpdate returns the light client finality update of the
+// head block, either JSON or SSZ encoded.
+func (h *Handler) GetLightClientFinalityUpdate(c handlers.Context) (any, error) {
+	update, err := h.backend.LightClientFinalityUpdate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed building finality update: %w", handlertypes.ErrNotFound, err)
+	}
+	return h.makeLightClientResponse(
+		c, update.AttestedHeader, update,
+		beacontypes.LightClientFinalityUpdateFromConsensus(update),
+	)
+}
+
+// GetLightClientOptimisticUpdate returns the light client optimistic update of
+// the head block, either JSON or SSZ encoded.
+func (h *Handler) GetLightClientOptimisticUpdate(c handlers.Context) (any, error) {
+	update, err := h.backend.LightClientOptimisticUpdate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed building optimistic update: %w", handlertypes.ErrNotFound, err)
+	}
+	return h.makeLightClientResponse(
+		c, update.AttestedHeader, update,
+		beacontypes.LightClientOptimisticUpdateFromConsensus(update),
+	)
+}
+
+// makeLightClientResponse encodes the given light client object, whose fork
+// is the one of the given header.
+func (h *Handler) makeLightClientResponse(
+	c handlers.Context,
+	header *ctypes.LightClientHeader,
+	obj interface{ MarshalSSZ() ([]byte, error) },
+	data any,
+) (any, error) {
+	forkName := version.Name(header.GetForkVersion())
+	if utils.WantsSSZ(c) {
+		bz, err := obj.MarshalSSZ()
+		if err != nil {
+			return nil, fmt.Errorf("failed encoding light client data: %w", err)
+		}
+		return nil, utils.WriteSSZ(c, forkName, bz)
+	}
+
+	c.Response().Header().Set(utils.HeaderEthConsensusVersion, forkName)
+	return beacontypes.LightClientResponse{
+		Version: forkName,
+		Data:    data,
+	}, nil
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	beaconecho "github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon"
+	"github.com/berachain/beacon-kit/node-api/handlers/beacon/mocks"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	testutils "github.com/berachain/beacon-kit/testing/utils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLightClientUpdates(t *testing.T) {
+	t.Parallel()
+
+	blk := testutils.GenerateValidBeaconBlock(t, version.Electra())
+	header, err := types.NewLightClientHeader(blk, &types.LightClientCometBFTHeader{
+		Header:   []byte{4},
+		BlockTx:  []byte{5},
+		TxTotal:  2,
+		TxBranch: []common.Root{{6}},
+	})
+	require.NoError(t, err)
+	update := &types.LightClientUpdate{
+		AttestedHeader: header,
+		NextValidators: []*types.LightClientValidator{{Pubkey: [48]byte{1}, VotingPower: 32}},
+		Commit: &types.LightClientCommit{
+			Height:     blk.GetSlot().Unwrap(),
+			Signatures: []*types.CommitSignature{{BlockIDFlag: 2, Signature: [96]byte{2}}},
+		},
+		SignatureSlot: blk.GetSlot() + 1,
+	}
+	genesisValidatorsRoot := common.Root{3}
+
+	testCases := []struct {
+		name                string
+		count               string
+		accept              string
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, rec *httptest.ResponseRecorder, res any, err error)
+	}{
+		{
+			name:  "json",
+			count: "2",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().LightClientUpdates(math.U64(1), uint64(2)).
+					Return([]*types.LightClientUpdate{update, update}, nil)
+			},
+			check: func(t *testing.T, _ *httptest.ResponseRecorder, res any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				bz, err := json.Marshal(res)
+				require.NoError(t, err)
+
+				var got []struct {
+					Version string `json:"version"`
+					Data    struct {
+						AttestedHeader struct {
+							ExecutionBranch []string `json:"execution_branch"`
+							CometBFT        struct {
+								TxTotal  string   `json:"tx_total"`
+								TxBranch []string `json:"tx_branch"`
+							} `json:"cometbft"`
+						} `json:"attested_header"`
+						NextValidators []struct {
+							VotingPower string `json:"voting_power"`
+						} `json:"next_validators"`
+						SignatureSlot string `json:"signature_slot"`
+					} `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(bz, &got))
+				require.Len(t, got, 2)
+				require.Equal(t, version.Name(version.Electra()), got[0].Version)
+				require.Len(t, got[0].Data.AttestedHeader.ExecutionBranch, types.ExecutionBranchDepth)
+				require.Equal(t, "2", got[0].Data.AttestedHeader.CometBFT.TxTotal)
+				require.Equal(t, []string{common.Root{6}.Hex()}, got[0].Data.AttestedHeader.CometBFT.TxBranch)
+				require.Equal(t, "32", got[0].Data.NextValidators[0].VotingPower)
+				require.Equal(t, update.SignatureSlot.Base10(), got[0].Data.SignatureSlot)
+			},
+		},
+		{
+			name:   "ssz",
+			count:  "2",
+			accept: echo.MIMEOctetStream,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().LightClientUpdates(math.U64(1), uint64(2)).
+					Return([]*types.LightClientUpdate{update, update}, nil)
+				b.EXPECT().GenesisValidatorsRoot().Return(genesisValidatorsRoot, nil)
+			},
+			check: func(t *testing.T, rec *httptest.ResponseRecorder, _ any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				expected, err := update.MarshalSSZ()
+				require.NoError(t, err)
+				digest := types.NewForkData(version.Electra(), genesisValidatorsRoot).HashTreeRoot()
+
+				// Each update is prefixed by its length and fork digest.
+				body := rec.Body.Bytes()
+				for range 2 {
+					require.Equal(t, uint64(4+len(expected)), binary.LittleEndian.Uint64(body[:8]))
+					require.Equal(t, digest[:4], body[8:12])
+					require.Equal(t, expected, body[12:12+len(expected)])
+					body = body[12+len(expected):]
+				}
+				require.Empty(t, body)
+			},
+		},
+		{
+			name:  "backend failure",
+			count: "1",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().LightClientUpdates(math.U64(1), uint64(1)).
+					Return(nil, errors.New("block not available"))
+			},
+			check: func(t *testing.T, _ *httptest.ResponseRecorder, _ any, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrNotFound)
+			},
+		},
+		{
+			name:                "invalid count",
+			count:               "two",
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, _ *httptest.ResponseRecorder, _ any, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// setup test
+			backend := mocks.NewBackend(t)
+			h := beacon.NewHandler(backend)
+			h.SetLogger(noop.NewLogger[log.Logger]())
+			e := echo.New()
+			e.Validator = &beaconecho.CustomValidator{
+				Validator: beaconecho.ConstructValidator(),
+			}
+
+			req := httptest.NewRequest(
+				http.MethodGet, "/?start_period=1&count="+tc.count, nil,
+			)
+			if tc.accept != "" {
+				req.Header.Set(echo.HeaderAccept, tc.accept)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			tc.setMockExpectations(backend)
+
+			res, err := h.GetLightClientUpdates(c)
+			tc.check(t, rec, res, err)
+		})
+	}
+}
@@ -604,6 +604,237 @@ func (_c *Backend_GetSlotByStateRoot_Call) RunAndReturn(run func(common.Root) (m
 	return _c
 }
 
+// LightClientBootstrap provides a mock function with given fields: root
+func (_m *Backend) LightClientBootstrap(root common.Root) (*consensus_typestypes.LightClientBootstrap, error) {
+	ret := _m.Called(root)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LightClientBootstrap")
+	}
+
+	var r0 *consensus_typestypes.LightClientBootstrap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Root) (*consensus_typestypes.LightClientBootstrap, error)); ok {
+		return rf(root)
+	}
+	if rf, ok := ret.Get(0).(func(common.Root) *consensus_typestypes.LightClientBootstrap); ok {
+		r0 = rf(root)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*consensus_typestypes.LightClientBootstrap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Root) error); ok {
+		r1 = rf(root)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_LightClientBootstrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LightClientBootstrap'
+type Backend_LightClientBootstrap_Call struct {
+	*mock.Call
+}
+
+// LightClientBootstrap is a helper method to define mock.On call
+//   - root common.Root
+func (_e *Backend_Expecter) LightClientBootstrap(root interface{}) *Backend_LightClientBootstrap_Call {
+	return &Backend_LightClientBootstrap_Call{Call: _e.mock.On("LightClientBootstrap", root)}
+}
+
+func (_c *Backend_LightClientBootstrap_Call) Run(run func(root common.Root)) *Backend_LightClientBootstrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Root))
+	})
+	return _c
+}
+
+func (_c *Backend_LightClientBootstrap_Call) Return(_a0 *consensus_typestypes.LightClientBootstrap, _a1 error) *Backend_LightClientBootstrap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_LightClientBootstrap_Call) RunAndReturn(run func(common.Root) (*consensus_typestypes.LightClientBootstrap, error)) *Backend_LightClientBootstrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LightClientFinalityUpdate provides a mock function with given fields:
+func (_m *Backend) LightClientFinalityUpdate() (*consensus_typestypes.LightClientFinalityUpdate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LightClientFinalityUpdate")
+	}
+
+	var r0 *consensus_typestypes.LightClientFinalityUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*consensus_typestypes.LightClientFinalityUpdate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *consensus_typestypes.LightClientFinalityUpdate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*consensus_typestypes.LightClientFinalityUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_LightClientFinalityUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LightClientFinalityUpdate'
+type Backend_LightClientFinalityUpdate_Call struct {
+	*mock.Call
+}
+
+// LightClientFinalityUpdate is a helper method to define mock.On call
+func (_e *Backend_Expecter) LightClientFinalityUpdate() *Backend_LightClientFinalityUpdate_Call {
+	return &Backend_LightClientFinalityUpdate_Call{Call: _e.mock.On("LightClientFinalityUpdate")}
+}
+
+func (_c *Backend_LightClientFinalityUpdate_Call) Run(run func()) *Backend_LightClientFinalityUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Backend_LightClientFinalityUpdate_Call) Return(_a0 *consensus_typestypes.LightClientFinalityUpdate, _a1 error) *Backend_LightClientFinalityUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_LightClientFinalityUpdate_Call) RunAndReturn(run func() (*consensus_typestypes.LightClientFinalityUpdate, error)) *Backend_LightClientFinalityUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LightClientOptimisticUpdate provides a mock function with given fields:
+func (_m *Backend) LightClientOptimisticUpdate() (*consensus_typestypes.LightClientOptimisticUpdate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LightClientOptimisticUpdate")
+	}
+
+	var r0 *consensus_typestypes.LightClientOptimisticUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*consensus_typestypes.LightClientOptimisticUpdate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *consensus_typestypes.LightClientOptimisticUpdate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*consensus_typestypes.LightClientOptimisticUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_LightClientOptimisticUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LightClientOptimisticUpdate'
+type Backend_LightClientOptimisticUpdate_Call struct {
+	*mock.Call
+}
+
+// LightClientOptimisticUpdate is a helper method to define mock.On call
+func (_e *Backend_Expecter) LightClientOptimisticUpdate() *Backend_LightClientOptimisticUpdate_Call {
+	return &Backend_LightClientOptimisticUpdate_Call{Call: _e.mock.On("LightClientOptimisticUpdate")}
+}
+
+func (_c *Backend_LightClientOptimisticUpdate_Call) Run(run func()) *Backend_LightClientOptimisticUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Backend_LightClientOptimisticUpdate_Call) Return(_a0 *consensus_typestypes.LightClientOptimisticUpdate, _a1 error) *Backend_LightClientOptimisticUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_LightClientOptimisticUpdate_Call) RunAndReturn(run func() (*consensus_typestypes.LightClientOptimisticUpdate, error)) *Backend_LightClientOptimisticUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LightClientUpdates provides a mock function with given fields: startPeriod, count
+func (_m *Backend) LightClientUpdates(startPeriod math.U64, count uint64) ([]*consensus_typestypes.LightClientUpdate, error) {
+	ret := _m.Called(startPeriod, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LightClientUpdates")
+	}
+
+	var r0 []*consensus_typestypes.LightClientUpdate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64, uint64) ([]*consensus_typestypes.LightClientUpdate, error)); ok {
+		return rf(startPeriod, count)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64, uint64) []*consensus_typestypes.LightClientUpdate); ok {
+		r0 = rf(startPeriod, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*consensus_typestypes.LightClientUpdate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64, uint64) error); ok {
+		r1 = rf(startPeriod, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_LightClientUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LightClientUpdates'
+type Backend_LightClientUpdates_Call struct {
+	*mock.Call
+}
+
+// LightClientUpdates is a helper method to define mock.On call
+//   - startPeriod math.U64
+//   - count uint64
+func (_e *Backend_Expecter) LightClientUpdates(startPeriod interface{}, count interface{}) *Backend_LightClientUpdates_Call {
+	return &Backend_LightClientUpdates_Call{Call: _e.mock.On("LightClientUpdates", startPeriod, count)}
+}
+
+func (_c *Backend_LightClientUpdates_Call) Run(run func(startPeriod math.U64, count uint64)) *Backend_LightClientUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *Backend_LightClientUpdates_Call) Return(_a0 []*consensus_typestypes.LightClientUpdate, _a1 error) *Backend_LightClientUpdates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_LightClientUpdates_Call) RunAndReturn(run func(math.U64, uint64) ([]*consensus_typestypes.LightClientUpdate, error)) *Backend_LightClientUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PendingPartialWithdrawalsAtState provides a mock function with given fields: _a0
 func (_m *Backend) PendingPartialWithdrawalsAtState(_a0 *state.StateDB) ([]*types.PendingPartialWithdrawalData, error) {
 	ret := _m.Called(_a0)
@@ -721,6 +952,64 @@ func (_c *Backend_RandaoAtEpoch_Call) RunAndReturn(run func(math.U64, math.U64)
 	return _c
 }
 
+// SignedBlockAtSlot provides a mock function with given fields: slot
+func (_m *Backend) SignedBlockAtSlot(slot math.U64) (*consensus_typestypes.SignedBeaconBlock, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignedBlockAtSlot")
+	}
+
+	var r0 *consensus_typestypes.SignedBeaconBlock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (*consensus_typestypes.SignedBeaconBlock, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) *consensus_typestypes.SignedBeaconBlock); ok {
+		r0 = rf(slot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*consensus_typestypes.SignedBeaconBlock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_SignedBlockAtSlot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignedBlockAtSlot'
+type Backend_SignedBlockAtSlot_Call struct {
+	*mock.Call
+}
+
+// SignedBlockAtSlot is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *Backend_Expecter) SignedBlockAtSlot(slot interface{}) *Backend_SignedBlockAtSlot_Call {
+	return &Backend_SignedBlockAtSlot_Call{Call: _e.mock.On("SignedBlockAtSlot", slot)}
+}
+
+func (_c *Backend_SignedBlockAtSlot_Call) Run(run func(slot math.U64)) *Backend_SignedBlockAtSlot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *Backend_SignedBlockAtSlot_Call) Return(_a0 *consensus_typestypes.SignedBeaconBlock, _a1 error) *Backend_SignedBlockAtSlot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_SignedBlockAtSlot_Call) RunAndReturn(run func(math.U64) (*consensus_typestypes.SignedBeaconBlock, error)) *Backend_SignedBlockAtSlot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StateAtSlot provides a mock function with given fields: slot
 func (_m *Backend) StateAtSlot(slot math.U64) (*state.StateDB, math.U64, error) {
 	ret := _m.Called(slot)
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// PostSyncCommitteeRewards returns the sync committee rewards of the block
+// identified by the given block ID. BeaconKit has no sync committee, so there
+// are never any rewards.
+func (h *Handler) PostSyncCommitteeRewards(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.PostRewardsSyncCommitteeRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	if _, err = utils.SlotFromBlockID(req.BlockID, h.backend); err != nil {
+		return nil, fmt.Errorf("%w: failed retrieving slot from block ID %s: %w", handlertypes.ErrNotFound, req.BlockID, err)
+	}
+	return beacontypes.NewResponse([]*beacontypes.SyncCommitteeRewardData{}), nil
+}
+
+// PostAttestationsRewards returns the attestations rewards of the given epoch.
+// BeaconKit has no attestations, so there are never any rewards.
+func (h *Handler) PostAttestationsRewards(c handlers.Context) (any, error) {
+	if _, err := utils.BindAndValidate[beacontypes.PostAttestationsRewardsRequest](c, h.Logger()); err != nil {
+		return nil, err
+	}
+	return beacontypes.NewResponse(&beacontypes.AttestationsRewardsData{
+		IdealRewards: []any{},
+		TotalRewards: []any{},
+	}), nil
+}
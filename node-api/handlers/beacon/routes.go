@@ -120,12 +120,12 @@ func (h *Handler) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodPost,
-			Path:    "/eth/v1/beacon/blocks/blinded_blocks",
+			Path:    "/eth/v1/beacon/blinded_blocks",
 			Handler: h.Deprecated,
 		},
 		{
 			Method:  http.MethodPost,
-			Path:    "eth/v2/beacon/blocks/blinded_blocks",
+			Path:    "/eth/v2/beacon/blinded_blocks",
 			Handler: h.NotImplemented,
 		},
 		{
@@ -140,8 +140,8 @@ func (h *Handler) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodGet,
-			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v2/beacon/blocks/:block_id",
+			Handler: h.GetBlock,
 		},
 		{
 			Method:  http.MethodGet,
@@ -166,7 +166,7 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/rewards/sync_committee/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.PostSyncCommitteeRewards,
 		},
 		{
 			Method:  http.MethodGet,
@@ -181,32 +181,32 @@ func (h *Handler) RegisterRoutes(
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/rewards/attestations/:epoch",
-			Handler: h.NotImplemented,
+			Handler: h.PostAttestationsRewards,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blinded_blocks/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlindedBlock,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/bootstrap/:block_root",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientBootstrap,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/updates",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientUpdates,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/finality_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientFinalityUpdate,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/light_client/optimistic_update",
-			Handler: h.NotImplemented,
+			Handler: h.GetLightClientOptimisticUpdate,
 		},
 		{
 			Method:  http.MethodGet,
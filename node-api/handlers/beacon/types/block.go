@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// The types below are the beacon API spec representation of a beacon block.
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getBlockV2
+
+type SignedBeaconBlock struct {
+	Message   *BeaconBlock `json:"message"`
+	Signature string       `json:"signature"`
+}
+
+type BeaconBlock struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	Body          any    `json:"body"`
+}
+
+// BeaconBlockBody is the body of a beacon block. Fields which are unused by
+// BeaconKit are always served empty.
+type BeaconBlockBody struct {
+	beaconBlockBodyCommon
+	ExecutionPayload  *ExecutionPayload  `json:"execution_payload"`
+	ExecutionRequests *ExecutionRequests `json:"execution_requests,omitempty"`
+}
+
+// BlindedBeaconBlockBody is the body of a beacon block whose execution
+// payload is replaced by the payload header.
+type BlindedBeaconBlockBody struct {
+	beaconBlockBodyCommon
+	ExecutionPayloadHeader *ExecutionPayloadHeader `json:"execution_payload_header"`
+	ExecutionRequests      *ExecutionRequests      `json:"execution_requests,omitempty"`
+}
+
+type beaconBlockBodyCommon struct {
+	RandaoReveal          string         `json:"randao_reveal"`
+	Eth1Data              *Eth1Data      `json:"eth1_data"`
+	Graffiti              string         `json:"graffiti"`
+	ProposerSlashings     []any          `json:"proposer_slashings"`
+	AttesterSlashings     []any          `json:"attester_slashings"`
+	Attestations          []any          `json:"attestations"`
+	Deposits              []*Deposit     `json:"deposits"`
+	VoluntaryExits        []any          `json:"voluntary_exits"`
+	SyncAggregate         *SyncAggregate `json:"sync_aggregate"`
+	BLSToExecutionChanges []any          `json:"bls_to_execution_changes"`
+	BlobKZGCommitments    []string       `json:"blob_kzg_commitments"`
+}
+
+type Eth1Data struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+type Deposit struct {
+	Proof []string     `json:"proof"`
+	Data  *DepositData `json:"data"`
+}
+
+type DepositData struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+type SyncAggregate struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits"`
+	SyncCommitteeSignature string `json:"sync_committee_signature"`
+}
+
+type executionPayloadCommon struct {
+	ParentHash    string `json:"parent_hash"`
+	FeeRecipient  string `json:"fee_recipient"`
+	StateRoot     string `json:"state_root"`
+	ReceiptsRoot  string `json:"receipts_root"`
+	LogsBloom     string `json:"logs_bloom"`
+	PrevRandao    string `json:"prev_randao"`
+	BlockNumber   string `json:"block_number"`
+	GasLimit      string `json:"gas_limit"`
+	GasUsed       string `json:"gas_used"`
+	Timestamp     string `json:"timestamp"`
+	ExtraData     string `json:"extra_data"`
+	BaseFeePerGas string `json:"base_fee_per_gas"`
+	BlockHash     string `json:"block_hash"`
+}
+
+type ExecutionPayload struct {
+	executionPayloadCommon
+	Transactions  []string      `json:"transactions"`
+	Withdrawals   []*Withdrawal `json:"withdrawals"`
+	BlobGasUsed   string        `json:"blob_gas_used"`
+	ExcessBlobGas string        `json:"excess_blob_gas"`
+}
+
+type ExecutionPayloadHeader struct {
+	executionPayloadCommon
+	TransactionsRoot string `json:"transactions_root"`
+	WithdrawalsRoot  string `json:"withdrawals_root"`
+	BlobGasUsed      string `json:"blob_gas_used"`
+	ExcessBlobGas    string `json:"excess_blob_gas"`
+}
+
+type Withdrawal struct {
+	Index          string `json:"index"`
+	ValidatorIndex string `json:"validator_index"`
+	Address        string `json:"address"`
+	Amount         string `json:"amount"`
+}
+
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `json:"deposits"`
+	Withdrawals    []*WithdrawalRequest    `json:"withdrawals"`
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+type DepositRequest struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+	Index                 string `json:"index"`
+}
+
+type WithdrawalRequest struct {
+	SourceAddress   string `json:"source_address"`
+	ValidatorPubkey string `json:"validator_pubkey"`
+	Amount          string `json:"amount"`
+}
+
+type ConsolidationRequest struct {
+	SourceAddress string `json:"source_address"`
+	SourcePubkey  string `json:"source_pubkey"`
+	TargetPubkey  string `json:"target_pubkey"`
+}
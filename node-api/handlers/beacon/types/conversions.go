@@ -21,14 +21,19 @@
 package types
 
 import (
+	"encoding"
 	"fmt"
 	"strconv"
 
 	"github.com/berachain/beacon-kit/cli/utils/parser"
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/encoding/hex"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 func BeaconBlockHeaderFromConsensus(h *ctypes.BeaconBlockHeader) *BeaconBlockHeader {
@@ -117,3 +122,447 @@ func ValidatorToConsensus(v *Validator) (*ctypes.Validator, error) {
 		WithdrawableEpoch:          we,
 	}, nil
 }
+
+// SignedBeaconBlockFromConsensus converts a signed block into its spec
+// representation. If blinded is set, the execution payload is replaced by its
+// header.
+func SignedBeaconBlockFromConsensus(
+	blk *ctypes.SignedBeaconBlock, blinded bool,
+) (*SignedBeaconBlock, error) {
+	body, err := beaconBlockBodyFromConsensus(blk.GetBody(), blinded)
+	if err != nil {
+		return nil, err
+	}
+	signature := blk.GetSignature()
+	return &SignedBeaconBlock{
+		Message: &BeaconBlock{
+			Slot:          blk.GetSlot().Base10(),
+			ProposerIndex: blk.GetProposerIndex().Base10(),
+			ParentRoot:    blk.GetParentBlockRoot().Hex(),
+			StateRoot:     blk.GetStateRoot().Hex(),
+			Body:          body,
+		},
+		Signature: hex.EncodeBytes(signature[:]),
+	}, nil
+}
+
+// SignedBlindedBeaconBlockFromConsensus converts a signed blinded block into
+// its spec representation.
+func SignedBlindedBeaconBlockFromConsensus(
+	blk *ctypes.SignedBlindedBeaconBlock,
+) (*SignedBeaconBlock, error) {
+	body := blk.Message.Body
+	requests, err := executionRequestsFromBody(body.GetBeaconBlockBody())
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBeaconBlock{
+		Message: &BeaconBlock{
+			Slot:          blk.Message.Slot.Base10(),
+			ProposerIndex: blk.Message.ProposerIndex.Base10(),
+			ParentRoot:    blk.Message.ParentRoot.Hex(),
+			StateRoot:     blk.Message.StateRoot.Hex(),
+			Body: &BlindedBeaconBlockBody{
+				beaconBlockBodyCommon:  beaconBlockBodyCommonFromConsensus(body.GetBeaconBlockBody()),
+				ExecutionPayloadHeader: executionPayloadHeaderFromConsensus(body.ExecutionPayloadHeader),
+				ExecutionRequests:      requests,
+			},
+		},
+		Signature: hex.EncodeBytes(blk.Signature[:]),
+	}, nil
+}
+
+func beaconBlockBodyFromConsensus(b *ctypes.BeaconBlockBody, blinded bool) (any, error) {
+	requests, err := executionRequestsFromBody(b)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := b.GetExecutionPayload()
+	if !blinded {
+		return &BeaconBlockBody{
+			beaconBlockBodyCommon: beaconBlockBodyCommonFromConsensus(b),
+			ExecutionPayload:      executionPayloadFromConsensus(payload),
+			ExecutionRequests:     requests,
+		}, nil
+	}
+
+	header, err := payload.ToHeader()
+	if err != nil {
+		return nil, fmt.Errorf("failed building execution payload header: %w", err)
+	}
+	return &BlindedBeaconBlockBody{
+		beaconBlockBodyCommon:  beaconBlockBodyCommonFromConsensus(b),
+		ExecutionPayloadHeader: executionPayloadHeaderFromConsensus(header),
+		ExecutionRequests:      requests,
+	}, nil
+}
+
+func beaconBlockBodyCommonFromConsensus(b *ctypes.BeaconBlockBody) beaconBlockBodyCommon {
+	deposits := make([]*Deposit, len(b.GetDeposits()))
+	for i, d := range b.GetDeposits() {
+		deposits[i] = &Deposit{
+			// BeaconKit deposits are not proven against the deposit
+			// contract tree, so no proof is available.
+			Proof: []string{},
+			Data: &DepositData{
+				Pubkey:                d.GetPubkey().String(),
+				WithdrawalCredentials: hex.EncodeBytes(d.Credentials[:]),
+				Amount:                d.GetAmount().Base10(),
+				Signature:             hex.EncodeBytes(d.Signature[:]),
+			},
+		}
+	}
+	commitments := make([]string, len(b.GetBlobKzgCommitments()))
+	for i, c := range b.GetBlobKzgCommitments() {
+		commitments[i] = hex.EncodeBytes(c[:])
+	}
+	syncAggregate := b.GetSyncAggregate()
+	eth1Data := b.GetEth1Data()
+	graffiti := b.GetGraffiti()
+	randaoReveal := b.GetRandaoReveal()
+	return beaconBlockBodyCommon{
+		RandaoReveal: hex.EncodeBytes(randaoReveal[:]),
+		Eth1Data: &Eth1Data{
+			DepositRoot:  eth1Data.DepositRoot.Hex(),
+			DepositCount: eth1Data.DepositCount.Base10(),
+			BlockHash:    eth1Data.BlockHash.Hex(),
+		},
+		Graffiti:          hex.EncodeBytes(graffiti[:]),
+		ProposerSlashings: []any{},
+		AttesterSlashings: []any{},
+		Attestations:      []any{},
+		Deposits:          deposits,
+		VoluntaryExits:    []any{},
+		SyncAggregate: &SyncAggregate{
+			SyncCommitteeBits:      hex.EncodeBytes(syncAggregate.SyncCommitteeBits[:]),
+			SyncCommitteeSignature: hex.EncodeBytes(syncAggregate.SyncCommitteeSignature[:]),
+		},
+		BLSToExecutionChanges: []any{},
+		BlobKZGCommitments:    commitments,
+	}
+}
+
+// executionRequestsFromBody returns the spec representation of the execution
+// requests of the body, or nil before Electra.
+func executionRequestsFromBody(b *ctypes.BeaconBlockBody) (*ExecutionRequests, error) {
+	if !version.EqualsOrIsAfter(b.GetForkVersion(), version.Electra()) {
+		return nil, nil
+	}
+	er, err := b.GetExecutionRequests()
+	if err != nil {
+		return nil, err
+	}
+	return executionRequestsFromConsensus(er), nil
+}
+
+func executionPayloadHeaderFromConsensus(h *ctypes.ExecutionPayloadHeader) *ExecutionPayloadHeader {
+	return &ExecutionPayloadHeader{
+		executionPayloadCommon: executionPayloadCommonFromConsensus(
+			h.ParentHash[:], h.FeeRecipient[:], h.StateRoot[:],
+			h.ReceiptsRoot[:], h.LogsBloom[:], h.Random[:],
+			h.Number, h.GasLimit, h.GasUsed, h.Timestamp,
+			h.ExtraData, h.BaseFeePerGas, h.BlockHash[:],
+		),
+		TransactionsRoot: h.TransactionsRoot.Hex(),
+		WithdrawalsRoot:  h.WithdrawalsRoot.Hex(),
+		BlobGasUsed:      h.BlobGasUsed.Base10(),
+		ExcessBlobGas:    h.ExcessBlobGas.Base10(),
+	}
+}
+
+func executionPayloadFromConsensus(p *ctypes.ExecutionPayload) *ExecutionPayload {
+	txs := make([]string, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		txs[i] = hex.EncodeBytes(tx)
+	}
+	withdrawals := make([]*Withdrawal, len(p.Withdrawals))
+	for i, w := range p.Withdrawals {
+		withdrawals[i] = WithdrawalFromEngine(w)
+	}
+	return &ExecutionPayload{
+		executionPayloadCommon: executionPayloadCommonFromConsensus(
+			p.ParentHash[:], p.FeeRecipient[:], p.StateRoot[:],
+			p.ReceiptsRoot[:], p.LogsBloom[:], p.Random[:],
+			p.Number, p.GasLimit, p.GasUsed, p.Timestamp,
+			p.ExtraData, p.BaseFeePerGas, p.BlockHash[:],
+		),
+		Transactions:  txs,
+		Withdrawals:   withdrawals,
+		BlobGasUsed:   p.BlobGasUsed.Base10(),
+		ExcessBlobGas: p.ExcessBlobGas.Base10(),
+	}
+}
+
+//nolint:revive // mirrors the payload fields shared by payload and header.
+func executionPayloadCommonFromConsensus(
+	parentHash, feeRecipient, stateRoot, receiptsRoot, logsBloom, prevRandao []byte,
+	number, gasLimit, gasUsed, timestamp math.U64,
+	extraData []byte, baseFeePerGas *math.U256, blockHash []byte,
+) executionPayloadCommon {
+	baseFee := "0"
+	if baseFeePerGas != nil {
+		baseFee = baseFeePerGas.Dec()
+	}
+	return executionPayloadCommon{
+		ParentHash:    hex.EncodeBytes(parentHash),
+		FeeRecipient:  hex.EncodeBytes(feeRecipient),
+		StateRoot:     hex.EncodeBytes(stateRoot),
+		ReceiptsRoot:  hex.EncodeBytes(receiptsRoot),
+		LogsBloom:     hex.EncodeBytes(logsBloom),
+		PrevRandao:    hex.EncodeBytes(prevRandao),
+		BlockNumber:   number.Base10(),
+		GasLimit:      gasLimit.Base10(),
+		GasUsed:       gasUsed.Base10(),
+		Timestamp:     timestamp.Base10(),
+		ExtraData:     hex.EncodeBytes(extraData),
+		BaseFeePerGas: baseFee,
+		BlockHash:     hex.EncodeBytes(blockHash),
+	}
+}
+
+// WithdrawalFromEngine converts a withdrawal into its spec representation.
+func WithdrawalFromEngine(w *engineprimitives.Withdrawal) *Withdrawal {
+	return &Withdrawal{
+		Index:          w.Index.Base10(),
+		ValidatorIndex: w.Validator.Base10(),
+		Address:        hex.EncodeBytes(w.Address[:]),
+		Amount:         w.Amount.Base10(),
+	}
+}
+
+func executionRequestsFromConsensus(er *ctypes.ExecutionRequests) *ExecutionRequests {
+	res := &ExecutionRequests{
+		Deposits:       make([]*DepositRequest, len(er.Deposits)),
+		Withdrawals:    make([]*WithdrawalRequest, len(er.Withdrawals)),
+		Consolidations: make([]*ConsolidationRequest, len(er.Consolidations)),
+	}
+	for i, d := range er.Deposits {
+		res.Deposits[i] = &DepositRequest{
+			Pubkey:                d.GetPubkey().String(),
+			WithdrawalCredentials: hex.EncodeBytes(d.Credentials[:]),
+			Amount:                d.GetAmount().Base10(),
+			Signature:             hex.EncodeBytes(d.Signature[:]),
+			Index:                 d.GetIndex().Base10(),
+		}
+	}
+	for i, w := range er.Withdrawals {
+		res.Withdrawals[i] = &WithdrawalRequest{
+			SourceAddress:   hex.EncodeBytes(w.SourceAddress[:]),
+			ValidatorPubkey: w.ValidatorPubKey.String(),
+			Amount:          w.Amount.Base10(),
+		}
+	}
+	for i, c := range er.Consolidations {
+		res.Consolidations[i] = &ConsolidationRequest{
+			SourceAddress: hex.EncodeBytes(c.SourceAddress[:]),
+			SourcePubkey:  c.SourcePubKey.String(),
+			TargetPubkey:  c.TargetPubKey.String(),
+		}
+	}
+	return res
+}
+
+// ExecutionPayloadHeaderToConsensus converts the spec representation of an
+// execution payload header of the given fork version into its consensus type.
+func ExecutionPayloadHeaderToConsensus(
+	h *ExecutionPayloadHeader, forkVersion common.Version,
+) (*ctypes.ExecutionPayloadHeader, error) {
+	if h == nil {
+		return nil, ctypes.ErrNilPayloadHeader
+	}
+	header := ctypes.NewEmptyExecutionPayloadHeaderWithVersion(forkVersion)
+	d := &specDecoder{}
+	d.executionPayloadCommon(&h.executionPayloadCommon, &executionPayloadFields{
+		ParentHash: &header.ParentHash, FeeRecipient: &header.FeeRecipient,
+		StateRoot: &header.StateRoot, ReceiptsRoot: &header.ReceiptsRoot,
+		LogsBloom: &header.LogsBloom, PrevRandao: &header.Random,
+		Number: &header.Number, GasLimit: &header.GasLimit,
+		GasUsed: &header.GasUsed, Timestamp: &header.Timestamp,
+		ExtraData: &header.ExtraData, BaseFeePerGas: &header.BaseFeePerGas,
+		BlockHash: &header.BlockHash,
+	})
+	d.text("transactions_root", h.TransactionsRoot, &header.TransactionsRoot)
+	d.text("withdrawals_root", h.WithdrawalsRoot, &header.WithdrawalsRoot)
+	header.BlobGasUsed = d.u64("blob_gas_used", h.BlobGasUsed)
+	header.ExcessBlobGas = d.u64("excess_blob_gas", h.ExcessBlobGas)
+	if d.err != nil {
+		return nil, d.err
+	}
+	return header, nil
+}
+
+// ExecutionPayloadToConsensus converts the spec representation of an
+// execution payload of the given fork version into its consensus type.
+func ExecutionPayloadToConsensus(
+	p *ExecutionPayload, forkVersion common.Version,
+) (*ctypes.ExecutionPayload, error) {
+	if p == nil {
+		return nil, ctypes.ErrNilValue
+	}
+	payload := ctypes.NewEmptyExecutionPayloadWithVersion(forkVersion)
+	d := &specDecoder{}
+	d.executionPayloadCommon(&p.executionPayloadCommon, &executionPayloadFields{
+		ParentHash: &payload.ParentHash, FeeRecipient: &payload.FeeRecipient,
+		StateRoot: &payload.StateRoot, ReceiptsRoot: &payload.ReceiptsRoot,
+		LogsBloom: &payload.LogsBloom, PrevRandao: &payload.Random,
+		Number: &payload.Number, GasLimit: &payload.GasLimit,
+		GasUsed: &payload.GasUsed, Timestamp: &payload.Timestamp,
+		ExtraData: &payload.ExtraData, BaseFeePerGas: &payload.BaseFeePerGas,
+		BlockHash: &payload.BlockHash,
+	})
+	payload.Transactions = make(engineprimitives.Transactions, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		payload.Transactions[i] = d.bytes("transactions", tx)
+	}
+	payload.Withdrawals = make(engineprimitives.Withdrawals, len(p.Withdrawals))
+	for i, w := range p.Withdrawals {
+		withdrawal := &engineprimitives.Withdrawal{
+			Index:     d.u64("withdrawals.index", w.Index),
+			Validator: d.u64("withdrawals.validator_index", w.ValidatorIndex),
+			Amount:    d.u64("withdrawals.amount", w.Amount),
+		}
+		d.text("withdrawals.address", w.Address, &withdrawal.Address)
+		payload.Withdrawals[i] = withdrawal
+	}
+	payload.BlobGasUsed = d.u64("blob_gas_used", p.BlobGasUsed)
+	payload.ExcessBlobGas = d.u64("excess_blob_gas", p.ExcessBlobGas)
+	if d.err != nil {
+		return nil, d.err
+	}
+	return payload, nil
+}
+
+// ExecutionRequestsToConsensus converts the spec representation of execution
+// requests into their consensus type.
+func ExecutionRequestsToConsensus(er *ExecutionRequests) (*ctypes.ExecutionRequests, error) {
+	if er == nil {
+		return nil, ctypes.ErrNilValue
+	}
+	res := &ctypes.ExecutionRequests{
+		Deposits:       make([]*ctypes.DepositRequest, len(er.Deposits)),
+		Withdrawals:    make([]*ctypes.WithdrawalRequest, len(er.Withdrawals)),
+		Consolidations: make([]*ctypes.ConsolidationRequest, len(er.Consolidations)),
+	}
+	d := &specDecoder{}
+	for i, r := range er.Deposits {
+		deposit := &ctypes.DepositRequest{
+			Amount: d.u64("deposits.amount", r.Amount),
+			Index:  d.u64("deposits.index", r.Index).Unwrap(),
+		}
+		d.text("deposits.pubkey", r.Pubkey, &deposit.Pubkey)
+		d.fixed("deposits.withdrawal_credentials", r.WithdrawalCredentials, deposit.Credentials[:])
+		d.text("deposits.signature", r.Signature, &deposit.Signature)
+		res.Deposits[i] = deposit
+	}
+	for i, r := range er.Withdrawals {
+		withdrawal := &ctypes.WithdrawalRequest{
+			Amount: d.u64("withdrawals.amount", r.Amount),
+		}
+		d.text("withdrawals.source_address", r.SourceAddress, &withdrawal.SourceAddress)
+		d.text("withdrawals.validator_pubkey", r.ValidatorPubkey, &withdrawal.ValidatorPubKey)
+		res.Withdrawals[i] = withdrawal
+	}
+	for i, r := range er.Consolidations {
+		consolidation := &ctypes.ConsolidationRequest{}
+		d.text("consolidations.source_address", r.SourceAddress, &consolidation.SourceAddress)
+		d.text("consolidations.source_pubkey", r.SourcePubkey, &consolidation.SourcePubKey)
+		d.text("consolidations.target_pubkey", r.TargetPubkey, &consolidation.TargetPubKey)
+		res.Consolidations[i] = consolidation
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return res, nil
+}
+
+// executionPayloadFields points to the fields shared by execution payloads
+// and headers.
+type executionPayloadFields struct {
+	ParentHash    *common.ExecutionHash
+	FeeRecipient  *common.ExecutionAddress
+	StateRoot     *common.Bytes32
+	ReceiptsRoot  *common.Bytes32
+	LogsBloom     *bytes.B256
+	PrevRandao    *common.Bytes32
+	Number        *math.U64
+	GasLimit      *math.U64
+	GasUsed       *math.U64
+	Timestamp     *math.U64
+	ExtraData     *bytes.Bytes
+	BaseFeePerGas **math.U256
+	BlockHash     *common.ExecutionHash
+}
+
+// specDecoder decodes fields of the spec representation, retaining the first
+// error encountered.
+type specDecoder struct {
+	err error
+}
+
+func (d *specDecoder) executionPayloadCommon(c *executionPayloadCommon, f *executionPayloadFields) {
+	d.text("parent_hash", c.ParentHash, f.ParentHash)
+	d.text("fee_recipient", c.FeeRecipient, f.FeeRecipient)
+	d.text("state_root", c.StateRoot, f.StateRoot)
+	d.text("receipts_root", c.ReceiptsRoot, f.ReceiptsRoot)
+	d.text("logs_bloom", c.LogsBloom, f.LogsBloom)
+	d.text("prev_randao", c.PrevRandao, f.PrevRandao)
+	*f.Number = d.u64("block_number", c.BlockNumber)
+	*f.GasLimit = d.u64("gas_limit", c.GasLimit)
+	*f.GasUsed = d.u64("gas_used", c.GasUsed)
+	*f.Timestamp = d.u64("timestamp", c.Timestamp)
+	*f.ExtraData = d.bytes("extra_data", c.ExtraData)
+	*f.BaseFeePerGas = d.u256("base_fee_per_gas", c.BaseFeePerGas)
+	d.text("block_hash", c.BlockHash, f.BlockHash)
+}
+
+func (d *specDecoder) text(field, value string, out encoding.TextUnmarshaler) {
+	if d.err != nil {
+		return
+	}
+	if err := out.UnmarshalText([]byte(value)); err != nil {
+		d.err = fmt.Errorf("failed parsing %s: %w", field, err)
+	}
+}
+
+func (d *specDecoder) fixed(field, value string, out []byte) {
+	if d.err != nil {
+		return
+	}
+	if err := hex.DecodeFixedText([]byte(value), out); err != nil {
+		d.err = fmt.Errorf("failed parsing %s: %w", field, err)
+	}
+}
+
+func (d *specDecoder) bytes(field, value string) []byte {
+	if d.err != nil {
+		return nil
+	}
+	bz, err := hex.ToBytes(value)
+	if err != nil {
+		d.err = fmt.Errorf("failed parsing %s: %w", field, err)
+	}
+	return bz
+}
+
+func (d *specDecoder) u64(field, value string) math.U64 {
+	if d.err != nil {
+		return 0
+	}
+	u, err := math.U64FromString(value)
+	if err != nil {
+		d.err = fmt.Errorf("failed parsing %s: %w", field, err)
+	}
+	return u
+}
+
+func (d *specDecoder) u256(field, value string) *math.U256 {
+	u := &math.U256{}
+	if d.err != nil {
+		return u
+	}
+	if err := u.SetFromDecimal(value); err != nil {
+		d.err = fmt.Errorf("failed parsing %s: %w", field, err)
+	}
+	return u
+}
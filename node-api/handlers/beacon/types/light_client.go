@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"strconv"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+)
+
+// The types below are the beacon API representation of the light client
+// data. CometBFT commits and validator sets take the place of the sync
+// aggregates and sync committees of the spec.
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getLightClientBootstrap
+
+type LightClientResponse struct {
+	Version string `json:"version"`
+	Data    any    `json:"data"`
+}
+
+type LightClientHeader struct {
+	Beacon          *BeaconBlockHeader         `json:"beacon"`
+	Execution       *ExecutionPayloadHeader    `json:"execution"`
+	ExecutionBranch []string                   `json:"execution_branch"`
+	CometBFT        *LightClientCometBFTHeader `json:"cometbft"`
+}
+
+type LightClientCometBFTHeader struct {
+	Header   string   `json:"header"`
+	BlockTx  string   `json:"block_tx"`
+	TxIndex  string   `json:"tx_index"`
+	TxTotal  string   `json:"tx_total"`
+	TxBranch []string `json:"tx_branch"`
+}
+
+type LightClientValidator struct {
+	Pubkey      string `json:"pubkey"`
+	VotingPower string `json:"voting_power"`
+}
+
+type CommitSignature struct {
+	BlockIDFlag      string `json:"block_id_flag"`
+	ValidatorAddress string `json:"validator_address"`
+	Timestamp        string `json:"timestamp"`
+	Signature        string `json:"signature"`
+}
+
+type LightClientCommit struct {
+	Height       string             `json:"height"`
+	Round        string             `json:"round"`
+	BlockHash    string             `json:"block_hash"`
+	PartSetTotal string             `json:"part_set_total"`
+	PartSetHash  string             `json:"part_set_hash"`
+	Signatures   []*CommitSignature `json:"signatures"`
+}
+
+type LightClientBootstrap struct {
+	Header            *LightClientHeader      `json:"header"`
+	CurrentValidators []*LightClientValidator `json:"current_validators"`
+}
+
+type LightClientUpdate struct {
+	AttestedHeader *LightClientHeader      `json:"attested_header"`
+	NextValidators []*LightClientValidator `json:"next_validators"`
+	Commit         *LightClientCommit      `json:"commit"`
+	SignatureSlot  string                  `json:"signature_slot"`
+}
+
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *LightClientHeader `json:"attested_header"`
+	FinalizedHeader *LightClientHeader `json:"finalized_header"`
+	Commit          *LightClientCommit `json:"commit"`
+	SignatureSlot   string             `json:"signature_slot"`
+}
+
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *LightClientHeader `json:"attested_header"`
+	Commit         *LightClientCommit `json:"commit"`
+	SignatureSlot  string             `json:"signature_slot"`
+}
+
+func LightClientBootstrapFromConsensus(b *ctypes.LightClientBootstrap) *LightClientBootstrap {
+	return &LightClientBootstrap{
+		Header:            lightClientHeaderFromConsensus(b.Header),
+		CurrentValidators: lightClientValidatorsFromConsensus(b.CurrentValidators),
+	}
+}
+
+func LightClientUpdateFromConsensus(u *ctypes.LightClientUpdate) *LightClientUpdate {
+	return &LightClientUpdate{
+		AttestedHeader: lightClientHeaderFromConsensus(u.AttestedHeader),
+		NextValidators: lightClientValidatorsFromConsensus(u.NextValidators),
+		Commit:         lightClientCommitFromConsensus(u.Commit),
+		SignatureSlot:  u.SignatureSlot.Base10(),
+	}
+}
+
+func LightClientFinalityUpdateFromConsensus(u *ctypes.LightClientFinalityUpdate) *LightClientFinalityUpdate {
+	return &LightClientFinalityUpdate{
+		AttestedHeader:  lightClientHeaderFromConsensus(u.AttestedHeader),
+		FinalizedHeader: lightClientHeaderFromConsensus(u.FinalizedHeader),
+		Commit:          lightClientCommitFromConsensus(u.Commit),
+		SignatureSlot:   u.SignatureSlot.Base10(),
+	}
+}
+
+func LightClientOptimisticUpdateFromConsensus(u *ctypes.LightClientOptimisticUpdate) *LightClientOptimisticUpdate {
+	return &LightClientOptimisticUpdate{
+		AttestedHeader: lightClientHeaderFromConsensus(u.AttestedHeader),
+		Commit:         lightClientCommitFromConsensus(u.Commit),
+		SignatureSlot:  u.SignatureSlot.Base10(),
+	}
+}
+
+func lightClientHeaderFromConsensus(h *ctypes.LightClientHeader) *LightClientHeader {
+	branch := make([]string, len(h.ExecutionBranch))
+	for i, node := range h.ExecutionBranch {
+		branch[i] = node.Hex()
+	}
+	return &LightClientHeader{
+		Beacon:          BeaconBlockHeaderFromConsensus(h.Beacon),
+		Execution:       executionPayloadHeaderFromConsensus(h.Execution),
+		ExecutionBranch: branch,
+		CometBFT:        lightClientCometBFTHeaderFromConsensus(h.CometBFT),
+	}
+}
+
+func lightClientCometBFTHeaderFromConsensus(h *ctypes.LightClientCometBFTHeader) *LightClientCometBFTHeader {
+	branch := make([]string, len(h.TxBranch))
+	for i, node := range h.TxBranch {
+		branch[i] = node.Hex()
+	}
+	return &LightClientCometBFTHeader{
+		Header:   hex.EncodeBytes(h.Header),
+		BlockTx:  hex.EncodeBytes(h.BlockTx),
+		TxIndex:  strconv.FormatUint(h.TxIndex, 10),
+		TxTotal:  strconv.FormatUint(h.TxTotal, 10),
+		TxBranch: branch,
+	}
+}
+
+func lightClientValidatorsFromConsensus(vals []*ctypes.LightClientValidator) []*LightClientValidator {
+	res := make([]*LightClientValidator, len(vals))
+	for i, val := range vals {
+		res[i] = &LightClientValidator{
+			Pubkey:      val.Pubkey.String(),
+			VotingPower: strconv.FormatUint(val.VotingPower, 10),
+		}
+	}
+	return res
+}
+
+func lightClientCommitFromConsensus(c *ctypes.LightClientCommit) *LightClientCommit {
+	sigs := make([]*CommitSignature, len(c.Signatures))
+	for i, sig := range c.Signatures {
+		sigs[i] = &CommitSignature{
+			BlockIDFlag:      strconv.FormatUint(uint64(sig.BlockIDFlag), 10),
+			ValidatorAddress: hex.EncodeBytes(sig.ValidatorAddress[:]),
+			Timestamp:        strconv.FormatUint(sig.Timestamp, 10),
+			Signature:        sig.Signature.String(),
+		}
+	}
+	return &LightClientCommit{
+		Height:       strconv.FormatUint(c.Height, 10),
+		Round:        strconv.FormatUint(uint64(c.Round), 10),
+		BlockHash:    c.BlockHash.Hex(),
+		PartSetTotal: strconv.FormatUint(uint64(c.PartSetTotal), 10),
+		PartSetHash:  c.PartSetHash.Hex(),
+		Signatures:   sigs,
+	}
+}
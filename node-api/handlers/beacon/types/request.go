@@ -177,3 +177,12 @@ type HeadersRequest struct {
 	SlotRequest
 	ParentRoot string `query:"parent_root" validate:"hex"`
 }
+
+type GetLightClientBootstrapRequest struct {
+	BlockRoot string `param:"block_root" validate:"required,hex"`
+}
+
+type GetLightClientUpdatesRequest struct {
+	StartPeriod string `query:"start_period" validate:"required,numeric"`
+	Count       string `query:"count" validate:"required,numeric"`
+}
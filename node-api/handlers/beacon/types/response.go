@@ -21,8 +21,10 @@
 package types
 
 import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/version"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
 )
 
 type GenericResponse struct {
@@ -121,6 +123,9 @@ type CommitteeData struct {
 	Validators []uint64 `json:"validators,string"`
 }
 
+// BlockRewardsData holds the rewards of a block. BeaconKit has no attestation,
+// sync committee or slashing rewards: blocks mint the EVM inflation, while the
+// proposer earns the execution fees paid to its fee recipient.
 type BlockRewardsData struct {
 	ProposerIndex     uint64 `json:"proposer_index,string"`
 	Total             uint64 `json:"total,string"`
@@ -128,6 +133,30 @@ type BlockRewardsData struct {
 	SyncAggregate     uint64 `json:"sync_aggregate,string"`
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
+
+	// The fields below are BeaconKit specific.
+	EVMInflationAddress string           `json:"evm_inflation_address"`
+	EVMInflation        uint64           `json:"evm_inflation,string"`
+	FeeRecipient        string           `json:"fee_recipient"`
+	ExecutionFees       uint64           `json:"execution_fees,string"`
+	BalanceChanges      []*BalanceChange `json:"balance_changes"`
+}
+
+// BalanceChange is the change of a validator balance between the pre-state
+// and the post-state of a block, in Gwei.
+type BalanceChange struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Delta          int64  `json:"delta,string"`
+}
+
+type SyncCommitteeRewardData struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Reward         int64  `json:"reward,string"`
+}
+
+type AttestationsRewardsData struct {
+	IdealRewards []any `json:"ideal_rewards"`
+	TotalRewards []any `json:"total_rewards"`
 }
 
 type Sidecar struct {
@@ -167,3 +196,10 @@ func NewPendingPartialWithdrawalsResponse(
 		GenericResponse: NewResponse(withdrawals),
 	}
 }
+
+// DepositsData is the data of the deposits processed by a beacon state.
+type DepositsData struct {
+	Deposits []*ctypes.Deposit `json:"deposits"`
+	// LastBlock is the EL block that emitted the last deposit, if known.
+	LastBlock *depositstorecommon.Block `json:"last_block,omitempty"`
+}
@@ -26,13 +26,15 @@ import (
 
 type Handler struct {
 	*handlers.BaseHandler
+	backend Backend
 }
 
-func NewHandler() *Handler {
+func NewHandler(backend Backend) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
+		backend: backend,
 	}
 	return h
 }
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	buildertypes "github.com/berachain/beacon-kit/node-api/handlers/builder/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetExpectedWithdrawals returns the withdrawals expected in the payload of
+// the block proposed on top of the requested state, which builders include
+// in the payloads they build.
+func (h *Handler) GetExpectedWithdrawals(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[buildertypes.GetExpectedWithdrawalsRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	var proposalSlot math.Slot
+	if req.ProposalSlot != "" {
+		if proposalSlot, err = math.U64FromString(req.ProposalSlot); err != nil {
+			return nil, fmt.Errorf(
+				"%w: invalid proposal slot %s", handlertypes.ErrInvalidRequest, req.ProposalSlot,
+			)
+		}
+	}
+
+	withdrawals, err := h.backend.ExpectedWithdrawals(slot, proposalSlot)
+	switch {
+	case err == nil:
+	case errors.Is(err, backend.ErrProposalSlotOutOfRange):
+		return nil, fmt.Errorf("%w: %w", handlertypes.ErrInvalidRequest, err)
+	default:
+		return nil, err
+	}
+
+	data := make([]*beacontypes.Withdrawal, len(withdrawals))
+	for i, w := range withdrawals {
+		data[i] = beacontypes.WithdrawalFromEngine(w)
+	}
+	return beacontypes.NewResponse(data), nil
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	beaconecho "github.com/berachain/beacon-kit/node-api/engines/echo"
+	beacontypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/builder"
+	"github.com/berachain/beacon-kit/node-api/handlers/builder/mocks"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExpectedWithdrawals(t *testing.T) {
+	t.Parallel()
+
+	withdrawals := engineprimitives.Withdrawals{
+		engineprimitives.NewWithdrawal(0, 0, common.ExecutionAddress{0xaa}, 1),
+		engineprimitives.NewWithdrawal(1, 2, common.ExecutionAddress{0xbb}, 3),
+	}
+
+	testCases := []struct {
+		name                string
+		stateID             string
+		proposalSlot        string
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, res any, err error)
+	}{
+		{
+			name:    "next slot",
+			stateID: "10",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().ExpectedWithdrawals(math.Slot(10), math.Slot(0)).Return(withdrawals, nil)
+			},
+			check: func(t *testing.T, res any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				require.Equal(t, beacontypes.NewResponse([]*beacontypes.Withdrawal{
+					beacontypes.WithdrawalFromEngine(withdrawals[0]),
+					beacontypes.WithdrawalFromEngine(withdrawals[1]),
+				}), res)
+			},
+		},
+		{
+			name:         "proposal slot",
+			stateID:      common.Root{0x01}.Hex(),
+			proposalSlot: "12",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().GetSlotByStateRoot(common.Root{0x01}).Return(math.Slot(10), nil)
+				b.EXPECT().ExpectedWithdrawals(math.Slot(10), math.Slot(12)).Return(withdrawals, nil)
+			},
+			check: func(t *testing.T, _ any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:                "invalid proposal slot",
+			stateID:             "10",
+			proposalSlot:        "abc",
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, _ any, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:         "proposal slot out of range",
+			stateID:      "10",
+			proposalSlot: "10",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().ExpectedWithdrawals(math.Slot(10), math.Slot(10)).
+					Return(nil, backend.ErrProposalSlotOutOfRange)
+			},
+			check: func(t *testing.T, _ any, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:    "backend failure",
+			stateID: "10",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().ExpectedWithdrawals(math.Slot(10), math.Slot(0)).Return(nil, errors.New("boom"))
+			},
+			check: func(t *testing.T, _ any, err error) {
+				t.Helper()
+				require.Error(t, err)
+				require.NotErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			b := mocks.NewBackend(t)
+			tc.setMockExpectations(b)
+			h := builder.NewHandler(b)
+			h.SetLogger(noop.NewLogger[log.Logger]())
+
+			e := echo.New()
+			e.Validator = &beaconecho.CustomValidator{
+				Validator: beaconecho.ConstructValidator(),
+			}
+			target := "/"
+			if tc.proposalSlot != "" {
+				target = fmt.Sprintf("/?proposal_slot=%s", tc.proposalSlot)
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			c := e.NewContext(req, httptest.NewRecorder())
+			c.SetParamNames("state_id")
+			c.SetParamValues(tc.stateID)
+
+			res, err := h.GetExpectedWithdrawals(c)
+			tc.check(t, res, err)
+		})
+	}
+}
@@ -20,12 +20,22 @@
 
 package config
 
-import "github.com/berachain/beacon-kit/chain"
+import (
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/node-api/handlers/config/types"
+)
 
 type Backend interface {
 	SpecBackend
+	ForkBackend
 }
 
 type SpecBackend interface {
 	Spec() (chain.Spec, error)
 }
+
+type ForkBackend interface {
+	// ForkSchedule returns the forks of the chain, starting from the genesis
+	// fork version.
+	ForkSchedule() ([]*types.ScheduledFork, error)
+}
@@ -20,6 +20,8 @@
 
 package types
 
+import ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+
 type SpecResponse struct {
 	Data SpecData `json:"data"`
 }
@@ -31,3 +33,33 @@ type SpecData struct {
 	InactivityPenaltyQuotient       string `json:"INACTIVITY_PENALTY_QUOTIENT"`
 	InactivityPenaltyQuotientAltair string `json:"INACTIVITY_PENALTY_QUOTIENT_ALTAIR"`
 }
+
+type ForkScheduleResponse struct {
+	Data []ForkData `json:"data"`
+}
+
+type ForkData struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+	// Estimated is set when the epoch is estimated from the target block
+	// time rather than taken from the activation block of the fork.
+	Estimated bool `json:"estimated,omitempty"`
+}
+
+// ScheduledFork is a fork of the fork schedule of the chain.
+type ScheduledFork struct {
+	*ctypes.Fork
+	// Estimated is set when the epoch of the fork is estimated, as is the
+	// case for forks not active yet.
+	Estimated bool
+}
+
+type DepositContractResponse struct {
+	Data DepositContractData `json:"data"`
+}
+
+type DepositContractData struct {
+	ChainID string `json:"chain_id"`
+	Address string `json:"address"`
+}
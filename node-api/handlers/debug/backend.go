@@ -21,13 +21,20 @@
 package debug
 
 import (
+	"context"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
 )
 
 // Backend is the interface for backend of the debug API.
 type Backend interface {
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error)
+	DepositsAtSlot(
+		ctx context.Context, slot math.Slot,
+	) (ctypes.Deposits, *depositstorecommon.Block, error)
 }
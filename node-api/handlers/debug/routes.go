@@ -35,6 +35,11 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 			Path:    "/eth/v2/debug/beacon/states/:state_id",
 			Handler: h.GetState,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/debug/deposits/:state_id",
+			Handler: h.GetDeposits,
+		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v2/debug/beacon/heads",
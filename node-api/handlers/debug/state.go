@@ -55,6 +55,14 @@ func (h *Handler) GetState(c handlers.Context) (any, error) {
 		return nil, err
 	}
 
+	if utils.WantsSSZ(c) {
+		var bz []byte
+		if bz, err = beaconState.MarshalSSZ(); err != nil {
+			return nil, err
+		}
+		return nil, utils.WriteSSZ(c, version.Name(fork.CurrentVersion), bz)
+	}
+
 	return beacontypes.StateResponse{
 		// All data is finalized in CometBFT since we only return data for slots up to head
 		Finalized: true,
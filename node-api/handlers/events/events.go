@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/node-api/events"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	eventstypes "github.com/berachain/beacon-kit/node-api/handlers/events/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/labstack/echo/v4"
+)
+
+// keepAliveInterval is how often a comment line is written to idle streams so
+// that proxies do not time out the connection.
+const keepAliveInterval = 15 * time.Second
+
+// GetEvents streams server-sent events for the requested topics until the
+// client disconnects or falls too far behind and is dropped by the broker.
+//
+// https://ethereum.github.io/beacon-APIs/#/Events/eventstream
+func (h *Handler) GetEvents(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[eventstypes.GetEventsRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+
+	var topics []string
+	for _, t := range req.Topics {
+		for _, topic := range strings.Split(t, ",") {
+			if topic = strings.TrimSpace(topic); topic != "" {
+				topics = append(topics, topic)
+			}
+		}
+	}
+	sub, err := h.broker.Subscribe(topics...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", types.ErrInvalidRequest, err)
+	}
+	defer h.broker.Unsubscribe(sub)
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	ctx := c.Request().Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil //nolint:nilnil // response already written.
+		case <-keepAlive.C:
+			if _, err = fmt.Fprint(w, ":\n\n"); err != nil {
+				return nil, err
+			}
+			w.Flush()
+		case ev, ok := <-sub.Events():
+			if !ok {
+				h.Logger().Warn("Event stream closed by broker", "topics", topics)
+				return nil, nil //nolint:nilnil // response already written.
+			}
+			if err = writeEvent(w, ev); err != nil {
+				return nil, err
+			}
+			w.Flush()
+		}
+	}
+}
+
+// writeEvent writes a single event in the text/event-stream format.
+func writeEvent(w *echo.Response, ev events.Event) error {
+	data, err := json.Marshal(ev.Data)
+	if err != nil {
+		return fmt.Errorf("failed marshaling %s event: %w", ev.Topic, err)
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Topic, data)
+	return err
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/events"
+	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/stretchr/testify/require"
+)
+
+func setupServer(t *testing.T, broker *events.Broker) *httptest.Server {
+	t.Helper()
+	logger := noop.NewLogger[log.Logger]()
+	h := eventsapi.NewHandler(broker)
+	h.RegisterRoutes(logger)
+	engine := echo.NewDefaultEngine()
+	engine.RegisterRoutes(h.RouteSet(), logger)
+	srv := httptest.NewServer(engine)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetEventsStreamsSubscribedTopics(t *testing.T) {
+	t.Parallel()
+	broker := events.NewBroker(noop.NewLogger[log.Logger](), 4)
+	srv := setupServer(t, broker)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, srv.URL+"/eth/v1/events?topics=head,block&topics=finalized_checkpoint", nil,
+	)
+	require.NoError(t, err)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	require.Eventually(t, func() bool {
+		return broker.HasSubscribers(events.TopicFinalizedCheckpoint)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	broker.Publish(events.TopicBlobSidecar, &events.BlobSidecarData{})
+	broker.Publish(events.TopicBlock, &events.BlockData{Slot: "7", Block: common.Root{0x01}})
+
+	reader := bufio.NewReader(res.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "event: block\n", line)
+	line, err = reader.ReadString('\n')
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(line, `data: {"slot":"7","block":"0x01`), line)
+
+	// Closing the connection releases the subscription.
+	cancel()
+	require.Eventually(t, func() bool {
+		return !broker.HasSubscribers(events.TopicBlock)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestGetEventsRejectsUnknownTopics(t *testing.T) {
+	t.Parallel()
+	broker := events.NewBroker(noop.NewLogger[log.Logger](), 4)
+	srv := setupServer(t, broker)
+
+	//nolint:noctx // test request.
+	res, err := http.Get(srv.URL + "/eth/v1/events?topics=head,not_a_topic")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	require.False(t, broker.HasSubscribers(events.TopicHead))
+}
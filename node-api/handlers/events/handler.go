@@ -20,17 +20,22 @@
 
 package events
 
-import "github.com/berachain/beacon-kit/node-api/handlers"
+import (
+	"github.com/berachain/beacon-kit/node-api/events"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+)
 
 type Handler struct {
 	*handlers.BaseHandler
+	broker *events.Broker
 }
 
-func NewHandler() *Handler {
+func NewHandler(broker *events.Broker) *Handler {
 	h := &Handler{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet(""),
 		),
+		broker: broker,
 	}
 	return h
 }
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+)
+
+// Bounds of the custom status code a syncing node may be asked to return.
+const (
+	minStatusCode = 100
+	maxStatusCode = 599
+)
+
+// Syncing returns the sync status of the node.
+func (h *Handler) Syncing(handlers.Context) (any, error) {
+	data, err := h.backend.SyncingData()
+	if err != nil {
+		return nil, err
+	}
+	return types.SyncingResponse{Data: data}, nil
+}
+
+// Health returns the health of the node as a status code with an empty body:
+// 200 if the node is ready, 206 (or the requested syncing_status) if it is
+// syncing and 503 if it is not initialized or its execution client is offline.
+func (h *Handler) Health(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetHealthRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	syncingCode := http.StatusPartialContent
+	if req.SyncingStatus != "" {
+		syncingCode, err = strconv.Atoi(req.SyncingStatus)
+		if err != nil || syncingCode < minStatusCode || syncingCode > maxStatusCode {
+			return nil, fmt.Errorf("%w: invalid syncing_status %s", handlertypes.ErrInvalidRequest, req.SyncingStatus)
+		}
+	}
+
+	code := http.StatusOK
+	data, err := h.backend.SyncingData()
+	switch {
+	case err != nil:
+		h.Logger().Warn("Node health check failed", "error", err)
+		code = http.StatusServiceUnavailable
+	case data.ELOffline:
+		code = http.StatusServiceUnavailable
+	case data.IsSyncing:
+		code = syncingCode
+	}
+	return nil, c.NoContent(code)
+}
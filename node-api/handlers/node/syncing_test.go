@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	beaconecho "github.com/berachain/beacon-kit/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/node-api/handlers/node"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/mocks"
+	"github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func setupHandler(t *testing.T, target string) (*node.Handler, *mocks.Backend, echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	backend := mocks.NewBackend(t)
+	h := node.NewHandler(backend)
+	h.SetLogger(noop.NewLogger[log.Logger]())
+	e := echo.New()
+	e.Validator = &beaconecho.CustomValidator{
+		Validator: beaconecho.ConstructValidator(),
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, target, nil), rec)
+	return h, backend, c, rec
+}
+
+func TestSyncing(t *testing.T) {
+	t.Parallel()
+	h, backend, c, _ := setupHandler(t, "/")
+	backend.EXPECT().SyncingData().Return(&types.SyncingData{
+		HeadSlot:     10,
+		SyncDistance: 5,
+		IsSyncing:    true,
+	}, nil)
+
+	res, err := h.Syncing(c)
+	require.NoError(t, err)
+	bz, err := json.Marshal(res)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{
+		"head_slot":"10",
+		"sync_distance":"5",
+		"is_syncing":true,
+		"is_optimistic":false,
+		"el_offline":false
+	}}`, string(bz))
+}
+
+func TestHealth(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		target   string
+		data     *types.SyncingData
+		err      error
+		expected int
+	}{
+		{
+			name:     "ready",
+			target:   "/",
+			data:     &types.SyncingData{},
+			expected: http.StatusOK,
+		},
+		{
+			name:     "syncing",
+			target:   "/",
+			data:     &types.SyncingData{IsSyncing: true},
+			expected: http.StatusPartialContent,
+		},
+		{
+			name:     "syncing with custom status",
+			target:   "/?syncing_status=200",
+			data:     &types.SyncingData{IsSyncing: true},
+			expected: http.StatusOK,
+		},
+		{
+			name:     "el offline",
+			target:   "/",
+			data:     &types.SyncingData{ELOffline: true},
+			expected: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "not initialized",
+			target:   "/",
+			err:      errors.New("no state"),
+			expected: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			h, backend, c, rec := setupHandler(t, tc.target)
+			backend.EXPECT().SyncingData().Return(tc.data, tc.err)
+
+			res, err := h.Health(c)
+			require.NoError(t, err)
+			require.Nil(t, res)
+			require.Equal(t, tc.expected, rec.Code)
+			require.Empty(t, rec.Body.Bytes())
+		})
+	}
+}
+
+func TestHealthInvalidSyncingStatus(t *testing.T) {
+	t.Parallel()
+	h, _, c, _ := setupHandler(t, "/?syncing_status=1000")
+
+	_, err := h.Health(c)
+	require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+}
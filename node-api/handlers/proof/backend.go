@@ -22,6 +22,7 @@ package proof
 
 import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 )
@@ -31,10 +32,13 @@ type Backend interface {
 	BlockBackend
 	StateBackend
 	GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 }
 
 type BlockBackend interface {
 	BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+	SignedBlockAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlock, error)
 }
 
 type StateBackend interface {
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/merkle"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// ErrInvalidObjectPath is returned when an object path cannot be resolved in
+// the beacon block header schema.
+var ErrInvalidObjectPath = errors.New("invalid object path")
+
+const (
+	// beaconBlockHeaderLeaves is the number of leaves of the beacon block
+	// header tree, padded to the next power of two.
+	beaconBlockHeaderLeaves = 8
+
+	// beaconBlockBodyLeaves is the number of leaves of the beacon block body
+	// tree of all supported forks, padded to the next power of two.
+	beaconBlockBodyLeaves = 16
+
+	// bodyEth1DataPosition and bodyDepositsPosition are the positions of the
+	// eth1 data and of the deposits in the beacon block body.
+	bodyEth1DataPosition = 1
+	bodyDepositsPosition = 6
+)
+
+// ProveObjectPathsInBlock generates a multiproof of the objects at the given
+// paths, resolved against the beacon block header with its state root expanded
+// into the beacon state and, if body is not nil, its body root expanded into
+// the beacon block body. The multiproof is verified against the beacon block
+// root as a sanity check and the beacon block root is returned alongside it.
+//
+// Leaves are 32 bytes chunks. Objects of basic types are packed with their
+// siblings, at the returned offset in the chunk.
+func ProveObjectPathsInBlock(
+	bbh *ctypes.BeaconBlockHeader,
+	bsm types.BeaconStateMarshallable,
+	body *ctypes.BeaconBlockBody,
+	paths []merkle.ObjectPath,
+) ([]*types.ObjectLeaf, []common.Root, common.Root, error) {
+	headerSchema := BeaconBlockHeaderSchema(bsm.GetForkVersion())
+	if body != nil {
+		headerSchema = BeaconBlockSchema(bsm.GetForkVersion())
+	}
+	leaves := make([]*types.ObjectLeaf, len(paths))
+	indices := make([]int, len(paths))
+	for i, path := range paths {
+		_, gIndex, offset, err := path.GetGeneralizedIndex(headerSchema)
+		if err != nil {
+			return nil, nil, common.Root{}, fmt.Errorf("%w %s: %w", ErrInvalidObjectPath, path, err)
+		}
+		leaves[i] = &types.ObjectLeaf{
+			Path:             string(path),
+			GeneralizedIndex: gIndex,
+			Offset:           offset,
+		}
+		indices[i] = int(gIndex) // #nosec G115 -- gindices of the state fit 63 bits.
+	}
+
+	tree, err := beaconBlockTree(bbh, bsm, body)
+	if err != nil {
+		return nil, nil, common.Root{}, err
+	}
+	// Hashing the tree first sets the value of intermediate nodes, which may
+	// be proven leaves.
+	beaconRoot := common.NewRootFromBytes(tree.Hash())
+	if beaconRoot != bbh.HashTreeRoot() {
+		return nil, nil, common.Root{}, errors.Wrapf(
+			errors.New("beacon state does not match the beacon block header"),
+			"beacon root: 0x%s", beaconRoot,
+		)
+	}
+
+	multiproof, err := tree.ProveMulti(indices)
+	if err != nil {
+		return nil, nil, common.Root{}, fmt.Errorf("%w: %w", ErrInvalidObjectPath, err)
+	}
+	for i, leaf := range multiproof.Leaves {
+		leaves[i].Leaf = common.NewRootFromBytes(leaf)
+	}
+	proof := make([]common.Root, len(multiproof.Hashes))
+	for i, hash := range multiproof.Hashes {
+		proof[i] = common.NewRootFromBytes(hash)
+	}
+
+	if ok, errVerify := fastssz.VerifyMultiproof(
+		beaconRoot[:], multiproof.Hashes, multiproof.Leaves, indices,
+	); errVerify != nil || !ok {
+		return nil, nil, common.Root{}, errors.Wrapf(
+			errors.New("object paths multiproof failed to verify against beacon root"),
+			"beacon root: 0x%s", beaconRoot,
+		)
+	}
+	return leaves, proof, beaconRoot, nil
+}
+
+// beaconBlockTree builds the tree of the beacon block header, with the state
+// root leaf replaced by the tree of the beacon state and, if body is not nil,
+// the body root leaf replaced by the tree of the beacon block body.
+func beaconBlockTree(
+	bbh *ctypes.BeaconBlockHeader,
+	bsm types.BeaconStateMarshallable,
+	body *ctypes.BeaconBlockBody,
+) (*fastssz.Node, error) {
+	stateTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	parentRoot, bodyRoot := bbh.GetParentBlockRoot(), bbh.GetBodyRoot()
+	bodyTree := fastssz.LeafFromBytes(bodyRoot[:])
+	if body != nil {
+		if bodyTree, err = beaconBlockBodyTree(body); err != nil {
+			return nil, err
+		}
+	}
+	return fastssz.TreeFromNodes([]*fastssz.Node{
+		fastssz.LeafFromUint64(bbh.GetSlot().Unwrap()),
+		fastssz.LeafFromUint64(bbh.GetProposerIndex().Unwrap()),
+		fastssz.LeafFromBytes(parentRoot[:]),
+		stateTree,
+		bodyTree,
+	}, beaconBlockHeaderLeaves)
+}
+
+// beaconBlockBodyTree builds the tree of the beacon block body from its top
+// level roots, with the eth1 data, deposits and execution payload leaves
+// replaced by their trees.
+func beaconBlockBodyTree(body *ctypes.BeaconBlockBody) (*fastssz.Node, error) {
+	tlrs, err := body.GetTopLevelRoots()
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*fastssz.Node, len(tlrs))
+	for i, root := range tlrs {
+		nodes[i] = fastssz.LeafFromBytes(root[:])
+	}
+	if nodes[bodyEth1DataPosition], err = body.GetEth1Data().GetTree(); err != nil {
+		return nil, err
+	}
+
+	deposits := body.GetDeposits()
+	depositNodes := make([]*fastssz.Node, len(deposits))
+	for i, deposit := range deposits {
+		if depositNodes[i], err = deposit.GetTree(); err != nil {
+			return nil, err
+		}
+	}
+	if nodes[bodyDepositsPosition], err = listTree(depositNodes, constants.MaxDeposits); err != nil {
+		return nil, err
+	}
+
+	// GetTopLevelRoots leaves the KZG commitments root blank.
+	commitments := body.GetBlobKzgCommitments().Leafify()
+	commitmentNodes := make([]*fastssz.Node, len(commitments))
+	for i, root := range commitments {
+		commitmentNodes[i] = fastssz.LeafFromBytes(root[:])
+	}
+	nodes[ctypes.KZGPosition], err = listTree(commitmentNodes, constants.MaxBlobCommitmentsPerBlock)
+	if err != nil {
+		return nil, err
+	}
+	if nodes[ctypes.ExecutionPositionBody], err = body.GetExecutionPayload().GetTree(); err != nil {
+		return nil, err
+	}
+	return fastssz.TreeFromNodes(nodes, beaconBlockBodyLeaves)
+}
+
+// listTree builds the tree of a list of the given elements, mixed in with its
+// length.
+func listTree(nodes []*fastssz.Node, limit uint64) (*fastssz.Node, error) {
+	return fastssz.TreeFromNodesWithMixin(
+		nodes, len(nodes), int(limit), // #nosec G115 -- list limits fit 63 bits.
+	)
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle/mock"
+	prooftypes "github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	mlib "github.com/berachain/beacon-kit/primitives/merkle"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBeaconBlockHeaderSchema tests that the exported schemas agree with the
+// generalized indices used by the dedicated proofs.
+func TestBeaconBlockHeaderSchema(t *testing.T) {
+	t.Parallel()
+
+	_, gIndex, _, err := mlib.ObjectPath(
+		"state_root/validators/0/pubkey",
+	).GetGeneralizedIndex(merkle.BeaconBlockHeaderSchema(version.Deneb1()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(merkle.ZeroValidatorPubkeyGIndexDenebBlock), gIndex)
+
+	_, gIndex, _, err = mlib.ObjectPath(
+		"state_root/validators/0/withdrawal_credentials",
+	).GetGeneralizedIndex(merkle.BeaconBlockHeaderSchema(version.Electra()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(merkle.ZeroValidatorCredentialsGIndexElectraBlock), gIndex)
+
+	_, gIndex, _, err = mlib.ObjectPath(
+		"validators/0/pubkey",
+	).GetGeneralizedIndex(merkle.BeaconStateSchema(version.Electra1()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(merkle.ZeroValidatorPubkeyGIndexElectraState), gIndex)
+}
+
+// TestProveObjectPathsInBlock tests the ProveObjectPathsInBlock function
+// against the dedicated proofs and the values of the state.
+func TestProveObjectPathsInBlock(t *testing.T) {
+	t.Parallel()
+
+	vals := make(types.Validators, 10)
+	for i := range vals {
+		vals[i] = &types.Validator{
+			Pubkey: [48]byte{byte(i)},
+			WithdrawalCredentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{byte(i)},
+			),
+			EffectiveBalance: math.Gwei(32e9 + i),
+		}
+	}
+	bs := mock.NewBeaconStateWith(7, vals, 5, common.ExecutionAddress{1}, version.Electra())
+	bs.Balances = []uint64{10, 11, 12, 13, 14, 15, 16, 17, 18, 19}
+	bs.NextWithdrawalIndex = 42
+	bbh := types.NewBeaconBlockHeader(7, 3, common.Root{1, 2, 3}, bs.HashTreeRoot(), common.Root{3, 2, 1})
+
+	t.Run("single leaf matches dedicated proof", func(t *testing.T) {
+		t.Parallel()
+		leaves, proof, root, err := merkle.ProveObjectPathsInBlock(
+			bbh, bs, nil, []mlib.ObjectPath{"state_root/validators/6/withdrawal_credentials"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, bbh.HashTreeRoot(), root)
+
+		expectedProof, expectedRoot, err := merkle.ProveWithdrawalCredentialsInBlock(6, bbh, bs)
+		require.NoError(t, err)
+		require.Equal(t, expectedRoot, root)
+		require.Equal(t, expectedProof, proof)
+		require.Equal(t, common.Root(vals[6].WithdrawalCredentials), leaves[0].Leaf)
+	})
+
+	t.Run("multiple leaves", func(t *testing.T) {
+		t.Parallel()
+		leaves, proof, root, err := merkle.ProveObjectPathsInBlock(bbh, bs, nil, []mlib.ObjectPath{
+			"proposer_index",
+			"state_root/balances/5",
+			"state_root/validators/9/effective_balance",
+			"state_root/next_withdrawal_index",
+			"state_root/latest_execution_payload_header/block_number",
+		})
+		require.NoError(t, err)
+		require.Equal(t, bbh.HashTreeRoot(), root)
+		require.NotEmpty(t, proof)
+
+		uint64At := func(leaf *prooftypes.ObjectLeaf) uint64 {
+			return binary.LittleEndian.Uint64(leaf.Leaf[leaf.Offset:])
+		}
+		require.Equal(t, uint64(3), uint64At(leaves[0]))
+		require.Equal(t, bs.Balances[5], uint64At(leaves[1]))
+		require.Equal(t, uint8(8), leaves[1].Offset)
+		require.Equal(t, vals[9].EffectiveBalance.Unwrap(), uint64At(leaves[2]))
+		require.Equal(t, bs.NextWithdrawalIndex, uint64At(leaves[3]))
+		require.Equal(t, uint64(5), uint64At(leaves[4]))
+	})
+
+	t.Run("block body leaves", func(t *testing.T) {
+		t.Parallel()
+		body := types.NewEmptyBeaconBlockBodyWithVersion(version.Electra())
+		require.NoError(t, body.SetExecutionRequests(&types.ExecutionRequests{}))
+		body.SetEth1Data(&types.Eth1Data{DepositRoot: common.Root{9}, DepositCount: 4})
+		body.SetDeposits(types.Deposits{
+			{Pubkey: [48]byte{1}, Amount: 32e9, Index: 2},
+			{Pubkey: [48]byte{2}, Amount: 64e9, Index: 3},
+		})
+		body.SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash]{{1}, {2}})
+		body.GetExecutionPayload().Number = 77
+		body.GetExecutionPayload().FeeRecipient = common.ExecutionAddress{4}
+		blockHeader := types.NewBeaconBlockHeader(7, 3, common.Root{1, 2, 3}, bs.HashTreeRoot(), body.HashTreeRoot())
+
+		leaves, proof, root, err := merkle.ProveObjectPathsInBlock(blockHeader, bs, body, []mlib.ObjectPath{
+			"body_root/execution_payload/block_number",
+			"body_root/deposits/1/amount",
+			"body_root/eth1_data/deposit_count",
+			"state_root/next_withdrawal_index",
+		})
+		require.NoError(t, err)
+		require.Equal(t, blockHeader.HashTreeRoot(), root)
+		require.NotEmpty(t, proof)
+
+		uint64At := func(leaf *prooftypes.ObjectLeaf) uint64 {
+			return binary.LittleEndian.Uint64(leaf.Leaf[leaf.Offset:])
+		}
+		require.Equal(t, uint64(77), uint64At(leaves[0]))
+		require.Equal(t, uint64(64e9), uint64At(leaves[1]))
+		require.Equal(t, uint64(4), uint64At(leaves[2]))
+		require.Equal(t, bs.NextWithdrawalIndex, uint64At(leaves[3]))
+
+		// Without the body, its fields are not part of the schema.
+		_, _, _, err = merkle.ProveObjectPathsInBlock(
+			blockHeader, bs, nil, []mlib.ObjectPath{"body_root/execution_payload/block_number"},
+		)
+		require.ErrorIs(t, err, merkle.ErrInvalidObjectPath)
+	})
+
+	t.Run("invalid path", func(t *testing.T) {
+		t.Parallel()
+		_, _, _, err := merkle.ProveObjectPathsInBlock(
+			bbh, bs, nil, []mlib.ObjectPath{"state_root/unknown_field"},
+		)
+		require.ErrorIs(t, err, merkle.ErrInvalidObjectPath)
+	})
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+const (
+	// StateRootField is the field of the beacon block header which is
+	// expanded into the beacon state in the block schema.
+	StateRootField = "state_root"
+
+	// BodyRootField is the field of the beacon block header which is expanded
+	// into the beacon block body in the block schema.
+	BodyRootField = "body_root"
+
+	// stateRootsLimit is the length of the block and state roots lists.
+	stateRootsLimit = 8192
+
+	// randaoMixesLimit is the length of the randao mixes list.
+	randaoMixesLimit = 65536
+
+	// extraDataLimit is the maximum length of the execution extra data.
+	extraDataLimit = 32
+)
+
+//nolint:gochecknoglobals // schemas are immutable.
+var (
+	beaconStateSchemaDeneb   = schema.DefineContainer(beaconStateFieldsDeneb()...)
+	beaconStateSchemaElectra = schema.DefineContainer(
+		append(beaconStateFieldsDeneb(), beaconStateFieldsElectra()...)...,
+	)
+	beaconHeaderSchemaDeneb   = beaconHeaderSchema(beaconStateSchemaDeneb, schema.B32())
+	beaconHeaderSchemaElectra = beaconHeaderSchema(beaconStateSchemaElectra, schema.B32())
+
+	beaconBodySchemaDeneb   = schema.DefineContainer(beaconBodyFieldsDeneb()...)
+	beaconBodySchemaElectra = schema.DefineContainer(
+		append(beaconBodyFieldsDeneb(), beaconBodyFieldsElectra()...)...,
+	)
+	beaconBlockSchemaDeneb   = beaconHeaderSchema(beaconStateSchemaDeneb, beaconBodySchemaDeneb)
+	beaconBlockSchemaElectra = beaconHeaderSchema(beaconStateSchemaElectra, beaconBodySchemaElectra)
+)
+
+// BeaconStateSchema returns the SSZ schema of the beacon state for the given
+// fork version. Fields are named as in the consensus specs.
+func BeaconStateSchema(forkVersion common.Version) schema.SSZType {
+	if version.IsBefore(forkVersion, version.Electra()) {
+		return beaconStateSchemaDeneb
+	}
+	return beaconStateSchemaElectra
+}
+
+// BeaconBlockHeaderSchema returns the SSZ schema of the beacon block header
+// for the given fork version, with the state root expanded into the beacon
+// state. Object paths resolved against it are anchored to the block root.
+func BeaconBlockHeaderSchema(forkVersion common.Version) schema.SSZType {
+	if version.IsBefore(forkVersion, version.Electra()) {
+		return beaconHeaderSchemaDeneb
+	}
+	return beaconHeaderSchemaElectra
+}
+
+// BeaconBlockSchema returns the SSZ schema of the beacon block header for the
+// given fork version, with the state root expanded into the beacon state and
+// the body root expanded into the beacon block body.
+func BeaconBlockSchema(forkVersion common.Version) schema.SSZType {
+	if version.IsBefore(forkVersion, version.Electra()) {
+		return beaconBlockSchemaDeneb
+	}
+	return beaconBlockSchemaElectra
+}
+
+func beaconHeaderSchema(state, body schema.SSZType) schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("proposer_index", schema.U64()),
+		schema.NewField("parent_root", schema.B32()),
+		schema.NewField(StateRootField, state),
+		schema.NewField(BodyRootField, body),
+	)
+}
+
+// beaconBodyFieldsDeneb returns the fields of the beacon block body. Fields
+// beacon-kit leaves empty, as well as the blob KZG commitments, are only
+// provable by their root.
+func beaconBodyFieldsDeneb() []*schema.Field {
+	return []*schema.Field{
+		schema.NewField("randao_reveal", schema.B96()),
+		schema.NewField("eth1_data", schema.DefineContainer(
+			schema.NewField("deposit_root", schema.B32()),
+			schema.NewField("deposit_count", schema.U64()),
+			schema.NewField("block_hash", schema.B32()),
+		)),
+		schema.NewField("graffiti", schema.B32()),
+		schema.NewField("proposer_slashings", schema.B32()),
+		schema.NewField("attester_slashings", schema.B32()),
+		schema.NewField("attestations", schema.B32()),
+		schema.NewField("deposits", schema.DefineList(schema.DefineContainer(
+			schema.NewField("pubkey", schema.B48()),
+			schema.NewField("withdrawal_credentials", schema.B32()),
+			schema.NewField("amount", schema.U64()),
+			schema.NewField("signature", schema.B96()),
+			schema.NewField("index", schema.U64()),
+		), constants.MaxDeposits)),
+		schema.NewField("voluntary_exits", schema.B32()),
+		schema.NewField("sync_aggregate", schema.B32()),
+		schema.NewField("execution_payload", schema.DefineContainer(
+			schema.NewField("parent_hash", schema.B32()),
+			schema.NewField("fee_recipient", schema.B20()),
+			schema.NewField("state_root", schema.B32()),
+			schema.NewField("receipts_root", schema.B32()),
+			schema.NewField("logs_bloom", schema.B256()),
+			schema.NewField("prev_randao", schema.B32()),
+			schema.NewField("block_number", schema.U64()),
+			schema.NewField("gas_limit", schema.U64()),
+			schema.NewField("gas_used", schema.U64()),
+			schema.NewField("timestamp", schema.U64()),
+			schema.NewField("extra_data", schema.DefineByteList(extraDataLimit)),
+			schema.NewField("base_fee_per_gas", schema.U256()),
+			schema.NewField("block_hash", schema.B32()),
+			schema.NewField("transactions", schema.DefineList(
+				schema.DefineByteList(constants.MaxBytesPerTx), constants.MaxTxsPerPayload,
+			)),
+			schema.NewField("withdrawals", schema.DefineList(schema.DefineContainer(
+				schema.NewField("index", schema.U64()),
+				schema.NewField("validator_index", schema.U64()),
+				schema.NewField("address", schema.B20()),
+				schema.NewField("amount", schema.U64()),
+			), constants.MaxWithdrawalsPerPayload)),
+			schema.NewField("blob_gas_used", schema.U64()),
+			schema.NewField("excess_blob_gas", schema.U64()),
+		)),
+		schema.NewField("bls_to_execution_changes", schema.B32()),
+		schema.NewField("blob_kzg_commitments", schema.B32()),
+	}
+}
+
+func beaconBodyFieldsElectra() []*schema.Field {
+	return []*schema.Field{
+		schema.NewField("execution_requests", schema.B32()),
+	}
+}
+
+func beaconStateFieldsDeneb() []*schema.Field {
+	return []*schema.Field{
+		schema.NewField("genesis_validators_root", schema.B32()),
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("fork", schema.DefineContainer(
+			schema.NewField("previous_version", schema.B4()),
+			schema.NewField("current_version", schema.B4()),
+			schema.NewField("epoch", schema.U64()),
+		)),
+		schema.NewField("latest_block_header", schema.DefineContainer(
+			schema.NewField("slot", schema.U64()),
+			schema.NewField("proposer_index", schema.U64()),
+			schema.NewField("parent_root", schema.B32()),
+			schema.NewField("state_root", schema.B32()),
+			schema.NewField("body_root", schema.B32()),
+		)),
+		schema.NewField("block_roots", schema.DefineList(schema.B32(), stateRootsLimit)),
+		schema.NewField("state_roots", schema.DefineList(schema.B32(), stateRootsLimit)),
+		schema.NewField("eth1_data", schema.DefineContainer(
+			schema.NewField("deposit_root", schema.B32()),
+			schema.NewField("deposit_count", schema.U64()),
+			schema.NewField("block_hash", schema.B32()),
+		)),
+		schema.NewField("eth1_deposit_index", schema.U64()),
+		schema.NewField("latest_execution_payload_header", schema.DefineContainer(
+			schema.NewField("parent_hash", schema.B32()),
+			schema.NewField("fee_recipient", schema.B20()),
+			schema.NewField("state_root", schema.B32()),
+			schema.NewField("receipts_root", schema.B32()),
+			schema.NewField("logs_bloom", schema.B256()),
+			schema.NewField("prev_randao", schema.B32()),
+			schema.NewField("block_number", schema.U64()),
+			schema.NewField("gas_limit", schema.U64()),
+			schema.NewField("gas_used", schema.U64()),
+			schema.NewField("timestamp", schema.U64()),
+			schema.NewField("extra_data", schema.DefineByteList(extraDataLimit)),
+			schema.NewField("base_fee_per_gas", schema.U256()),
+			schema.NewField("block_hash", schema.B32()),
+			schema.NewField("transactions_root", schema.B32()),
+			schema.NewField("withdrawals_root", schema.B32()),
+			schema.NewField("blob_gas_used", schema.U64()),
+			schema.NewField("excess_blob_gas", schema.U64()),
+		)),
+		schema.NewField("validators", schema.DefineList(schema.DefineContainer(
+			schema.NewField("pubkey", schema.B48()),
+			schema.NewField("withdrawal_credentials", schema.B32()),
+			schema.NewField("effective_balance", schema.U64()),
+			schema.NewField("slashed", schema.Bool()),
+			schema.NewField("activation_eligibility_epoch", schema.U64()),
+			schema.NewField("activation_epoch", schema.U64()),
+			schema.NewField("exit_epoch", schema.U64()),
+			schema.NewField("withdrawable_epoch", schema.U64()),
+		), constants.ValidatorsRegistryLimit)),
+		schema.NewField(
+			"balances", schema.DefineList(schema.U64(), constants.ValidatorsRegistryLimit),
+		),
+		schema.NewField("randao_mixes", schema.DefineList(schema.B32(), randaoMixesLimit)),
+		schema.NewField("next_withdrawal_index", schema.U64()),
+		schema.NewField("next_withdrawal_validator_index", schema.U64()),
+		schema.NewField(
+			"slashings", schema.DefineList(schema.U64(), constants.ValidatorsRegistryLimit),
+		),
+		schema.NewField("total_slashing", schema.U64()),
+	}
+}
+
+func beaconStateFieldsElectra() []*schema.Field {
+	return []*schema.Field{
+		schema.NewField("pending_partial_withdrawals", schema.DefineList(schema.DefineContainer(
+			schema.NewField("validator_index", schema.U64()),
+			schema.NewField("amount", schema.U64()),
+			schema.NewField("withdrawable_epoch", schema.U64()),
+		), constants.PendingPartialWithdrawalsLimit)),
+	}
+}
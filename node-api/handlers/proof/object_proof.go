@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/node-api/handlers/proof/types"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	mlib "github.com/berachain/beacon-kit/primitives/merkle"
+	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
+)
+
+//nolint:gochecknoglobals // read-only lookup tables.
+var (
+	// headerFields are the fields of the beacon block header. Paths starting
+	// with any other field are resolved against the beacon state.
+	headerFields = []string{"slot", "proposer_index", "parent_root", merkle.StateRootField, merkle.BodyRootField}
+
+	// validatorLists are the state lists indexed by validator index, whose
+	// index may also be given as a validator pubkey.
+	validatorLists = []string{"validators", "balances"}
+)
+
+// GetStateObjectProof returns a multiproof of the objects at the requested
+// paths in the block of the given state, which can be verified against the
+// beacon block root.
+func (h *Handler) GetStateObjectProof(c handlers.Context) (any, error) {
+	params, err := utils.BindAndValidate[types.StateObjectProofRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(params.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	return h.getObjectProof(slot, params.Paths)
+}
+
+// GetBlockObjectProof returns a multiproof of the objects at the requested
+// paths in the given block, which can be verified against the beacon block
+// root.
+func (h *Handler) GetBlockObjectProof(c handlers.Context) (any, error) {
+	params, err := utils.BindAndValidate[types.BlockObjectProofRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(params.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	return h.getObjectProof(slot, params.Paths)
+}
+
+// getObjectProof proves the objects at the given paths in the block at the
+// given slot. The block body is only loaded if a path descends into it.
+func (h *Handler) getObjectProof(slot math.Slot, rawPaths []string) (any, error) {
+	beaconState, slot, err := h.backend.StateAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	blockHeader, err := h.backend.BlockHeaderAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	var body *ctypes.BeaconBlockBody
+	paths := make([]mlib.ObjectPath, len(rawPaths))
+	for i, path := range rawPaths {
+		if paths[i], err = resolveObjectPath(beaconState, path); err != nil {
+			return nil, fmt.Errorf("%w: %w", handlertypes.ErrInvalidRequest, err)
+		}
+		if body == nil && strings.HasPrefix(string(paths[i]), merkle.BodyRootField+"/") {
+			var blk *ctypes.SignedBeaconBlock
+			if blk, err = h.backend.SignedBlockAtSlot(slot); err != nil {
+				return nil, errors.Wrapf(err, "failed to get block body at slot %d", slot)
+			}
+			body = blk.GetBeaconBlock().GetBody()
+		}
+	}
+
+	h.Logger().Info("Generating object proofs", "slot", slot, "paths", rawPaths)
+
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	leaves, proof, beaconBlockRoot, err := merkle.ProveObjectPathsInBlock(blockHeader, bsm, body, paths)
+	if errors.Is(err, merkle.ErrInvalidObjectPath) {
+		return nil, fmt.Errorf("%w: %w", handlertypes.ErrInvalidRequest, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ObjectProofResponse{
+		BeaconBlockHeader: blockHeader,
+		BeaconBlockRoot:   beaconBlockRoot,
+		Leaves:            leaves,
+		Proof:             proof,
+	}, nil
+}
+
+// resolveObjectPath anchors the given path to the beacon block header and
+// replaces validator pubkeys by their index.
+func resolveObjectPath(st *statedb.StateDB, path string) (mlib.ObjectPath, error) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if !slices.Contains(headerFields, parts[0]) {
+		parts = append([]string{merkle.StateRootField}, parts...)
+	}
+	if parts[0] != merkle.StateRootField {
+		return mlib.ObjectPath(strings.Join(parts, "/")), nil
+	}
+
+	for i := 2; i < len(parts); i++ {
+		if !slices.Contains(validatorLists, parts[i-1]) || !strings.HasPrefix(parts[i], "0x") {
+			continue
+		}
+		var pubkey crypto.BLSPubkey
+		if err := pubkey.UnmarshalText([]byte(parts[i])); err != nil {
+			return "", fmt.Errorf("invalid validator pubkey %s: %w", parts[i], err)
+		}
+		index, err := st.ValidatorIndexByPubkey(pubkey)
+		if err != nil {
+			return "", fmt.Errorf("unknown validator pubkey %s: %w", parts[i], err)
+		}
+		parts[i] = index.Base10()
+	}
+	return mlib.ObjectPath(strings.Join(parts, "/")), nil
+}
@@ -40,5 +40,15 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 			Path:    "bkit/v1/proof/validator_credentials/:timestamp_id/:validator_index",
 			Handler: h.GetValidatorCredentials,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/merkle/states/:state_id",
+			Handler: h.GetStateObjectProof,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/merkle/blocks/:block_id",
+			Handler: h.GetBlockObjectProof,
+		},
 	})
 }
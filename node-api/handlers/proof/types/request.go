@@ -34,3 +34,20 @@ type ValidatorCredentialsRequest struct {
 	types.TimestampIDRequest
 	ValidatorIndex string `param:"validator_index" validate:"required,numeric"`
 }
+
+// StateObjectProofRequest is the request for the
+// `/proof/merkle/states/{state_id}` endpoint. Each path is resolved against the
+// beacon block header, or against the beacon state if it does not start with a
+// field of the header.
+type StateObjectProofRequest struct {
+	types.StateIDRequest
+	Paths []string `query:"path" validate:"required,min=1,max=64,dive,required"`
+}
+
+// BlockObjectProofRequest is the request for the
+// `/proof/merkle/blocks/{block_id}` endpoint. Paths are resolved as for
+// StateObjectProofRequest.
+type BlockObjectProofRequest struct {
+	types.BlockIDRequest
+	Paths []string `query:"path" validate:"required,min=1,max=64,dive,required"`
+}
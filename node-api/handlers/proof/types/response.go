@@ -69,3 +69,41 @@ type ValidatorWithdrawalCredentialsResponse struct {
 	// block. In the Electra fork, z is 6350779162034177.
 	WithdrawalCredentialsProof []common.Root `json:"withdrawal_credentials_proof"`
 }
+
+// ObjectLeaf is a leaf of an object paths multiproof.
+type ObjectLeaf struct {
+	// Path is the requested object path.
+	Path string `json:"path"`
+
+	// GeneralizedIndex is the generalized index of the leaf in the beacon
+	// block.
+	GeneralizedIndex uint64 `json:"generalized_index"`
+
+	// Offset is the offset of the object in the leaf, for objects of basic
+	// types packed with their siblings.
+	Offset uint8 `json:"offset"`
+
+	// Leaf is the 32 bytes chunk holding the object, or the root of the
+	// object for composite types.
+	Leaf common.Root `json:"leaf"`
+}
+
+// ObjectProofResponse is the response for the `/proof/merkle/states/{state_id}`
+// and `/proof/merkle/blocks/{block_id}` endpoints.
+type ObjectProofResponse struct {
+	// BeaconBlockHeader is the block header of which the hash tree root is the
+	// beacon block root to verify against.
+	BeaconBlockHeader *ctypes.BeaconBlockHeader `json:"beacon_block_header"`
+
+	// BeaconBlockRoot is the beacon block root for this slot.
+	BeaconBlockRoot common.Root `json:"beacon_block_root"`
+
+	// Leaves are the proven leaves, in the order of the requested paths.
+	Leaves []*ObjectLeaf `json:"leaves"`
+
+	// Proof is the multiproof of the leaves against the beacon block root. It
+	// holds the helper nodes in decreasing order of generalized index, as in
+	// the consensus specs. With a single leaf, it is the regular Merkle
+	// branch of the leaf.
+	Proof []common.Root `json:"proof"`
+}
@@ -30,6 +30,10 @@ const (
 	TimestampIDPrefix = "t"
 )
 
+// HeaderEthConsensusVersion is the header carrying the fork version name of
+// versioned SSZ responses.
+const HeaderEthConsensusVersion = "Eth-Consensus-Version"
+
 const (
 	Head math.Slot = iota
 	Genesis
@@ -22,10 +22,13 @@ package utils
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/labstack/echo/v4"
 )
 
 // BindAndValidate binds the request to the context and validates it.
@@ -40,3 +43,17 @@ func BindAndValidate[RequestT any](c handlers.Context, logger log.Logger) (Reque
 	logger.Info("Request validation successful", "params", req)
 	return req, nil
 }
+
+// WantsSSZ returns true if the request asks for an SSZ encoded response.
+func WantsSSZ(c handlers.Context) bool {
+	return strings.Contains(
+		c.Request().Header.Get(echo.HeaderAccept), echo.MIMEOctetStream,
+	)
+}
+
+// WriteSSZ writes the SSZ encoded response, along with the consensus version
+// header required by the beacon API spec.
+func WriteSSZ(c handlers.Context, consensusVersion string, bz []byte) error {
+	c.Response().Header().Set(HeaderEthConsensusVersion, consensusVersion)
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, bz)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// GetProposerDuties returns the validators expected to propose the blocks of
+// the requested epoch. Since CometBFT selects proposers, duties of upcoming
+// slots are a best-effort projection.
+func (h *Handler) GetProposerDuties(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.GetProposerDutiesRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := math.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid epoch %s", handlertypes.ErrInvalidRequest, req.Epoch)
+	}
+
+	dependentRoot, duties, err := h.backend.ProposerDutiesAtEpoch(epoch)
+	switch {
+	case err == nil:
+	case errors.Is(err, backend.ErrEpochOutOfRange):
+		return nil, fmt.Errorf("%w: %w", handlertypes.ErrInvalidRequest, err)
+	default:
+		return nil, err
+	}
+
+	return types.ProposerDutiesResponse{
+		DependentRoot: dependentRoot,
+		// Never optimistic since duties are derived from finalized data.
+		ExecutionOptimistic: false,
+		Data:                duties,
+	}, nil
+}
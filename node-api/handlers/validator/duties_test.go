@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	beaconecho "github.com/berachain/beacon-kit/node-api/engines/echo"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/mocks"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProposerDuties(t *testing.T) {
+	t.Parallel()
+
+	dependentRoot := common.Root{0xaa}
+	duties := []*types.ProposerDuty{
+		{Pubkey: crypto.BLSPubkey{0x01}, ValidatorIndex: 3, Slot: 32},
+		{Pubkey: crypto.BLSPubkey{0x02}, ValidatorIndex: 1, Slot: 33},
+	}
+
+	testCases := []struct {
+		name                string
+		epoch               string
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, res any, err error)
+	}{
+		{
+			name:  "success",
+			epoch: "1",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().ProposerDutiesAtEpoch(math.Epoch(1)).Return(dependentRoot, duties, nil)
+			},
+			check: func(t *testing.T, res any, err error) {
+				t.Helper()
+				require.NoError(t, err)
+				bz, err := json.Marshal(res)
+				require.NoError(t, err)
+				require.JSONEq(t, fmt.Sprintf(`{
+					"dependent_root":"%s",
+					"execution_optimistic":false,
+					"data":[
+						{"pubkey":"%s","validator_index":"3","slot":"32"},
+						{"pubkey":"%s","validator_index":"1","slot":"33"}
+					]
+				}`, dependentRoot, duties[0].Pubkey, duties[1].Pubkey), string(bz))
+			},
+		},
+		{
+			name:  "epoch out of range",
+			epoch: "10",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().ProposerDutiesAtEpoch(math.Epoch(10)).
+					Return(common.Root{}, nil, backend.ErrEpochOutOfRange)
+			},
+			check: func(t *testing.T, _ any, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:  "backend failure",
+			epoch: "1",
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().ProposerDutiesAtEpoch(math.Epoch(1)).
+					Return(common.Root{}, nil, errors.New("boom"))
+			},
+			check: func(t *testing.T, _ any, err error) {
+				t.Helper()
+				require.Error(t, err)
+				require.NotErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:                "invalid epoch",
+			epoch:               "abc",
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, _ any, err error) {
+				t.Helper()
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			b := mocks.NewBackend(t)
+			tc.setMockExpectations(b)
+			h := validator.NewHandler(b)
+			h.SetLogger(noop.NewLogger[log.Logger]())
+
+			e := echo.New()
+			e.Validator = &beaconecho.CustomValidator{
+				Validator: beaconecho.ConstructValidator(),
+			}
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+			c.SetParamNames("epoch")
+			c.SetParamValues(tc.epoch)
+
+			res, err := h.GetProposerDuties(c)
+			tc.check(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/log/noop"
+	beaconecho "github.com/berachain/beacon-kit/node-api/engines/echo"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/mocks"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareBeaconProposer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		body                string
+		setMockExpectations func(*mocks.Backend)
+		check               func(t *testing.T, err error)
+	}{
+		{
+			name: "success",
+			body: `[
+				{"validator_index":"1","fee_recipient":"0xaa00000000000000000000000000000000000000"},
+				{"validator_index":"2","fee_recipient":"0xbb00000000000000000000000000000000000000"}
+			]`,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().PrepareBeaconProposers([]*types.ProposerPreparation{
+					{ValidatorIndex: 1, FeeRecipient: common.ExecutionAddress{0xaa}},
+					{ValidatorIndex: 2, FeeRecipient: common.ExecutionAddress{0xbb}},
+				}).Return(nil)
+			},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:                "invalid validator index",
+			body:                `[{"validator_index":"abc","fee_recipient":"0xaa00000000000000000000000000000000000000"}]`,
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name:                "invalid fee recipient",
+			body:                `[{"validator_index":"1","fee_recipient":"0xaa"}]`,
+			setMockExpectations: func(*mocks.Backend) {},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.ErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+		{
+			name: "backend failure",
+			body: `[{"validator_index":"1","fee_recipient":"0xaa00000000000000000000000000000000000000"}]`,
+			setMockExpectations: func(b *mocks.Backend) {
+				b.EXPECT().PrepareBeaconProposers(mock.Anything).Return(errors.New("boom"))
+			},
+			check: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+				require.NotErrorIs(t, err, handlertypes.ErrInvalidRequest)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			b := mocks.NewBackend(t)
+			tc.setMockExpectations(b)
+			h := validator.NewHandler(b)
+			h.SetLogger(noop.NewLogger[log.Logger]())
+
+			e := echo.New()
+			e.Validator = &beaconecho.CustomValidator{
+				Validator: beaconecho.ConstructValidator(),
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			_, err := h.PrepareBeaconProposer(c)
+			tc.check(t, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"errors"
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-api/backend"
+	"github.com/berachain/beacon-kit/node-api/handlers"
+	handlertypes "github.com/berachain/beacon-kit/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// RegisterValidator registers validators with the relays of external block
+// builders this node requests payloads from.
+func (h *Handler) RegisterValidator(c handlers.Context) (any, error) {
+	req, err := utils.BindAndValidate[types.RegisterValidatorRequest](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	regs := make([]*ctypes.SignedValidatorRegistration, len(req))
+	for i, r := range req {
+		if r == nil || r.Message == nil {
+			return nil, fmt.Errorf("%w: registration %d has no message", handlertypes.ErrInvalidRequest, i)
+		}
+		regs[i] = &ctypes.SignedValidatorRegistration{
+			Message: &ctypes.ValidatorRegistration{
+				FeeRecipient: r.Message.FeeRecipient,
+				GasLimit:     math.U64(r.Message.GasLimit),
+				Timestamp:    math.U64(r.Message.Timestamp),
+				Pubkey:       r.Message.Pubkey,
+			},
+			Signature: r.Signature,
+		}
+	}
+
+	err = h.backend.RegisterValidators(c.Request().Context(), regs)
+	switch {
+	case err == nil:
+	case errors.Is(err, backend.ErrNoRelays), errors.Is(err, relay.ErrInvalidRegistration):
+		return nil, fmt.Errorf("%w: %w", handlertypes.ErrInvalidRequest, err)
+	default:
+		return nil, err
+	}
+	return nil, nil //nolint:nilnil // the response has no body.
+}
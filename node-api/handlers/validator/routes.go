@@ -38,7 +38,7 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/validator/duties/proposer/:epoch",
-			Handler: h.NotImplemented,
+			Handler: h.GetProposerDuties,
 		},
 		{
 			Method:  http.MethodPost,
@@ -103,12 +103,12 @@ func (h *Handler) RegisterRoutes(logger log.Logger) {
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/validator/prepare_beacon_proposer",
-			Handler: h.NotImplemented,
+			Handler: h.PrepareBeaconProposer,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/validator/register_validator",
-			Handler: h.NotImplemented,
+			Handler: h.RegisterValidator,
 		},
 		{
 			Method:  http.MethodPost,
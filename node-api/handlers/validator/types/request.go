@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+type GetProposerDutiesRequest struct {
+	Epoch string `param:"epoch" validate:"required,epoch"`
+}
+
+// PrepareBeaconProposerRequest is the body of prepare_beacon_proposer. Its
+// entries are validated while decoding.
+//
+// https://ethereum.github.io/beacon-APIs/#/Validator/prepareBeaconProposer
+type PrepareBeaconProposerRequest []*ProposerPreparation
+
+// ProposerPreparation sets the fee recipient of a validator.
+type ProposerPreparation struct {
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	FeeRecipient   common.ExecutionAddress `json:"fee_recipient"`
+}
+
+// RegisterValidatorRequest is the body of register_validator. Its entries are
+// validated while decoding.
+//
+// https://ethereum.github.io/beacon-APIs/#/Validator/registerValidator
+type RegisterValidatorRequest []*SignedValidatorRegistration
+
+// SignedValidatorRegistration is a validator registration signed by the
+// validator in the builder domain.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+// ValidatorRegistration registers the preferences of a validator with
+// external block builders.
+type ValidatorRegistration struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     uint64                  `json:"gas_limit,string"`
+	Timestamp    uint64                  `json:"timestamp,string"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
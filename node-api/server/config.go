@@ -21,7 +21,8 @@
 package server
 
 const (
-	defaultAddress = "127.0.0.1:3500"
+	defaultAddress          = "127.0.0.1:3500"
+	defaultEventsBufferSize = 16
 )
 
 // Config is the configuration for the node API server.
@@ -32,13 +33,17 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// EventsBufferSize is the number of events buffered per event stream
+	// subscriber before it is dropped as too slow.
+	EventsBufferSize int `mapstructure:"events-buffer-size"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:          false,
+		Address:          defaultAddress,
+		Logging:          false,
+		EventsBufferSize: defaultEventsBufferSize,
 	}
 }
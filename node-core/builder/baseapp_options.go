@@ -27,10 +27,13 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	server "github.com/berachain/beacon-kit/cli/commands/server"
 	"github.com/berachain/beacon-kit/config"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cast"
@@ -65,6 +68,11 @@ func DefaultServiceOptions(
 		}
 	}
 
+	snapshotStore, err := getSnapshotStore(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	return []func(*cometbft.Service){
 		cometbft.SetPruning(pruningOpts),
 		cometbft.SetMinRetainBlocks(
@@ -79,7 +87,35 @@ func DefaultServiceOptions(
 			true,
 		),
 		cometbft.SetChainID(chainID),
+		cometbft.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(
+			cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+			cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
+		)),
+	}
+}
+
+// getSnapshotStore opens the store holding state sync snapshots, under the
+// node data directory.
+func getSnapshotStore(appOpts config.AppOptions) (*snapshots.Store, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	snapshotDB, err := dbm.NewDB("metadata", dbm.PebbleDBBackend, snapshotDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshots metadata db: %w", err)
+	}
+
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	if err != nil {
+		return nil, errors.Join(
+			snapshotDB.Close(),
+			fmt.Errorf("failed to create snapshot store: %w", err),
+		)
 	}
+	return snapshotStore, nil
 }
 
 func loadChainIDFromGenesis(appOpts config.AppOptions) (string, error) {
@@ -24,6 +24,7 @@ import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-api/backend"
@@ -31,6 +32,8 @@ import (
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/server"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/payload/proposer"
+	"github.com/berachain/beacon-kit/payload/relay"
 	cmtcfg "github.com/cometbft/cometbft/config"
 )
 
@@ -45,6 +48,10 @@ type NodeAPIBackendInput struct {
 	ChainSpec      chain.Spec
 	StorageBackend *storage.Backend
 	CometConfig    *cmtcfg.Config
+	EngineClient   *client.EngineClient
+	Proposers      *proposer.Registry
+	StateProcessor StateProcessor
+	Relays         *relay.Relays
 }
 
 func ProvideNodeAPIBackend(
@@ -54,6 +61,10 @@ func ProvideNodeAPIBackend(
 		in.StorageBackend,
 		in.ChainSpec,
 		in.CometConfig,
+		in.EngineClient,
+		in.Proposers,
+		in.StateProcessor,
+		in.Relays,
 	)
 }
 
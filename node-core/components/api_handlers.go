@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	beaconapi "github.com/berachain/beacon-kit/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/node-api/handlers/builder"
@@ -30,17 +31,19 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/node-api/handlers/validator"
 )
 
 type NodeAPIHandlersInput struct {
 	depinject.In
-	BeaconAPIHandler  *beaconapi.Handler
-	BuilderAPIHandler *builderapi.Handler
-	ConfigAPIHandler  *configapi.Handler
-	DebugAPIHandler   *debugapi.Handler
-	EventsAPIHandler  *eventsapi.Handler
-	NodeAPIHandler    *nodeapi.Handler
-	ProofAPIHandler   *proofapi.Handler
+	BeaconAPIHandler    *beaconapi.Handler
+	BuilderAPIHandler   *builderapi.Handler
+	ConfigAPIHandler    *configapi.Handler
+	DebugAPIHandler     *debugapi.Handler
+	EventsAPIHandler    *eventsapi.Handler
+	NodeAPIHandler      *nodeapi.Handler
+	ProofAPIHandler     *proofapi.Handler
+	ValidatorAPIHandler *validatorapi.Handler
 }
 
 func ProvideNodeAPIHandlers(in NodeAPIHandlersInput) []handlers.Handlers {
@@ -52,6 +55,7 @@ func ProvideNodeAPIHandlers(in NodeAPIHandlersInput) []handlers.Handlers {
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
@@ -59,8 +63,8 @@ func ProvideNodeAPIBeaconHandler(b NodeAPIBackend) *beaconapi.Handler {
 	return beaconapi.NewHandler(b)
 }
 
-func ProvideNodeAPIBuilderHandler() *builderapi.Handler {
-	return builderapi.NewHandler()
+func ProvideNodeAPIBuilderHandler(b NodeAPIBackend) *builderapi.Handler {
+	return builderapi.NewHandler(b)
 }
 
 func ProvideNodeAPIConfigHandler(b NodeAPIBackend) *configapi.Handler {
@@ -71,14 +75,18 @@ func ProvideNodeAPIDebugHandler(b NodeAPIBackend) *debugapi.Handler {
 	return debugapi.NewHandler(b)
 }
 
-func ProvideNodeAPIEventsHandler() *eventsapi.Handler {
-	return eventsapi.NewHandler()
+func ProvideNodeAPIEventsHandler(b *events.Broker) *eventsapi.Handler {
+	return eventsapi.NewHandler(b)
 }
 
-func ProvideNodeAPINodeHandler() *nodeapi.Handler {
-	return nodeapi.NewHandler()
+func ProvideNodeAPINodeHandler(b NodeAPIBackend) *nodeapi.Handler {
+	return nodeapi.NewHandler(b)
 }
 
 func ProvideNodeAPIProofHandler(b NodeAPIBackend) *proofapi.Handler {
 	return proofapi.NewHandler(b)
 }
+
+func ProvideNodeAPIValidatorHandler(b NodeAPIBackend) *validatorapi.Handler {
+	return validatorapi.NewHandler(b)
+}
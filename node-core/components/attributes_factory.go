@@ -26,6 +26,8 @@ import (
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/payload/attributes"
+	"github.com/berachain/beacon-kit/payload/proposer"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 )
 
 type AttributesFactoryInput struct {
@@ -34,6 +36,8 @@ type AttributesFactoryInput struct {
 	ChainSpec chain.Spec
 	Config    *config.Config
 	Logger    *phuslu.Logger
+	Proposers *proposer.Registry
+	Signer    crypto.BLSSigner
 }
 
 // ProvideAttributesFactory provides an AttributesFactory for the client.
@@ -41,6 +45,7 @@ func ProvideAttributesFactory(in AttributesFactoryInput) (*attributes.Factory, e
 	return attributes.NewAttributesFactory(
 		in.ChainSpec,
 		in.Logger,
-		in.Config.PayloadBuilder.SuggestedFeeRecipient,
+		in.Proposers,
+		in.Signer.PublicKey(),
 	), nil
 }
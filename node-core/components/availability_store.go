@@ -21,6 +21,7 @@
 package components
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -38,6 +39,7 @@ import (
 type AvailabilityStoreInput struct {
 	depinject.In
 	AppOpts config.AppOptions
+	Config  *config.Config
 	Logger  *phuslu.Logger
 }
 
@@ -48,7 +50,22 @@ func ProvideAvailabilityStore(in AvailabilityStoreInput) (*dastore.Store, error)
 		blobsDir = filepath.Join(rootDir, "data", "blobs")
 	)
 
-	return dastore.New(
+	opts := []dastore.Option{
+		dastore.WithArchiveMode(in.Config.BlobStore.ArchiveMode),
+	}
+	if archiveDir := in.Config.BlobStore.ArchiveDir; archiveDir != "" {
+		archive, err := dastore.OpenArchive(archiveDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open blob archives: %w", err)
+		}
+		in.Logger.Info(
+			"Serving blob sidecars from archives",
+			"dir", archiveDir, "archives", len(archive.Files()),
+		)
+		opts = append(opts, dastore.WithArchive(archive))
+	}
+
+	store := dastore.New(
 		filedb.NewRangeDB(
 			filedb.NewDB(
 				filedb.WithRootDirectory(blobsDir),
@@ -58,5 +75,10 @@ func ProvideAvailabilityStore(in AvailabilityStoreInput) (*dastore.Store, error)
 			),
 		),
 		in.Logger.With("service", "da-store"),
-	), nil
+		opts...,
+	)
+	if err := store.QuarantineCorruptSidecars(); err != nil {
+		return nil, err
+	}
+	return store, nil
 }
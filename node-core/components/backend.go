@@ -23,7 +23,6 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/chain"
-	"github.com/berachain/beacon-kit/consensus-types/types"
 	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
@@ -37,7 +36,7 @@ import (
 type StorageBackendInput struct {
 	depinject.In
 	AvailabilityStore *dastore.Store
-	BlockStore        *block.KVStore[*types.BeaconBlock]
+	BlockStore        *block.KVStore
 	ChainSpec         chain.Spec
 	DepositStore      deposit.StoreManager
 	BeaconStore       *beacondb.KVStore
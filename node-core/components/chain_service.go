@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/execution/deposit"
 	"github.com/berachain/beacon-kit/execution/engine"
 	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-api/events"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
 	"github.com/berachain/beacon-kit/primitives/crypto"
@@ -48,6 +49,7 @@ type ChainServiceInput struct {
 	BlobProcessor         BlobProcessor
 	TelemetrySink         *metrics.TelemetrySink
 	BeaconDepositContract deposit.Contract
+	EventPublisher        *events.Publisher
 }
 
 // ProvideChainService is a depinject provider for the blockchain service.
@@ -61,8 +63,11 @@ func ProvideChainService(in ChainServiceInput) *blockchain.Service {
 		in.ExecutionEngine,
 		in.LocalBuilder,
 		in.StateProcessor,
+		in.EventPublisher,
 		in.TelemetrySink,
+		in.Cfg.Engine.DepositFetchMaxSpan,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+		in.Cfg.BlobStore.ArchiveMode,
 	)
 }
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/log/phuslu"
+	"github.com/berachain/beacon-kit/node-api/events"
+)
+
+// EventBrokerInput is the input for the event broker provider.
+type EventBrokerInput struct {
+	depinject.In
+
+	Config *config.Config
+	Logger *phuslu.Logger
+}
+
+// ProvideEventBroker provides the broker backing the node API event stream.
+func ProvideEventBroker(in EventBrokerInput) *events.Broker {
+	return events.NewBroker(
+		in.Logger.With("service", "event-broker"),
+		in.Config.NodeAPI.EventsBufferSize,
+	)
+}
+
+// EventPublisherInput is the input for the event publisher provider.
+type EventPublisherInput struct {
+	depinject.In
+
+	AttributesFactory AttributesFactory
+	Broker            *events.Broker
+	ChainSpec         chain.Spec
+	Logger            *phuslu.Logger
+}
+
+// ProvideEventPublisher provides the publisher the blockchain service uses
+// to emit events.
+func ProvideEventPublisher(in EventPublisherInput) *events.Publisher {
+	return events.NewPublisher(
+		in.Broker,
+		in.ChainSpec,
+		in.AttributesFactory,
+		in.Logger.With("service", "event-publisher"),
+	)
+}
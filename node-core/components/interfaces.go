@@ -31,6 +31,9 @@ import (
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/node-api/handlers"
 	"github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	configtypes "github.com/berachain/beacon-kit/node-api/handlers/config/types"
+	nodetypes "github.com/berachain/beacon-kit/node-api/handlers/node/types"
+	validatortypes "github.com/berachain/beacon-kit/node-api/handlers/validator/types"
 	nodecoretypes "github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/payload/builder"
 	"github.com/berachain/beacon-kit/primitives/common"
@@ -42,6 +45,7 @@ import (
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/berachain/beacon-kit/storage/block"
 	"github.com/berachain/beacon-kit/storage/deposit"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
 )
 
 type (
@@ -154,7 +158,7 @@ type (
 	// components required by the beacon node.
 	StorageBackend interface {
 		AvailabilityStore() *dastore.Store
-		BlockStore() *block.KVStore[*ctypes.BeaconBlock]
+		BlockStore() *block.KVStore
 		DepositStore() deposit.StoreManager
 		// StateFromContext retrieves the beacon state from the given context.
 		StateFromContext(context.Context) *statedb.StateDB
@@ -501,8 +505,11 @@ type (
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
 
 		NodeAPIBeaconBackend
+		NodeAPIBuilderBackend
 		NodeAPIProofBackend
 		NodeAPIConfigBackend
+		NodeAPINodeBackend
+		NodeAPIValidatorBackend
 	}
 
 	// NodeAPIBeaconBackend is the interface for backend of the beacon API.
@@ -510,6 +517,8 @@ type (
 		GenesisBackend
 		BlobBackend
 		BlockBackend
+		DepositBackend
+		LightClientBackend
 		RandaoBackend
 		StateBackend
 		ValidatorBackend
@@ -520,9 +529,36 @@ type (
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	}
 
+	// NodeAPIBuilderBackend is the interface for backend of the builder API.
+	NodeAPIBuilderBackend interface {
+		ExpectedWithdrawals(
+			slot, proposalSlot math.Slot,
+		) (engineprimitives.Withdrawals, error)
+	}
+
+	// NodeAPINodeBackend is the interface for backend of the node API.
+	NodeAPINodeBackend interface {
+		SyncingData() (*nodetypes.SyncingData, error)
+	}
+
+	// NodeAPIValidatorBackend is the interface for backend of the validator
+	// API.
+	NodeAPIValidatorBackend interface {
+		ProposerDutiesAtEpoch(
+			epoch math.Epoch,
+		) (common.Root, []*validatortypes.ProposerDuty, error)
+		PrepareBeaconProposers(
+			preparations []*validatortypes.ProposerPreparation,
+		) error
+		RegisterValidators(
+			ctx context.Context, regs []*ctypes.SignedValidatorRegistration,
+		) error
+	}
+
 	// NodeAPIConfigBackend is the interface for backend of the config API.
 	NodeAPIConfigBackend interface {
 		Spec() (chain.Spec, error)
+		ForkSchedule() ([]*configtypes.ScheduledFork, error)
 	}
 
 	// NodeAPIProofBackend is the interface for backend of the proof API.
@@ -550,12 +586,26 @@ type (
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
 		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (*ctypes.BeaconBlockHeader, error)
+		SignedBlockAtSlot(slot math.Slot) (*ctypes.SignedBeaconBlock, error)
+	}
+
+	LightClientBackend interface {
+		LightClientBootstrap(root common.Root) (*ctypes.LightClientBootstrap, error)
+		LightClientUpdates(startPeriod math.Epoch, count uint64) ([]*ctypes.LightClientUpdate, error)
+		LightClientFinalityUpdate() (*ctypes.LightClientFinalityUpdate, error)
+		LightClientOptimisticUpdate() (*ctypes.LightClientOptimisticUpdate, error)
 	}
 
 	StateBackend interface {
 		StateAtSlot(slot math.Slot) (*statedb.StateDB, math.Slot, error)
 	}
 
+	DepositBackend interface {
+		DepositsAtSlot(
+			ctx context.Context, slot math.Slot,
+		) (ctypes.Deposits, *depositstorecommon.Block, error)
+	}
+
 	WithdrawalBackend interface {
 		PendingPartialWithdrawalsAtState(*statedb.StateDB) ([]*types.PendingPartialWithdrawalData, error)
 	}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/config"
+	"github.com/berachain/beacon-kit/payload/proposer"
+)
+
+// ProposerRegistryInput is the input for the proposer registry provider.
+type ProposerRegistryInput struct {
+	depinject.In
+	Cfg *config.Config
+}
+
+// ProvideProposerRegistry provides the settings of the validators whose
+// blocks are proposed by this node.
+func ProvideProposerRegistry(in ProposerRegistryInput) (*proposer.Registry, error) {
+	return proposer.NewRegistry(
+		proposer.Settings{
+			FeeRecipient: in.Cfg.PayloadBuilder.SuggestedFeeRecipient,
+			Graffiti:     in.Cfg.Validator.Graffiti,
+		},
+		in.Cfg.PayloadBuilder.ProposerConfigFile,
+	)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/node-core/services/version"
 	"github.com/berachain/beacon-kit/node-core/types"
 	"github.com/berachain/beacon-kit/observability/telemetry"
+	"github.com/berachain/beacon-kit/observability/tracing"
 )
 
 // ServiceRegistryInput is the input for the service registry provider.
@@ -45,6 +46,7 @@ type ServiceRegistryInput struct {
 	ReportingService *version.ReportingService
 	TelemetrySink    *metrics.TelemetrySink
 	TelemetryService *telemetry.Service
+	TracingService   *tracing.Service
 	ValidatorService *validator.Service
 	CometBFTService  types.ConsensusService
 	ShutdownService  *shutdown.Service
@@ -64,6 +66,9 @@ func ProvideServiceRegistry(in ServiceRegistryInput) *service.Registry {
 		service.WithService(in.ReportingService),
 		service.WithService(in.TelemetryService),
 
+		// tracing must be set up before the services producing spans start
+		service.WithService(in.TracingService),
+
 		// engineClient will block until it connects to the execution layer
 		service.WithService(in.EngineClient),
 
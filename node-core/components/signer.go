@@ -44,35 +44,57 @@ type BlsSignerInput struct {
 
 // ProvideBlsSigner is a function that provides the module to the application.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
-	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
-		// if no private key is provided, use privval signer
-		homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
-		privValKeyFile := cast.ToString(
-			in.AppOpts.Get(beaconflags.PrivValidatorKeyFile),
-		)
-		privValStateFile := cast.ToString(
-			in.AppOpts.Get(beaconflags.PrivValidatorStateFile),
-		)
-		// If privValKeyFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValKeyFile) {
-			privValKeyFile = filepath.Join(homeDir, privValKeyFile)
-		}
-		// If privValStateFile is not an absolute path, join with homeDir
-		if !filepath.IsAbs(privValStateFile) {
-			privValStateFile = filepath.Join(homeDir, privValStateFile)
-		}
+	if in.PrivKey != [constants.BLSSecretKeyLength]byte{} {
+		return signer.NewLegacySigner(in.PrivKey)
+	}
 
-		// Check key file existence here as the error in NewBLSSigner is vague.
-		if _, err := os.Stat(privValKeyFile); os.IsNotExist(err) {
-			return nil, fmt.Errorf("key file does not exist at path: %s", privValKeyFile)
-		}
+	backend := cast.ToString(in.AppOpts.Get(beaconflags.SignerBackend))
+	if backend != "" && backend != signer.BackendLocal && backend != signer.BackendRemote {
+		return nil, fmt.Errorf("unknown signer backend: %s", backend)
+	}
 
-		// Check state file existence as the error in NewBLSSigner is vague.
-		if _, err := os.Stat(privValStateFile); os.IsNotExist(err) {
-			return nil, fmt.Errorf("state file does not exist at path: %s", privValStateFile)
-		}
+	// The remote signer holds the signing key, so no key is read from disk.
+	// CometBFT votes are signed by CometBFT's own private validator, which
+	// must then be an external signer for the same key served on its
+	// priv_validator_laddr.
+	if backend == signer.BackendRemote {
+		return signer.NewRemoteSigner(signer.Config{
+			Backend:       backend,
+			RemoteURL:     cast.ToString(in.AppOpts.Get(beaconflags.SignerRemoteURL)),
+			RemotePubkey:  cast.ToString(in.AppOpts.Get(beaconflags.SignerRemotePubkey)),
+			RemoteTimeout: cast.ToDuration(in.AppOpts.Get(beaconflags.SignerRemoteTimeout)),
+			TLSCAFile:     cast.ToString(in.AppOpts.Get(beaconflags.SignerTLSCAFile)),
+			TLSCertFile:   cast.ToString(in.AppOpts.Get(beaconflags.SignerTLSCertFile)),
+			TLSKeyFile:    cast.ToString(in.AppOpts.Get(beaconflags.SignerTLSKeyFile)),
+		})
+	}
 
-		return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
+	// if no private key is provided, use privval signer
+	homeDir := cast.ToString(in.AppOpts.Get(flags.FlagHome))
+	privValKeyFile := cast.ToString(
+		in.AppOpts.Get(beaconflags.PrivValidatorKeyFile),
+	)
+	privValStateFile := cast.ToString(
+		in.AppOpts.Get(beaconflags.PrivValidatorStateFile),
+	)
+	// If privValKeyFile is not an absolute path, join with homeDir
+	if !filepath.IsAbs(privValKeyFile) {
+		privValKeyFile = filepath.Join(homeDir, privValKeyFile)
 	}
-	return signer.NewLegacySigner(in.PrivKey)
+	// If privValStateFile is not an absolute path, join with homeDir
+	if !filepath.IsAbs(privValStateFile) {
+		privValStateFile = filepath.Join(homeDir, privValStateFile)
+	}
+
+	// Check key file existence here as the error in NewBLSSigner is vague.
+	if _, err := os.Stat(privValKeyFile); os.IsNotExist(err) {
+		return nil, fmt.Errorf("key file does not exist at path: %s", privValKeyFile)
+	}
+
+	// Check state file existence as the error in NewBLSSigner is vague.
+	if _, err := os.Stat(privValStateFile); os.IsNotExist(err) {
+		return nil, fmt.Errorf("state file does not exist at path: %s", privValStateFile)
+	}
+
+	return signer.NewBLSSigner(privValKeyFile, privValStateFile), nil
 }
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import "time"
+
+const (
+	// BackendLocal signs with the on-disk CometBFT private validator key.
+	BackendLocal = "local"
+	// BackendRemote signs with a Web3Signer-compatible remote signer, without
+	// reading any key from disk.
+	BackendRemote = "remote"
+
+	defaultRemoteTimeout = 2 * time.Second
+)
+
+// DefaultConfig returns the default configuration for the BLS signer.
+func DefaultConfig() Config {
+	return Config{
+		Backend:       BackendLocal,
+		RemoteTimeout: defaultRemoteTimeout,
+	}
+}
+
+// Config is the configuration for the BLS signer used for block proposals.
+type Config struct {
+	// Backend is the signer backend, either "local" or "remote".
+	Backend string `mapstructure:"backend"`
+	// RemoteURL is the base url of the remote signer.
+	RemoteURL string `mapstructure:"remote-url"`
+	// RemotePubkey is the hex encoded public key the remote signer signs with.
+	// If empty, the single key held by the remote signer is used.
+	RemotePubkey string `mapstructure:"remote-pubkey"`
+	// RemoteTimeout is the timeout for individual remote signer requests.
+	RemoteTimeout time.Duration `mapstructure:"remote-timeout"`
+	// TLSCAFile is the path to the CA certificate used to verify the remote
+	// signer. If empty, the system roots are used.
+	TLSCAFile string `mapstructure:"tls-ca-file"`
+	// TLSCertFile is the path to the client certificate presented to the
+	// remote signer for mutual TLS.
+	TLSCertFile string `mapstructure:"tls-cert-file"`
+	// TLSKeyFile is the path to the key of the client certificate.
+	TLSKeyFile string `mapstructure:"tls-key-file"`
+}
@@ -36,4 +36,25 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrRemoteURLRequired is returned when the remote signer backend is
+	// selected without a remote signer url.
+	ErrRemoteURLRequired = errors.New("remote signer url required")
+
+	// ErrRemoteKeyNotFound is returned when the remote signer does not hold
+	// the signing key.
+	ErrRemoteKeyNotFound = errors.New("remote signer signing key not found")
+
+	// ErrRemoteSigner is returned when the remote signer rejects a request.
+	ErrRemoteSigner = errors.New("remote signer request failed")
+
+	// ErrUntypedSigningRequest is returned when the remote or the protected
+	// signer is asked to sign bytes without a typed signing request.
+	ErrUntypedSigningRequest = errors.New(
+		"signer only signs typed signing requests",
+	)
+
+	// ErrMissingBlockHeader is returned when a block signing request does not
+	// carry the header of the block being signed.
+	ErrMissingBlockHeader = errors.New("block signing request without block header")
 )
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// SlashingProtectionDB records the blocks signed by a key.
+type SlashingProtectionDB interface {
+	// CheckAndRecordBlock records that pubkey signs the block with the given
+	// signing root at slot, failing if this could lead to a double proposal.
+	CheckAndRecordBlock(
+		pubkey crypto.BLSPubkey,
+		slot math.Slot,
+		signingRoot common.Root,
+		genesisValidatorsRoot common.Root,
+	) error
+	// SealBlock makes the record of the block with the given signing root
+	// signed by pubkey at slot final, so that no different block is signed at
+	// slot.
+	SealBlock(pubkey crypto.BLSPubkey, slot math.Slot, signingRoot common.Root) error
+	// Close closes the database.
+	Close() error
+}
+
+// ProtectedSigner wraps a BLS signer, checking block signing requests
+// against a slashing protection database before signing them.
+//
+// Randao reveals are passed through as they are a deterministic function of
+// the epoch and cannot conflict.
+type ProtectedSigner struct {
+	crypto.BLSSigner
+	db SlashingProtectionDB
+}
+
+// NewProtectedSigner creates a new ProtectedSigner.
+func NewProtectedSigner(
+	signer crypto.BLSSigner, db SlashingProtectionDB,
+) *ProtectedSigner {
+	return &ProtectedSigner{BLSSigner: signer, db: db}
+}
+
+// Sign refuses to sign bytes, as untyped requests cannot be checked against
+// the slashing protection database.
+func (s *ProtectedSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUntypedSigningRequest
+}
+
+// SignRequest signs the given request, recording signed blocks in the
+// slashing protection database first.
+func (s *ProtectedSigner) SignRequest(
+	req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	if req.Type == crypto.SigningTypeBlock {
+		if req.Block == nil {
+			return crypto.BLSSignature{}, ErrMissingBlockHeader
+		}
+		if err := s.db.CheckAndRecordBlock(
+			s.PublicKey(), req.Block.Slot, req.SigningRoot, req.GenesisValidatorsRoot,
+		); err != nil {
+			return crypto.BLSSignature{}, err
+		}
+	}
+	return crypto.SignRequest(s.BLSSigner, req)
+}
+
+// SealBlock makes the block with the given signing root signed at slot the
+// only block signed at slot, even in later rounds. It is used once a signed
+// block leaves the node other than through a CometBFT proposal, such as a
+// blinded block submitted to the relay of an external builder.
+func (s *ProtectedSigner) SealBlock(slot math.Slot, signingRoot common.Root) error {
+	return s.db.SealBlock(s.PublicKey(), slot, signingRoot)
+}
+
+// Close closes the slashing protection database.
+func (s *ProtectedSigner) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/slashing"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cosmosdb "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtectedSigner(t *testing.T) {
+	t.Parallel()
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	legacy, err := signer.NewLegacySigner(signer.LegacyKey(privKey.Bytes()))
+	require.NoError(t, err)
+	db := cosmosdb.NewMemDB()
+	protected := signer.NewProtectedSigner(legacy, slashing.NewStore(db))
+
+	blockRequest := func(root common.Root) *crypto.SigningRequest {
+		return &crypto.SigningRequest{
+			Type:        crypto.SigningTypeBlock,
+			SigningRoot: root,
+			Block:       &crypto.SigningBlockHeader{Slot: 10},
+		}
+	}
+
+	// A block signed before a restart is not replaced by a different one.
+	_, err = protected.SignRequest(blockRequest(common.Root{0x01}))
+	require.NoError(t, err)
+	restarted := signer.NewProtectedSigner(legacy, slashing.NewStore(db))
+	_, err = restarted.SignRequest(blockRequest(common.Root{0x01}))
+	require.NoError(t, err)
+	_, err = restarted.SignRequest(blockRequest(common.Root{0x02}))
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+
+	// Untyped requests would bypass the slashing protection database.
+	_, err = protected.Sign([]byte{0x02})
+	require.ErrorIs(t, err, signer.ErrUntypedSigningRequest)
+}
@@ -0,0 +1,372 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+// remoteSignPath is the Web3Signer eth2 signing endpoint, suffixed with the
+// hex encoded public key of the signing key.
+const remoteSignPath = "/api/v1/eth2/sign/"
+
+// remotePublicKeysPath is the Web3Signer endpoint listing the public keys of
+// the eth2 signing keys it holds.
+const remotePublicKeysPath = "/api/v1/eth2/publicKeys"
+
+// maxRemoteResponseSize bounds the size of a remote signer response body.
+const maxRemoteResponseSize = 1 << 16
+
+// RemoteSigner is a BLS signer that delegates signing to a Web3Signer
+// compatible HTTP signer. It only signs typed requests, so that the remote
+// signer can inspect what it is signing and apply its own slashing protection.
+type RemoteSigner struct {
+	signURL string
+	pubkey  crypto.BLSPubkey
+	client  *http.Client
+}
+
+// NewRemoteSigner creates a new RemoteSigner from the given configuration.
+// The signing key is the configured remote pubkey, which the remote signer
+// must hold, or else the single key the remote signer holds. No key is read
+// from disk.
+func NewRemoteSigner(cfg Config) (*RemoteSigner, error) {
+	if cfg.RemoteURL == "" {
+		return nil, ErrRemoteURLRequired
+	}
+	base, err := url.Parse(cfg.RemoteURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer url: %w", err)
+	}
+
+	var pubkey crypto.BLSPubkey
+	if cfg.RemotePubkey != "" {
+		if err = pubkey.UnmarshalText([]byte(cfg.RemotePubkey)); err != nil {
+			return nil, fmt.Errorf("invalid remote signer pubkey: %w", err)
+		}
+	}
+
+	tlsCfg, err := remoteTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{
+		Timeout:   cfg.RemoteTimeout,
+		Transport: &http.Transport{TLSClientConfig: tlsCfg},
+	}
+
+	keys, err := remotePublicKeys(client, base.JoinPath(remotePublicKeysPath).String())
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case cfg.RemotePubkey != "":
+		if !slices.Contains(keys, pubkey) {
+			return nil, errors.Wrapf(ErrRemoteKeyNotFound, "%s", pubkey)
+		}
+	case len(keys) == 1:
+		pubkey = keys[0]
+	default:
+		return nil, errors.Wrapf(
+			ErrRemoteKeyNotFound, "remote signer holds %d keys, configure the remote pubkey", len(keys),
+		)
+	}
+
+	return &RemoteSigner{
+		signURL: base.JoinPath(remoteSignPath, pubkey.String()).String(),
+		pubkey:  pubkey,
+		client:  client,
+	}, nil
+}
+
+// remotePublicKeys returns the public keys of the signing keys held by the
+// remote signer.
+func remotePublicKeys(client *http.Client, keysURL string) ([]crypto.BLSPubkey, error) {
+	httpReq, err := http.NewRequestWithContext(context.Background(), http.MethodGet, keysURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := doRemoteRequest(client, httpReq)
+	if err != nil {
+		return nil, err
+	}
+	var keys []crypto.BLSPubkey
+	if err = json.Unmarshal(respBody, &keys); err != nil {
+		return nil, fmt.Errorf("decoding remote signer public keys: %w", err)
+	}
+	return keys, nil
+}
+
+// doRemoteRequest sends the request to the remote signer, bounded by the
+// client timeout, and returns the body of a successful response.
+func doRemoteRequest(client *http.Client, httpReq *http.Request) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(httpReq.Context(), client.Timeout)
+	defer cancel()
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading remote signer response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(
+			ErrRemoteSigner, "status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody),
+		)
+	}
+	return respBody, nil
+}
+
+// remoteTLSConfig builds the TLS configuration of the remote signer client,
+// presenting a client certificate when one is configured.
+func remoteTLSConfig(cfg Config) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.TLSCAFile != "" {
+		pem, err := os.ReadFile(cfg.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading remote signer CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.TLSCAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading remote signer client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return tlsCfg, nil
+}
+
+// ========================== Implements BLS Signer ==========================
+
+// PublicKey returns the public key of the remote signing key.
+func (s *RemoteSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubkey
+}
+
+// Sign is not supported by the remote signer, which only signs typed
+// requests.
+func (s *RemoteSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUntypedSigningRequest
+}
+
+// SignRequest sends the signing request to the remote signer and verifies the
+// returned signature against the signing root.
+func (s *RemoteSigner) SignRequest(
+	req *crypto.SigningRequest,
+) (crypto.BLSSignature, error) {
+	body, err := json.Marshal(newRemoteSigningRequest(req))
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	httpReq, err := http.NewRequestWithContext(
+		context.Background(), http.MethodPost, s.signURL, bytes.NewReader(body),
+	)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	respBody, err := doRemoteRequest(s.client, httpReq)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+
+	sig, err := parseRemoteSignature(respBody)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if err = s.VerifySignature(s.pubkey, req.SigningRoot[:], sig); err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	return sig, nil
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (s *RemoteSigner) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignature(pubKey, msg, signature)
+}
+
+// parseRemoteSignature parses a signature returned either as JSON or, as
+// Web3Signer does by default, as plain hex text.
+func parseRemoteSignature(body []byte) (crypto.BLSSignature, error) {
+	var sig crypto.BLSSignature
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '{' {
+		var resp struct {
+			Signature crypto.BLSSignature `json:"signature"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return sig, fmt.Errorf("decoding remote signer response: %w", err)
+		}
+		return resp.Signature, nil
+	}
+	if err := sig.UnmarshalText(body); err != nil {
+		return sig, fmt.Errorf("decoding remote signer response: %w", err)
+	}
+	return sig, nil
+}
+
+// remoteSigningRequest is the Web3Signer eth2 signing request body.
+type remoteSigningRequest struct {
+	Type         crypto.SigningType  `json:"type"`
+	ForkInfo     remoteForkInfo      `json:"fork_info"`
+	SigningRoot  common.Root         `json:"signingRoot"`
+	RandaoReveal *remoteRandaoReveal `json:"randao_reveal,omitempty"`
+	BeaconBlock  *remoteBlockRequest `json:"beacon_block,omitempty"`
+
+	ValidatorRegistration *remoteValidatorRegistration `json:"validator_registration,omitempty"`
+	Deposit               *remoteDeposit               `json:"deposit,omitempty"`
+}
+
+type remoteForkInfo struct {
+	Fork                  remoteFork  `json:"fork"`
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+}
+
+type remoteFork struct {
+	PreviousVersion common.Version `json:"previous_version"`
+	CurrentVersion  common.Version `json:"current_version"`
+	Epoch           string         `json:"epoch"`
+}
+
+type remoteRandaoReveal struct {
+	Epoch string `json:"epoch"`
+}
+
+type remoteBlockRequest struct {
+	Version     string            `json:"version"`
+	BlockHeader remoteBlockHeader `json:"block_header"`
+}
+
+type remoteBlockHeader struct {
+	Slot          string      `json:"slot"`
+	ProposerIndex string      `json:"proposer_index"`
+	ParentRoot    common.Root `json:"parent_root"`
+	StateRoot     common.Root `json:"state_root"`
+	BodyRoot      common.Root `json:"body_root"`
+}
+
+type remoteValidatorRegistration struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     string                  `json:"gas_limit"`
+	Timestamp    string                  `json:"timestamp"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
+
+type remoteDeposit struct {
+	Pubkey                crypto.BLSPubkey `json:"pubkey"`
+	WithdrawalCredentials common.Bytes32   `json:"withdrawal_credentials"`
+	Amount                string           `json:"amount"`
+	GenesisForkVersion    common.Version   `json:"genesis_fork_version"`
+}
+
+// newRemoteSigningRequest converts a signing request into its Web3Signer
+// representation. Beacon-kit domains only depend on the current fork version,
+// so the fork is reported as active since genesis.
+func newRemoteSigningRequest(req *crypto.SigningRequest) *remoteSigningRequest {
+	r := &remoteSigningRequest{
+		Type: req.Type,
+		ForkInfo: remoteForkInfo{
+			Fork: remoteFork{
+				PreviousVersion: req.ForkVersion,
+				CurrentVersion:  req.ForkVersion,
+				Epoch:           "0",
+			},
+			GenesisValidatorsRoot: req.GenesisValidatorsRoot,
+		},
+		SigningRoot: req.SigningRoot,
+	}
+	switch req.Type {
+	case crypto.SigningTypeRandaoReveal:
+		r.RandaoReveal = &remoteRandaoReveal{Epoch: req.Epoch.Base10()}
+	case crypto.SigningTypeBlock:
+		if req.Block != nil {
+			r.BeaconBlock = &remoteBlockRequest{
+				Version: remoteBlockVersion(req.ForkVersion),
+				BlockHeader: remoteBlockHeader{
+					Slot:          req.Block.Slot.Base10(),
+					ProposerIndex: req.Block.ProposerIndex.Base10(),
+					ParentRoot:    req.Block.ParentRoot,
+					StateRoot:     req.Block.StateRoot,
+					BodyRoot:      req.Block.BodyRoot,
+				},
+			}
+		}
+	case crypto.SigningTypeValidatorRegistration:
+		if reg := req.ValidatorRegistration; reg != nil {
+			r.ValidatorRegistration = &remoteValidatorRegistration{
+				FeeRecipient: reg.FeeRecipient,
+				GasLimit:     reg.GasLimit.Base10(),
+				Timestamp:    reg.Timestamp.Base10(),
+				Pubkey:       reg.Pubkey,
+			}
+		}
+	case crypto.SigningTypeDeposit:
+		if dep := req.Deposit; dep != nil {
+			r.Deposit = &remoteDeposit{
+				Pubkey:                dep.Pubkey,
+				WithdrawalCredentials: dep.WithdrawalCredentials,
+				Amount:                dep.Amount.Base10(),
+				GenesisForkVersion:    dep.GenesisForkVersion,
+			}
+		}
+	}
+	return r
+}
+
+// remoteBlockVersion returns the Web3Signer block version for the given fork
+// version. Beacon-kit forks map onto the Ethereum fork they extend.
+func remoteBlockVersion(forkVersion common.Version) string {
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		return "ELECTRA"
+	}
+	return "DENEB"
+}
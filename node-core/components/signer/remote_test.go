@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteRequest is the subset of the Web3Signer signing request checked by
+// the stand-in signer.
+type remoteRequest struct {
+	Type        string      `json:"type"`
+	SigningRoot common.Root `json:"signingRoot"`
+	ForkInfo    struct {
+		Fork struct {
+			CurrentVersion common.Version `json:"current_version"`
+		} `json:"fork"`
+		GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+	} `json:"fork_info"`
+	RandaoReveal *struct {
+		Epoch string `json:"epoch"`
+	} `json:"randao_reveal"`
+	BeaconBlock *struct {
+		Version     string `json:"version"`
+		BlockHeader struct {
+			Slot          string `json:"slot"`
+			ProposerIndex string `json:"proposer_index"`
+		} `json:"block_header"`
+	} `json:"beacon_block"`
+	Deposit *struct {
+		Pubkey                crypto.BLSPubkey `json:"pubkey"`
+		WithdrawalCredentials common.Bytes32   `json:"withdrawal_credentials"`
+		Amount                string           `json:"amount"`
+		GenesisForkVersion    common.Version   `json:"genesis_fork_version"`
+	} `json:"deposit"`
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	pubkey, ok := privKey.PubKey().(bls12381.PubKey)
+	require.True(t, ok)
+	pubkeyHex := crypto.BLSPubkey(pubkey.Compress()).String()
+
+	gvr := common.Root{0x01}
+	randaoReq := &crypto.SigningRequest{
+		Type:                  crypto.SigningTypeRandaoReveal,
+		SigningRoot:           common.Root{0xaa},
+		ForkVersion:           version.Electra(),
+		GenesisValidatorsRoot: gvr,
+		Epoch:                 3,
+	}
+	blockReq := &crypto.SigningRequest{
+		Type:                  crypto.SigningTypeBlock,
+		SigningRoot:           common.Root{0xbb},
+		ForkVersion:           version.Deneb1(),
+		GenesisValidatorsRoot: gvr,
+		Block: &crypto.SigningBlockHeader{
+			Slot:          10,
+			ProposerIndex: 2,
+		},
+	}
+
+	var (
+		lastReq   remoteRequest
+		plainText bool
+		badRoot   bool
+		failure   bool
+		heldKeys  = []string{pubkeyHex}
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/eth2/publicKeys", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(heldKeys)
+	})
+	mux.HandleFunc("POST /api/v1/eth2/sign/{pubkey}", func(w http.ResponseWriter, r *http.Request) {
+		if failure {
+			http.Error(w, "slashing protection triggered", http.StatusPreconditionFailed)
+			return
+		}
+		if r.PathValue("pubkey") != pubkeyHex {
+			http.Error(w, "unknown key", http.StatusNotFound)
+			return
+		}
+		lastReq = remoteRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&lastReq); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		root := lastReq.SigningRoot
+		if badRoot {
+			root = common.Root{0xff}
+		}
+		sigBz, err := privKey.Sign(root[:])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sig := crypto.BLSSignature(sigBz)
+		if plainText {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(sig.String()))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"signature": sig.String()})
+	})
+
+	// Serve over mutual TLS, trusting only the client certificate below.
+	dir := t.TempDir()
+	certFile, keyFile, clientCert := writeClientCert(t, dir)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	srv := httptest.NewUnstartedServer(mux)
+	srv.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{
+		Type: "CERTIFICATE", Bytes: srv.Certificate().Raw,
+	}), 0o600))
+
+	cfg := signer.Config{
+		Backend:       signer.BackendRemote,
+		RemoteURL:     srv.URL,
+		RemotePubkey:  pubkeyHex,
+		RemoteTimeout: time.Second,
+		TLSCAFile:     caFile,
+		TLSCertFile:   certFile,
+		TLSKeyFile:    keyFile,
+	}
+	s, err := signer.NewRemoteSigner(cfg)
+	require.NoError(t, err)
+	require.Equal(t, pubkeyHex, s.PublicKey().String())
+
+	// Without a configured pubkey, the single key held remotely is used.
+	noPubkey := cfg
+	noPubkey.RemotePubkey = ""
+	s, err = signer.NewRemoteSigner(noPubkey)
+	require.NoError(t, err)
+	require.Equal(t, pubkeyHex, s.PublicKey().String())
+
+	// The signing key must be held remotely and unambiguous.
+	otherKey := crypto.BLSPubkey{0x01}.String()
+	heldKeys = []string{otherKey}
+	_, err = signer.NewRemoteSigner(cfg)
+	require.ErrorIs(t, err, signer.ErrRemoteKeyNotFound)
+	heldKeys = []string{pubkeyHex, otherKey}
+	_, err = signer.NewRemoteSigner(noPubkey)
+	require.ErrorIs(t, err, signer.ErrRemoteKeyNotFound)
+	s, err = signer.NewRemoteSigner(cfg)
+	require.NoError(t, err)
+
+	// Randao reveals carry the epoch.
+	sig, err := crypto.SignRequest(s, randaoReq)
+	require.NoError(t, err)
+	require.NoError(t, s.VerifySignature(s.PublicKey(), randaoReq.SigningRoot[:], sig))
+	require.Equal(t, string(crypto.SigningTypeRandaoReveal), lastReq.Type)
+	require.Equal(t, randaoReq.SigningRoot, lastReq.SigningRoot)
+	require.Equal(t, version.Electra(), lastReq.ForkInfo.Fork.CurrentVersion)
+	require.Equal(t, gvr, lastReq.ForkInfo.GenesisValidatorsRoot)
+	require.NotNil(t, lastReq.RandaoReveal)
+	require.Equal(t, "3", lastReq.RandaoReveal.Epoch)
+	require.Nil(t, lastReq.BeaconBlock)
+
+	// Block proposals carry the block header, and plain text responses are
+	// accepted.
+	plainText = true
+	sig, err = s.SignRequest(blockReq)
+	require.NoError(t, err)
+	require.NoError(t, s.VerifySignature(s.PublicKey(), blockReq.SigningRoot[:], sig))
+	require.Equal(t, string(crypto.SigningTypeBlock), lastReq.Type)
+	require.NotNil(t, lastReq.BeaconBlock)
+	require.Equal(t, "DENEB", lastReq.BeaconBlock.Version)
+	require.Equal(t, "10", lastReq.BeaconBlock.BlockHeader.Slot)
+	require.Equal(t, "2", lastReq.BeaconBlock.BlockHeader.ProposerIndex)
+	require.Nil(t, lastReq.RandaoReveal)
+
+	// Deposit messages carry the deposit data, so deposit creation and
+	// genesis deposits can be signed remotely.
+	plainText = false
+	credentials := types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{0x02})
+	msg, sig, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(version.Deneb(), common.Root{}),
+		common.DomainType{0x03},
+		s,
+		credentials,
+		32e9,
+	)
+	require.NoError(t, err)
+	require.Equal(t, s.PublicKey(), msg.Pubkey)
+	require.Equal(t, string(crypto.SigningTypeDeposit), lastReq.Type)
+	require.NoError(t, s.VerifySignature(s.PublicKey(), lastReq.SigningRoot[:], sig))
+	require.NotNil(t, lastReq.Deposit)
+	require.Equal(t, s.PublicKey(), lastReq.Deposit.Pubkey)
+	require.Equal(t, common.Bytes32(credentials), lastReq.Deposit.WithdrawalCredentials)
+	require.Equal(t, "32000000000", lastReq.Deposit.Amount)
+	require.Equal(t, version.Deneb(), lastReq.Deposit.GenesisForkVersion)
+	require.Nil(t, lastReq.BeaconBlock)
+
+	// Signatures over a different root are rejected.
+	badRoot = true
+	_, err = s.SignRequest(blockReq)
+	require.ErrorIs(t, err, signer.ErrInvalidSignature)
+	badRoot = false
+
+	// Refusals by the remote signer are surfaced.
+	failure = true
+	_, err = s.SignRequest(blockReq)
+	require.ErrorIs(t, err, signer.ErrRemoteSigner)
+	require.Contains(t, err.Error(), "slashing protection triggered")
+	failure = false
+
+	// Untyped signing is not supported.
+	_, err = s.Sign(randaoReq.SigningRoot[:])
+	require.ErrorIs(t, err, signer.ErrUntypedSigningRequest)
+
+	// Without a client certificate the handshake fails.
+	cfg.TLSCertFile, cfg.TLSKeyFile = "", ""
+	_, err = signer.NewRemoteSigner(cfg)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, signer.ErrRemoteSigner)
+}
+
+func TestNewRemoteSigner(t *testing.T) {
+	t.Parallel()
+	_, err := signer.NewRemoteSigner(signer.Config{})
+	require.ErrorIs(t, err, signer.ErrRemoteURLRequired)
+
+	_, err = signer.NewRemoteSigner(signer.Config{
+		RemoteURL:    "http://localhost:9000",
+		RemotePubkey: "0x1234",
+	})
+	require.ErrorContains(t, err, "invalid remote signer pubkey")
+}
+
+// writeClientCert writes a self-signed client certificate and its key to dir.
+func writeClientCert(t *testing.T, dir string) (string, string, *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "beacond"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{
+		Type: "CERTIFICATE", Bytes: der,
+	}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{
+		Type: "EC PRIVATE KEY", Bytes: keyDer,
+	}), 0o600))
+	return certFile, keyFile, cert
+}
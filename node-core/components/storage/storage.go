@@ -24,7 +24,6 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/chain"
-	"github.com/berachain/beacon-kit/consensus-types/types"
 	dastore "github.com/berachain/beacon-kit/da/store"
 	"github.com/berachain/beacon-kit/log"
 	statedb "github.com/berachain/beacon-kit/state-transition/core/state"
@@ -40,7 +39,7 @@ type Backend struct {
 	availabilityStore *dastore.Store
 	kvStore           *beacondb.KVStore
 	depositStore      deposit.StoreManager
-	blockStore        *block.KVStore[*types.BeaconBlock]
+	blockStore        *block.KVStore
 	logger            log.Logger
 	telemetrySink     statedb.TelemetrySink
 }
@@ -50,7 +49,7 @@ func NewBackend(
 	availabilityStore *dastore.Store,
 	kvStore *beacondb.KVStore,
 	depositStore deposit.StoreManager,
-	blockStore *block.KVStore[*types.BeaconBlock],
+	blockStore *block.KVStore,
 	logger log.Logger,
 	telemetrySink statedb.TelemetrySink,
 ) *Backend {
@@ -87,7 +86,7 @@ func (k Backend) BeaconStore() *beacondb.KVStore {
 	return k.kvStore
 }
 
-func (k Backend) BlockStore() *block.KVStore[*types.BeaconBlock] {
+func (k Backend) BlockStore() *block.KVStore {
 	return k.blockStore
 }
 
@@ -22,25 +22,34 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/beacon/blockchain"
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
 	"github.com/berachain/beacon-kit/node-core/components/storage"
+	"github.com/berachain/beacon-kit/payload/proposer"
+	"github.com/berachain/beacon-kit/payload/relay"
 	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/storage/slashing"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
 type ValidatorServiceInput struct {
 	depinject.In
 	Cfg            *config.Config
+	ChainService   *blockchain.Service
 	ChainSpec      chain.Spec
 	LocalBuilder   LocalBuilder
 	Logger         *phuslu.Logger
+	Proposers      *proposer.Registry
+	Relays         *relay.Relays
 	StateProcessor StateProcessor
 	StorageBackend *storage.Backend
 	Signer         crypto.BLSSigner
+	SlashingDB     *slashing.Store
 	SidecarFactory SidecarFactory
 	TelemetrySink  *metrics.TelemetrySink
 }
@@ -53,10 +62,13 @@ func ProvideValidatorService(in ValidatorServiceInput) (*validator.Service, erro
 		in.Logger.With("service", "validator"),
 		in.ChainSpec,
 		in.StorageBackend,
+		in.ChainService,
 		in.StateProcessor,
-		in.Signer,
+		signer.NewProtectedSigner(in.Signer, in.SlashingDB),
 		in.SidecarFactory,
 		in.LocalBuilder,
+		in.Relays,
+		in.Proposers,
 		in.TelemetrySink,
 	), nil
 }
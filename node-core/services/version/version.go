@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"strings"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
@@ -31,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/execution/client"
 	"github.com/berachain/beacon-kit/execution/client/ethclient"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 // defaultReportingInterval is the default interval at which the version is
@@ -150,10 +152,7 @@ func (rs *ReportingService) printToConsole(
 	+ 🧩 Your node is running version: %-40s+
 	+ ♦ Eth client: %-59s+
 	+ 💾 Your system: %-57s+
-	+ 🍴 Deneb1 Fork Time: %-52d+
-	+ 🍴 Electra Fork Time: %-51d+
-	+ 🍴 Electra1 Fork Time: %-50d+
-	+ 🦺 Please report issues @ https://github.com/berachain/beacon-kit/issues +
+%s	+ 🦺 Please report issues @ https://github.com/berachain/beacon-kit/issues +
 	+==========================================================================+
 
 
@@ -161,12 +160,24 @@ func (rs *ReportingService) printToConsole(
 		rs.version,
 		fmt.Sprintf("%s (version: %s)", ethClient.Name, ethClient.Version),
 		runtime.GOOS+"/"+runtime.GOARCH,
-		rs.forkSpec.Deneb1ForkTime(),
-		rs.forkSpec.ElectraForkTime(),
-		rs.forkSpec.Electra1ForkTime(),
+		rs.forkTimes(),
 	))
 }
 
+// forkTimes returns the console lines of the fork times of the forks after
+// genesis.
+func (rs *ReportingService) forkTimes() string {
+	var lines strings.Builder
+	for _, fork := range rs.forkSpec.ForkSchedule()[1:] {
+		name := version.Name(fork.Version)
+		lines.WriteString(fmt.Sprintf(
+			"\t+ 🍴 %-70s+\n",
+			fmt.Sprintf("%s Fork Time: %d", strings.ToUpper(name[:1])+name[1:], fork.Time),
+		))
+	}
+	return lines.String()
+}
+
 func (rs *ReportingService) GetEthVersion(
 	ctx context.Context) (engineprimitives.ClientVersionV1, error) {
 	ethVersion := engineprimitives.ClientVersionV1{
@@ -25,7 +25,9 @@ import (
 
 	"cosmossdk.io/store"
 	"github.com/berachain/beacon-kit/beacon/blockchain"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	service "github.com/berachain/beacon-kit/node-core/services/registry"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -58,4 +60,20 @@ type ConsensusService interface {
 		prove bool,
 	) (sdk.Context, error)
 	LastBlockHeight() int64
+	// IsSyncing returns true if the node is catching up with the chain.
+	IsSyncing() bool
+	// SyncingToHeight returns the height of the tip of the chain.
+	SyncingToHeight() int64
+	// UpcomingProposers returns the projected proposers of the next count
+	// blocks, together with the height of the first of them.
+	UpcomingProposers(count int) (int64, []crypto.BLSPubkey, error)
+	// LightClientCommit returns the CometBFT commit of the block at the
+	// given height.
+	LightClientCommit(height int64) (*ctypes.LightClientCommit, error)
+	// LightClientValidators returns the CometBFT validator set which signs
+	// the block at the given height.
+	LightClientValidators(height int64) ([]*ctypes.LightClientValidator, error)
+	// LightClientCometBFTHeader returns the header of the CometBFT block at
+	// the given height, together with the proof of its beacon block.
+	LightClientCometBFTHeader(height int64) (*ctypes.LightClientCometBFTHeader, error)
 }
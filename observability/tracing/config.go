@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+const (
+	// ExporterOTLPGRPC exports the spans to an OTLP collector over gRPC.
+	ExporterOTLPGRPC = "otlp-grpc"
+	// ExporterOTLPHTTP exports the spans to an OTLP collector over HTTP.
+	ExporterOTLPHTTP = "otlp-http"
+
+	defaultEndpoint    = "localhost:4317"
+	defaultServiceName = "beacond"
+	defaultSampleRatio = 1.0
+)
+
+// DefaultConfig returns the default configuration for tracing, which is
+// disabled.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:     false,
+		Exporter:    ExporterOTLPGRPC,
+		Endpoint:    defaultEndpoint,
+		Insecure:    true,
+		ServiceName: defaultServiceName,
+		SampleRatio: defaultSampleRatio,
+	}
+}
+
+// Config is the configuration for OpenTelemetry tracing.
+type Config struct {
+	// Enabled determines if the spans are exported.
+	Enabled bool `mapstructure:"enabled"`
+	// Exporter is the protocol used to export the spans, either "otlp-grpc"
+	// or "otlp-http".
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the host and port of the OTLP collector.
+	Endpoint string `mapstructure:"endpoint"`
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool `mapstructure:"insecure"`
+	// ServiceName is the service name the spans are reported under.
+	ServiceName string `mapstructure:"service-name"`
+	// SampleRatio is the fraction of the traces which are sampled, between
+	// 0 and 1.
+	SampleRatio float64 `mapstructure:"sample-ratio"`
+}
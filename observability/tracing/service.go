@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/berachain/beacon-kit/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// shutdownTimeout bounds the time spent flushing the pending spans on stop.
+const shutdownTimeout = 5 * time.Second
+
+// Service installs the global tracer provider, exporting the spans of the
+// node to the configured OTLP collector.
+type Service struct {
+	// cfg is the tracing configuration.
+	cfg Config
+	// logger is used for logging.
+	logger log.Logger
+	// exporter overrides the exporter built from the configuration, if set.
+	exporter sdktrace.SpanExporter
+	// provider is the installed tracer provider, nil until started.
+	provider *sdktrace.TracerProvider
+}
+
+// NewService creates a new tracing service.
+func NewService(cfg Config, logger log.Logger, opts ...Option) (*Service, error) {
+	if cfg.Enabled {
+		switch cfg.Exporter {
+		case ExporterOTLPGRPC, ExporterOTLPHTTP:
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnknownExporter, cfg.Exporter)
+		}
+		if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidSampleRatio, cfg.SampleRatio)
+		}
+	}
+
+	s := &Service{
+		cfg:    cfg,
+		logger: logger,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return "tracing"
+}
+
+// Start installs the tracer provider if tracing is enabled.
+func (s *Service) Start(ctx context.Context) error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+
+	exporter := s.exporter
+	if exporter == nil {
+		var err error
+		if exporter, err = s.newExporter(ctx); err != nil {
+			return err
+		}
+	}
+
+	resource, err := sdkresource.Merge(
+		sdkresource.Default(),
+		sdkresource.NewWithAttributes(
+			semconv.SchemaURL, semconv.ServiceName(s.cfg.ServiceName),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	s.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource),
+		sdktrace.WithSampler(sdktrace.ParentBased(
+			sdktrace.TraceIDRatioBased(s.cfg.SampleRatio),
+		)),
+	)
+	otel.SetTracerProvider(s.provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	s.logger.Info(
+		"Exporting traces",
+		"exporter", s.cfg.Exporter,
+		"endpoint", s.cfg.Endpoint,
+		"sample_ratio", s.cfg.SampleRatio,
+	)
+	return nil
+}
+
+// Stop flushes the pending spans and shuts the tracer provider down.
+func (s *Service) Stop() error {
+	if s.provider == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.provider.Shutdown(ctx)
+}
+
+// newExporter builds the OTLP exporter of the configured protocol.
+func (s *Service) newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	switch s.cfg.Exporter {
+	case ExporterOTLPGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(s.cfg.Endpoint)}
+		if s.cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ExporterOTLPHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(s.cfg.Endpoint)}
+		if s.cfg.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownExporter, s.cfg.Exporter)
+	}
+}
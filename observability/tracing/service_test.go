@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// inMemoryExporter is an in-memory exporter whose spans outlive the shutdown
+// of the tracer provider.
+type inMemoryExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+// Shutdown does not reset the exported spans.
+func (inMemoryExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestNewServiceInvalidConfig(t *testing.T) {
+	t.Parallel()
+	logger := noop.NewLogger[any]()
+
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.Exporter = "zipkin"
+	_, err := tracing.NewService(cfg, logger)
+	require.ErrorIs(t, err, tracing.ErrUnknownExporter)
+
+	cfg = tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.SampleRatio = 1.5
+	_, err = tracing.NewService(cfg, logger)
+	require.ErrorIs(t, err, tracing.ErrInvalidSampleRatio)
+
+	// The configuration is not checked when tracing is disabled.
+	cfg.Enabled = false
+	s, err := tracing.NewService(cfg, logger)
+	require.NoError(t, err)
+	require.NoError(t, s.Start(context.Background()))
+	require.NoError(t, s.Stop())
+}
+
+func TestServiceExportsSpans(t *testing.T) {
+	t.Parallel()
+	exporter := inMemoryExporter{tracetest.NewInMemoryExporter()}
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = true
+	cfg.ServiceName = "beacond-test"
+	s, err := tracing.NewService(
+		cfg, noop.NewLogger[any](), tracing.WithExporter(exporter),
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.Start(context.Background()))
+
+	ctx, parent := tracing.Start(context.Background(), "parent")
+	_, child := tracing.Start(ctx, "child")
+	tracing.End(child, errors.New("child failed"))
+	tracing.End(parent, nil)
+
+	// Stopping the service flushes the pending spans.
+	require.NoError(t, s.Stop())
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, "parent", spans[1].Name)
+	require.Equal(t, spans[1].SpanContext.TraceID(), spans[0].SpanContext.TraceID())
+	require.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	require.Equal(t, codes.Error, spans[0].Status.Code)
+	require.Len(t, spans[0].Events, 1)
+	require.Equal(t, codes.Unset, spans[1].Status.Code)
+	require.Contains(t, spans[1].Resource.Attributes(), semconv.ServiceName("beacond-test"))
+}
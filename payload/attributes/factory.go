@@ -34,21 +34,26 @@ type Factory struct {
 	chainSpec ChainSpec
 	// logger is the logger for the attributes factory.
 	logger log.Logger
-	// suggestedFeeRecipient is the suggested fee recipient sent to
-	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	// proposers provides the fee recipient sent to the execution client
+	// for the payload build.
+	proposers Proposers
+	// proposerPubkey is the public key of the validator proposing the
+	// payloads built by this node.
+	proposerPubkey crypto.BLSPubkey
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
 func NewAttributesFactory(
 	chainSpec ChainSpec,
 	logger log.Logger,
-	suggestedFeeRecipient common.ExecutionAddress,
+	proposers Proposers,
+	proposerPubkey crypto.BLSPubkey,
 ) *Factory {
 	return &Factory{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+		chainSpec:      chainSpec,
+		logger:         logger,
+		proposers:      proposers,
+		proposerPubkey: proposerPubkey,
 	}
 }
 
@@ -64,7 +69,7 @@ func (f *Factory) BuildPayloadAttributes(
 		f.chainSpec.ActiveForkVersionForTimestamp(timestamp),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.proposers.FeeRecipient(f.proposerPubkey),
 		payloadWithdrawals,
 		prevHeadRoot,
 		parentProposerPubkey,
@@ -22,6 +22,7 @@ package attributes
 
 import (
 	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 )
 
@@ -30,3 +31,10 @@ type ChainSpec interface {
 	EpochsPerHistoricalVector() uint64
 	SlotToEpoch(slot math.Slot) math.Epoch
 }
+
+// Proposers provides the settings of the validators proposing payloads.
+type Proposers interface {
+	// FeeRecipient returns the fee recipient of the validator with the given
+	// pubkey.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+}
@@ -39,6 +39,10 @@ type Config struct {
 	// SuggestedFeeRecipient is the address that will receive the transaction
 	// fees produced by any blocks from this node.
 	SuggestedFeeRecipient common.ExecutionAddress `mapstructure:"suggested-fee-recipient"`
+	// ProposerConfigFile is the path to an optional JSON file setting the
+	// fee recipient, gas limit and graffiti of each validator, overriding
+	// the node defaults.
+	ProposerConfigFile string `mapstructure:"proposer-config-file"`
 	// PayloadTimeout is the timeout parameter for local build
 	// payload. This should match, or be slightly less than the configured
 	// timeout on your execution client. It also must be less than
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/bytes"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// configFile is the proposer config file. Every field of its entries is
+// optional, as in
+//
+//	{
+//	  "proposer_config": {
+//	    "0xa1b2...": {
+//	      "fee_recipient": "0x50f1...",
+//	      "gas_limit": "36000000",
+//	      "graffiti": "validator 1"
+//	    }
+//	  },
+//	  "default_config": {
+//	    "fee_recipient": "0x6e36..."
+//	  }
+//	}
+type configFile struct {
+	ProposerConfig map[crypto.BLSPubkey]*configEntry `json:"proposer_config"`
+	DefaultConfig  *configEntry                      `json:"default_config"`
+}
+
+// configEntry overrides the settings of a validator.
+type configEntry struct {
+	FeeRecipient *common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     *uint64                  `json:"gas_limit,string"`
+	Graffiti     *string                  `json:"graffiti"`
+}
+
+// loadConfigFile reads the proposer config file at path.
+func loadConfigFile(path string) (*configFile, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f configFile
+	if err = json.Unmarshal(bz, &f); err != nil {
+		return nil, errors.Wrapf(ErrInvalidConfigFile, "%s: %v", path, err)
+	}
+	if err = f.DefaultConfig.validate(); err != nil {
+		return nil, errors.Wrapf(err, "%s: default_config", path)
+	}
+	for pubkey, entry := range f.ProposerConfig {
+		if err = entry.validate(); err != nil {
+			return nil, errors.Wrapf(err, "%s: proposer_config %s", path, pubkey)
+		}
+	}
+	return &f, nil
+}
+
+// validate checks that the settings of the entry can be used.
+func (e *configEntry) validate() error {
+	if e == nil || e.Graffiti == nil {
+		return nil
+	}
+	return ValidateGraffiti(*e.Graffiti)
+}
+
+// apply overrides settings with the fields set in the entry.
+func (e *configEntry) apply(settings *Settings) {
+	if e == nil {
+		return
+	}
+	if e.FeeRecipient != nil {
+		settings.FeeRecipient = *e.FeeRecipient
+	}
+	if e.GasLimit != nil {
+		settings.GasLimit = *e.GasLimit
+	}
+	if e.Graffiti != nil {
+		settings.Graffiti = *e.Graffiti
+	}
+}
+
+// ValidateGraffiti checks that the graffiti fits in the graffiti field of a
+// block.
+func ValidateGraffiti(graffiti string) error {
+	if len(graffiti) > bytes.B32Size {
+		return errors.Wrapf(
+			ErrGraffitiTooLong, "%d bytes, at most %d allowed", len(graffiti), bytes.B32Size,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// Settings are the settings of the blocks proposed by a validator.
+type Settings struct {
+	// FeeRecipient is the address receiving the fees of the execution
+	// payloads.
+	FeeRecipient common.ExecutionAddress
+	// GasLimit is the gas limit registered with builder relays. Zero leaves
+	// it to the relay configuration.
+	GasLimit uint64
+	// Graffiti is included in the blocks.
+	Graffiti string
+}
+
+// Registry holds the settings of the validators whose blocks are proposed by
+// this node. The settings of a validator are, in order of precedence:
+//
+//  1. the fee recipient prepared through the node API,
+//  2. the entry of the validator in the proposer config file,
+//  3. the default entry of the proposer config file,
+//  4. the node defaults.
+type Registry struct {
+	// defaults are the node defaults, overridden by the default entry of the
+	// config file.
+	defaults Settings
+	// file is the proposer config file, if any.
+	file *configFile
+
+	mu sync.RWMutex
+	// prepared are the fee recipients prepared through the node API.
+	prepared map[crypto.BLSPubkey]common.ExecutionAddress
+}
+
+// NewRegistry creates a new Registry with the given node defaults, loading
+// the proposer config file at configFilePath if set.
+func NewRegistry(defaults Settings, configFilePath string) (*Registry, error) {
+	if err := ValidateGraffiti(defaults.Graffiti); err != nil {
+		return nil, err
+	}
+	r := &Registry{
+		defaults: defaults,
+		prepared: make(map[crypto.BLSPubkey]common.ExecutionAddress),
+	}
+	if configFilePath == "" {
+		return r, nil
+	}
+
+	f, err := loadConfigFile(configFilePath)
+	if err != nil {
+		return nil, err
+	}
+	f.DefaultConfig.apply(&r.defaults)
+	r.file = f
+	return r, nil
+}
+
+// Get returns the settings of the validator with the given pubkey.
+func (r *Registry) Get(pubkey crypto.BLSPubkey) Settings {
+	settings := r.defaults
+	if r.file != nil {
+		r.file.ProposerConfig[pubkey].apply(&settings)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if feeRecipient, ok := r.prepared[pubkey]; ok {
+		settings.FeeRecipient = feeRecipient
+	}
+	return settings
+}
+
+// FeeRecipient returns the fee recipient of the validator with the given
+// pubkey.
+func (r *Registry) FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress {
+	return r.Get(pubkey).FeeRecipient
+}
+
+// GasLimit returns the gas limit of the validator with the given pubkey, or
+// zero if unset.
+func (r *Registry) GasLimit(pubkey crypto.BLSPubkey) uint64 {
+	return r.Get(pubkey).GasLimit
+}
+
+// Graffiti returns the graffiti of the validator with the given pubkey.
+func (r *Registry) Graffiti(pubkey crypto.BLSPubkey) string {
+	return r.Get(pubkey).Graffiti
+}
+
+// PrepareProposer sets the fee recipient of the validator with the given
+// pubkey, overriding the config file until the node restarts.
+func (r *Registry) PrepareProposer(
+	pubkey crypto.BLSPubkey, feeRecipient common.ExecutionAddress,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.prepared[pubkey] = feeRecipient
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proposer_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/payload/proposer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+	var (
+		configured   = crypto.BLSPubkey{0x01}
+		unconfigured = crypto.BLSPubkey{0x02}
+		defaults     = proposer.Settings{
+			FeeRecipient: common.ExecutionAddress{0xaa},
+			Graffiti:     "node",
+		}
+	)
+	path := writeConfigFile(t, `{
+		"proposer_config": {
+			"`+configured.String()+`": {
+				"fee_recipient": "0xbb00000000000000000000000000000000000000",
+				"gas_limit": "36000000",
+				"graffiti": "validator"
+			}
+		},
+		"default_config": {
+			"fee_recipient": "0xcc00000000000000000000000000000000000000"
+		}
+	}`)
+
+	r, err := proposer.NewRegistry(defaults, path)
+	require.NoError(t, err)
+
+	// The entry of the validator overrides the default entry of the file.
+	require.Equal(t, proposer.Settings{
+		FeeRecipient: common.ExecutionAddress{0xbb},
+		GasLimit:     36_000_000,
+		Graffiti:     "validator",
+	}, r.Get(configured))
+
+	// The default entry of the file overrides the node defaults.
+	require.Equal(t, proposer.Settings{
+		FeeRecipient: common.ExecutionAddress{0xcc},
+		Graffiti:     "node",
+	}, r.Get(unconfigured))
+
+	// Prepared fee recipients override the file.
+	r.PrepareProposer(configured, common.ExecutionAddress{0xdd})
+	require.Equal(t, common.ExecutionAddress{0xdd}, r.FeeRecipient(configured))
+	require.Equal(t, uint64(36_000_000), r.GasLimit(configured))
+	require.Equal(t, "validator", r.Graffiti(configured))
+	require.Equal(t, common.ExecutionAddress{0xcc}, r.FeeRecipient(unconfigured))
+}
+
+func TestRegistryWithoutConfigFile(t *testing.T) {
+	t.Parallel()
+	defaults := proposer.Settings{FeeRecipient: common.ExecutionAddress{0xaa}, Graffiti: "node"}
+	r, err := proposer.NewRegistry(defaults, "")
+	require.NoError(t, err)
+	require.Equal(t, defaults, r.Get(crypto.BLSPubkey{0x01}))
+}
+
+func TestRegistryInvalidConfigFile(t *testing.T) {
+	t.Parallel()
+	pubkey := crypto.BLSPubkey{0x01}.String()
+	testCases := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{
+			name:    "malformed json",
+			content: `{"proposer_config": [}`,
+			wantErr: proposer.ErrInvalidConfigFile,
+		},
+		{
+			name:    "invalid pubkey",
+			content: `{"proposer_config": {"0x01": {}}}`,
+			wantErr: proposer.ErrInvalidConfigFile,
+		},
+		{
+			name:    "invalid fee recipient",
+			content: `{"default_config": {"fee_recipient": "0x01"}}`,
+			wantErr: proposer.ErrInvalidConfigFile,
+		},
+		{
+			name: "graffiti too long",
+			content: `{"proposer_config": {"` + pubkey + `": {
+				"graffiti": "this graffiti does not fit in 32 bytes"
+			}}}`,
+			wantErr: proposer.ErrGraffitiTooLong,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := proposer.NewRegistry(proposer.Settings{}, writeConfigFile(t, tc.content))
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+
+	_, err := proposer.NewRegistry(proposer.Settings{}, filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "proposer_config.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
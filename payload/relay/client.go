@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+)
+
+const (
+	// headerPath is the builder API endpoint of execution payload bids,
+	// suffixed with the slot, the parent hash and the proposer pubkey.
+	headerPath = "/eth/v1/builder/header"
+	// blindedBlocksPath is the builder API endpoint revealing the execution
+	// payload of signed blinded blocks.
+	blindedBlocksPath = "/eth/v1/builder/blinded_blocks"
+	// validatorsPath is the builder API endpoint of validator registrations.
+	validatorsPath = "/eth/v1/builder/validators"
+
+	// consensusVersionHeader carries the fork of submitted blinded blocks.
+	consensusVersionHeader = "Eth-Consensus-Version"
+
+	// maxResponseSize bounds the size of a relay response body, which may
+	// carry the blobs of a payload.
+	maxResponseSize = 1 << 26
+)
+
+// Client is a client of the builder API of a single relay.
+type Client struct {
+	baseURL *url.URL
+	// pubkey is the public key bids of the relay must be signed with, if
+	// configured.
+	pubkey *crypto.BLSPubkey
+	client *http.Client
+	cs     ChainSpec
+}
+
+// NewClient creates a new Client for the relay at rawURL. The user of the url,
+// if any, is the public key of the relay.
+func NewClient(rawURL string, cs ChainSpec) (*Client, error) {
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid relay url: %w", err)
+	}
+	c := &Client{cs: cs, client: &http.Client{}}
+	if baseURL.User != nil {
+		var pubkey crypto.BLSPubkey
+		if err = pubkey.UnmarshalText([]byte(baseURL.User.Username())); err != nil {
+			return nil, fmt.Errorf("invalid relay pubkey: %w", err)
+		}
+		c.pubkey = &pubkey
+		baseURL.User = nil
+	}
+	c.baseURL = baseURL
+	return c, nil
+}
+
+// String returns the url of the relay, without its public key.
+func (c *Client) String() string {
+	return c.baseURL.String()
+}
+
+// GetHeader requests the bid of the relay for the execution payload of the
+// given slot, built on top of parentHash, for the proposer with the given
+// pubkey. It returns ErrNoBid if the relay has no bid.
+func (c *Client) GetHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+) (*ctypes.SignedBuilderBid, error) {
+	path := c.baseURL.JoinPath(headerPath, slot.Base10(), parentHash.Hex(), pubkey.String())
+	status, body, err := c.do(ctx, http.MethodGet, path.String(), nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNoContent {
+		return nil, ErrNoBid
+	}
+
+	var resp versionedResponse[signedBuilderBidJSON]
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, errors.Wrapf(ErrInvalidBid, "decoding bid: %v", err)
+	}
+	return c.builderBidFromJSON(&resp.Data)
+}
+
+// SubmitBlindedBlock submits the signed blinded block to the relay, which
+// reveals its execution payload and blobs in return.
+func (c *Client) SubmitBlindedBlock(
+	ctx context.Context,
+	blk *ctypes.SignedBlindedBeaconBlock,
+) (*ctypes.ExecutionPayload, engineprimitives.BlobsBundle, error) {
+	signedBlk, err := apitypes.SignedBlindedBeaconBlockFromConsensus(blk)
+	if err != nil {
+		return nil, nil, err
+	}
+	forkVersion := blk.Message.Body.GetBeaconBlockBody().GetForkVersion()
+	status, body, err := c.do(
+		ctx, http.MethodPost, c.baseURL.JoinPath(blindedBlocksPath).String(),
+		signedBlk, consensusVersion(forkVersion),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if status != http.StatusOK {
+		return nil, nil, errors.Wrapf(ErrInvalidReveal, "unexpected status %d", status)
+	}
+
+	var resp versionedResponse[payloadAndBlobsJSON]
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, nil, errors.Wrapf(ErrInvalidReveal, "decoding payload: %v", err)
+	}
+	payload, err := apitypes.ExecutionPayloadToConsensus(resp.Data.ExecutionPayload, forkVersion)
+	if err != nil {
+		return nil, nil, errors.Wrapf(ErrInvalidReveal, "decoding payload: %v", err)
+	}
+	blobsBundle := resp.Data.BlobsBundle
+	if blobsBundle == nil {
+		blobsBundle = &engineprimitives.BlobsBundleV1{}
+	}
+	return payload, blobsBundle, nil
+}
+
+// RegisterValidators registers the given validators with the relay.
+func (c *Client) RegisterValidators(
+	ctx context.Context,
+	regs []*ctypes.SignedValidatorRegistration,
+) error {
+	body := make([]*signedValidatorRegistrationJSON, len(regs))
+	for i, reg := range regs {
+		body[i] = &signedValidatorRegistrationJSON{
+			Message: &validatorRegistrationJSON{
+				FeeRecipient: reg.Message.FeeRecipient,
+				GasLimit:     reg.Message.GasLimit.Base10(),
+				Timestamp:    reg.Message.Timestamp.Base10(),
+				Pubkey:       reg.Message.Pubkey,
+			},
+			Signature: reg.Signature,
+		}
+	}
+	status, _, err := c.do(
+		ctx, http.MethodPost, c.baseURL.JoinPath(validatorsPath).String(), body, "",
+	)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return errors.Wrapf(ErrRelayRequest, "unexpected status %d", status)
+	}
+	return nil
+}
+
+// builderBidFromJSON converts the spec representation of a signed builder bid
+// into its consensus type, for the fork active at the payload timestamp.
+func (c *Client) builderBidFromJSON(bid *signedBuilderBidJSON) (*ctypes.SignedBuilderBid, error) {
+	if bid.Message == nil || bid.Message.Header == nil {
+		return nil, errors.Wrap(ErrInvalidBid, "missing bid header")
+	}
+	msg := bid.Message
+	timestamp, err := math.U64FromString(msg.Header.Timestamp)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidBid, "decoding timestamp: %v", err)
+	}
+	forkVersion := c.cs.ActiveForkVersionForTimestamp(timestamp)
+
+	header, err := apitypes.ExecutionPayloadHeaderToConsensus(msg.Header, forkVersion)
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidBid, "decoding header: %v", err)
+	}
+	value := &math.U256{}
+	if err = value.SetFromDecimal(msg.Value); err != nil {
+		return nil, errors.Wrapf(ErrInvalidBid, "decoding value: %v", err)
+	}
+	builderBid := &ctypes.BuilderBid{
+		Versionable:        ctypes.NewVersionable(forkVersion),
+		Header:             header,
+		BlobKzgCommitments: msg.BlobKZGCommitments,
+		Value:              value,
+		Pubkey:             msg.Pubkey,
+	}
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		if builderBid.ExecutionRequests, err = apitypes.ExecutionRequestsToConsensus(
+			msg.ExecutionRequests,
+		); err != nil {
+			return nil, errors.Wrapf(ErrInvalidBid, "decoding execution requests: %v", err)
+		}
+	}
+	return &ctypes.SignedBuilderBid{Message: builderBid, Signature: bid.Signature}, nil
+}
+
+// do sends a request with the given JSON body to the relay, returning the
+// status and the body of successful responses.
+func (c *Client) do(
+	ctx context.Context, method, target string, reqBody any, forkName string,
+) (int, []byte, error) {
+	var bodyReader io.Reader
+	if reqBody != nil {
+		bz, err := json.Marshal(reqBody)
+		if err != nil {
+			return 0, nil, err
+		}
+		bodyReader = bytes.NewReader(bz)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, bodyReader)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if forkName != "" {
+		req.Header.Set(consensusVersionHeader, forkName)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, nil, errors.Wrapf(ErrRelayRequest, "%v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return 0, nil, fmt.Errorf("reading relay response: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return 0, nil, errors.Wrapf(
+			ErrRelayRequest, "status %d: %s", resp.StatusCode, bytes.TrimSpace(body),
+		)
+	}
+	return resp.StatusCode, body, nil
+}
+
+// consensusVersion returns the builder API name of the given fork version.
+// Beacon-kit forks map onto the Ethereum fork they extend.
+func consensusVersion(forkVersion common.Version) string {
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		return "electra"
+	}
+	return "deneb"
+}
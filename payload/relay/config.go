@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "time"
+
+const (
+	// defaultBidTimeout is the default time waited for the bids of relays.
+	defaultBidTimeout = 500 * time.Millisecond
+	// defaultRevealTimeout is the default time waited for a relay to reveal
+	// an execution payload.
+	defaultRevealTimeout = time.Second
+	// defaultGasLimit is the default gas limit registered with relays.
+	defaultGasLimit = 30_000_000
+)
+
+// Config is the configuration of the builder relays bidding for the execution
+// payloads of the blocks proposed by this node.
+type Config struct {
+	// URLs are the base urls of the builder relays. The public key of a
+	// relay may be given as the user of its url, as in
+	// https://0xa1b2...@relay.example.com, to only accept bids signed by it.
+	// External builders are disabled if no url is set.
+	URLs []string `mapstructure:"urls"`
+	// BidTimeout is the time waited for the bids of the relays.
+	BidTimeout time.Duration `mapstructure:"bid-timeout"`
+	// RevealTimeout is the time waited for a relay to reveal the execution
+	// payload of a signed blinded block, before falling back to the local
+	// payload.
+	RevealTimeout time.Duration `mapstructure:"reveal-timeout"`
+	// LocalValueBoost is the percentage by which the value of the local
+	// payload is increased before being compared to bids.
+	LocalValueBoost uint64 `mapstructure:"local-value-boost"`
+	// GasLimit is the gas limit registered with the relays, unless set for
+	// the validator in the proposer config file.
+	GasLimit uint64 `mapstructure:"gas-limit"`
+}
+
+// DefaultConfig returns the default configuration of the builder relays.
+func DefaultConfig() Config {
+	return Config{
+		URLs:          []string{},
+		BidTimeout:    defaultBidTimeout,
+		RevealTimeout: defaultRevealTimeout,
+		GasLimit:      defaultGasLimit,
+	}
+}
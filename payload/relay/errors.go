@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrNoBid is returned when no relay bid for the execution payload.
+	ErrNoBid = errors.New("no bid received from relays")
+
+	// ErrRelayRequest is returned when a relay rejects a request.
+	ErrRelayRequest = errors.New("relay request failed")
+
+	// ErrInvalidBid is returned when a relay returns a malformed bid.
+	ErrInvalidBid = errors.New("invalid bid")
+
+	// ErrUnexpectedRelayPubkey is returned when a bid is not signed by the
+	// public key configured for its relay.
+	ErrUnexpectedRelayPubkey = errors.New("bid signed by unexpected relay pubkey")
+
+	// ErrInvalidReveal is returned when the payload revealed by a relay does
+	// not match its bid.
+	ErrInvalidReveal = errors.New("revealed payload does not match bid")
+
+	// ErrInvalidRegistration is returned when a validator registration is
+	// not signed by its validator.
+	ErrInvalidRegistration = errors.New("invalid validator registration")
+)
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	kzgtypes "github.com/berachain/beacon-kit/da/kzg/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ChainSpec defines the chain parameters used by the relays.
+type ChainSpec interface {
+	// ActiveForkVersionForTimestamp returns the fork version active at the
+	// given timestamp.
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+	// GenesisForkVersion returns the fork version at genesis, which is used
+	// in the builder signing domain.
+	GenesisForkVersion() common.Version
+	// DomainTypeApplicationMask returns the domain type of builder
+	// signatures.
+	DomainTypeApplicationMask() common.DomainType
+}
+
+// SignatureVerifier verifies BLS signatures.
+type SignatureVerifier interface {
+	// VerifySignature verifies a signature against a message and a public
+	// key.
+	VerifySignature(pubKey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature) error
+}
+
+// BlobProofVerifier verifies the KZG proofs of blobs.
+type BlobProofVerifier interface {
+	// VerifyBlobProofBatch verifies the KZG proofs of a batch of blobs
+	// against their commitments.
+	VerifyBlobProofBatch(args *kzgtypes.BlobProofArgs) error
+}
+
+// Proposers provides the settings of the validators registered with relays.
+type Proposers interface {
+	// FeeRecipient returns the fee recipient of the validator with the given
+	// pubkey.
+	FeeRecipient(pubkey crypto.BLSPubkey) common.ExecutionAddress
+	// GasLimit returns the gas limit of the validator with the given pubkey,
+	// or zero to use the configured one.
+	GasLimit(pubkey crypto.BLSPubkey) uint64
+}
@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"context"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	kzgtypes "github.com/berachain/beacon-kit/da/kzg/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// percent is the denominator of LocalValueBoost.
+const percent = 100
+
+// Bid is a bid for an execution payload, along with the relay it was
+// received from.
+type Bid struct {
+	*ctypes.SignedBuilderBid
+	relay *Client
+}
+
+// NewBid returns the bid received from the given relay.
+func NewBid(bid *ctypes.SignedBuilderBid, relay *Client) *Bid {
+	return &Bid{SignedBuilderBid: bid, relay: relay}
+}
+
+// Relay returns the url of the relay the bid was received from.
+func (b *Bid) Relay() string {
+	return b.relay.String()
+}
+
+// Relays requests bids for the execution payloads of the blocks proposed by
+// this node from a set of builder relays.
+type Relays struct {
+	cfg       Config
+	proposers Proposers
+	cs        ChainSpec
+	verifier  SignatureVerifier
+	blobs     BlobProofVerifier
+	logger    log.Logger
+	clients   []*Client
+}
+
+// New creates new Relays from the given configuration. Registrations with the
+// relays use the fee recipients and gas limits of proposers.
+func New(
+	cfg *Config,
+	proposers Proposers,
+	cs ChainSpec,
+	verifier SignatureVerifier,
+	blobs BlobProofVerifier,
+	logger log.Logger,
+) (*Relays, error) {
+	r := &Relays{
+		cfg:       *cfg,
+		proposers: proposers,
+		cs:        cs,
+		verifier:  verifier,
+		blobs:     blobs,
+		logger:    logger,
+		clients:   make([]*Client, 0, len(cfg.URLs)),
+	}
+	// Configurations predating some options leave them unset.
+	defaults := DefaultConfig()
+	if r.cfg.BidTimeout == 0 {
+		r.cfg.BidTimeout = defaults.BidTimeout
+	}
+	if r.cfg.RevealTimeout == 0 {
+		r.cfg.RevealTimeout = defaults.RevealTimeout
+	}
+	if r.cfg.GasLimit == 0 {
+		r.cfg.GasLimit = defaults.GasLimit
+	}
+
+	for _, rawURL := range cfg.URLs {
+		c, err := NewClient(rawURL, cs)
+		if err != nil {
+			return nil, err
+		}
+		r.clients = append(r.clients, c)
+	}
+	return r, nil
+}
+
+// Enabled returns whether any relay is configured.
+func (r *Relays) Enabled() bool {
+	return len(r.clients) > 0
+}
+
+// GetBid requests bids for the execution payload of the given slot, built on
+// top of parentHash, for the proposer with the given pubkey. It returns the
+// most valuable correctly signed bid received within the bid timeout, or
+// ErrNoBid.
+func (r *Relays) GetBid(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+	pubkey crypto.BLSPubkey,
+) (*Bid, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.BidTimeout)
+	defer cancel()
+
+	bids := make(chan *Bid, len(r.clients))
+	for _, c := range r.clients {
+		go func() {
+			bid, err := c.GetHeader(ctx, slot, parentHash, pubkey)
+			if err == nil {
+				err = r.verifyBid(c, bid)
+			}
+			switch {
+			case errors.Is(err, ErrNoBid):
+				r.logger.Debug("No bid from relay", "relay", c, "slot", slot.Base10())
+				bids <- nil
+			case err != nil:
+				r.logger.Warn(
+					"Failed requesting bid from relay",
+					"relay", c, "slot", slot.Base10(), "error", err,
+				)
+				bids <- nil
+			default:
+				bids <- NewBid(bid, c)
+			}
+		}()
+	}
+
+	var best *Bid
+	for range r.clients {
+		bid := <-bids
+		if bid != nil && (best == nil || bid.Message.Value.Gt(best.Message.Value)) {
+			best = bid
+		}
+	}
+	if best == nil {
+		return nil, ErrNoBid
+	}
+	return best, nil
+}
+
+// Outbids returns whether the bid is more valuable than a local payload of the
+// given value, once boosted by LocalValueBoost.
+func (r *Relays) Outbids(bid *Bid, localValue *math.U256) bool {
+	boosted := new(math.U256).Mul(localValue, math.NewU256(percent+r.cfg.LocalValueBoost))
+	bidValue := new(math.U256).Mul(bid.Message.Value, math.NewU256(percent))
+	return bidValue.Gt(boosted)
+}
+
+// GetPayload submits the signed blinded block built with the bid to its relay,
+// returning the execution payload and blobs revealed by the relay within the
+// reveal timeout.
+func (r *Relays) GetPayload(
+	ctx context.Context,
+	bid *Bid,
+	blk *ctypes.SignedBlindedBeaconBlock,
+) (*ctypes.ExecutionPayload, engineprimitives.BlobsBundle, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.RevealTimeout)
+	defer cancel()
+
+	payload, blobsBundle, err := bid.relay.SubmitBlindedBlock(ctx, blk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The payload itself is checked against the header when unblinding the
+	// block, only the blobs are checked here.
+	commitments := blobsBundle.GetCommitments()
+	if len(commitments) != len(bid.Message.BlobKzgCommitments) ||
+		len(blobsBundle.GetProofs()) != len(commitments) ||
+		len(blobsBundle.GetBlobs()) != len(commitments) {
+		return nil, nil, errors.Wrapf(
+			ErrInvalidReveal, "expected %d blobs, got %d commitments, %d proofs and %d blobs",
+			len(bid.Message.BlobKzgCommitments), len(commitments),
+			len(blobsBundle.GetProofs()), len(blobsBundle.GetBlobs()),
+		)
+	}
+	for i, commitment := range commitments {
+		if commitment != bid.Message.BlobKzgCommitments[i] {
+			return nil, nil, errors.Wrapf(ErrInvalidReveal, "blob %d commitment mismatch", i)
+		}
+	}
+	// The blobs must match their commitments, as they would be checked by
+	// the da verifier of every other node.
+	if len(commitments) > 0 {
+		if err = r.blobs.VerifyBlobProofBatch(&kzgtypes.BlobProofArgs{
+			Blobs:       blobsBundle.GetBlobs(),
+			Proofs:      blobsBundle.GetProofs(),
+			Commitments: commitments,
+		}); err != nil {
+			return nil, nil, errors.Wrapf(ErrInvalidReveal, "invalid blob proofs: %v", err)
+		}
+	}
+	return payload, blobsBundle, nil
+}
+
+// NewRegistration returns the registration of the validator with the given
+// pubkey, made at timestamp.
+func (r *Relays) NewRegistration(
+	pubkey crypto.BLSPubkey, timestamp time.Time,
+) *ctypes.ValidatorRegistration {
+	gasLimit := r.proposers.GasLimit(pubkey)
+	if gasLimit == 0 {
+		gasLimit = r.cfg.GasLimit
+	}
+	return &ctypes.ValidatorRegistration{
+		FeeRecipient: r.proposers.FeeRecipient(pubkey),
+		GasLimit:     math.U64(gasLimit),
+		Timestamp:    math.U64(timestamp.Unix()),
+		Pubkey:       pubkey,
+	}
+}
+
+// RegisterValidator registers the validator with all relays.
+func (r *Relays) RegisterValidator(
+	ctx context.Context, reg *ctypes.SignedValidatorRegistration,
+) error {
+	return r.registerValidators(ctx, []*ctypes.SignedValidatorRegistration{reg})
+}
+
+// RegisterValidators registers validators with all relays on their behalf,
+// once their registrations are checked to be signed by them.
+func (r *Relays) RegisterValidators(
+	ctx context.Context, regs []*ctypes.SignedValidatorRegistration,
+) error {
+	for i, reg := range regs {
+		signingRoot := ctypes.ComputeBuilderSigningRoot(
+			reg.Message, r.cs.GenesisForkVersion(), r.cs.DomainTypeApplicationMask(),
+		)
+		if err := r.verifier.VerifySignature(
+			reg.Message.Pubkey, signingRoot[:], reg.Signature,
+		); err != nil {
+			return errors.Wrapf(
+				ErrInvalidRegistration, "registration %d of %s: %v", i, reg.Message.Pubkey, err,
+			)
+		}
+	}
+	return r.registerValidators(ctx, regs)
+}
+
+// registerValidators submits the registrations to all relays.
+func (r *Relays) registerValidators(
+	ctx context.Context, regs []*ctypes.SignedValidatorRegistration,
+) error {
+	errs := make(chan error, len(r.clients))
+	for _, c := range r.clients {
+		go func() {
+			err := c.RegisterValidators(ctx, regs)
+			if err != nil {
+				err = errors.Wrapf(err, "relay %s", c)
+			}
+			errs <- err
+		}()
+	}
+
+	var err error
+	for range r.clients {
+		err = errors.Join(err, <-errs)
+	}
+	return err
+}
+
+// verifyBid checks that the bid is signed by the relay in the builder domain.
+func (r *Relays) verifyBid(c *Client, bid *ctypes.SignedBuilderBid) error {
+	if c.pubkey != nil && *c.pubkey != bid.Message.Pubkey {
+		return errors.Wrapf(
+			ErrUnexpectedRelayPubkey, "expected %s, got %s", c.pubkey, bid.Message.Pubkey,
+		)
+	}
+	signingRoot := ctypes.ComputeBuilderSigningRoot(
+		bid.Message, r.cs.GenesisForkVersion(), r.cs.DomainTypeApplicationMask(),
+	)
+	if err := r.verifier.VerifySignature(
+		bid.Message.Pubkey, signingRoot[:], bid.Signature,
+	); err != nil {
+		return errors.Wrapf(ErrInvalidBid, "invalid signature: %v", err)
+	}
+	return nil
+}
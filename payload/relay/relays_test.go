@@ -0,0 +1,474 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/chain"
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	kzgtypes "github.com/berachain/beacon-kit/da/kzg/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/log/noop"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/payload/proposer"
+	"github.com/berachain/beacon-kit/payload/relay"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/encoding/hex"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSlot      = math.Slot(10)
+	testTimestamp = math.U64(1_700_000_000)
+)
+
+var testParentHash = common.ExecutionHash{0x01}
+
+// mockRelay is a relay serving a fixed bid and payload.
+type mockRelay struct {
+	cs     chain.Spec
+	signer *signer.BLSSigner
+	// pubkey is the public key the bid claims to be signed by.
+	pubkey  crypto.BLSPubkey
+	payload *ctypes.ExecutionPayload
+	value   uint64
+	// blobsBundle is the bundle revealed with the payload.
+	blobsBundle *engineprimitives.BlobsBundleV1
+	// commitments are the blob commitments of the bid.
+	commitments []eip4844.KZGCommitment
+
+	mu            sync.Mutex
+	registrations []map[string]any
+}
+
+func newMockRelay(t *testing.T, cs chain.Spec, value uint64) *mockRelay {
+	t.Helper()
+	s := newSigner(t)
+	return &mockRelay{
+		cs:          cs,
+		signer:      s,
+		pubkey:      s.PublicKey(),
+		payload:     newPayload(cs),
+		value:       value,
+		blobsBundle: &engineprimitives.BlobsBundleV1{},
+		commitments: []eip4844.KZGCommitment{},
+	}
+}
+
+// serve starts serving the relay, which has no bid if value is zero.
+func (m *mockRelay) serve(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /eth/v1/builder/header/{slot}/{parent}/{pubkey}", func(w http.ResponseWriter, _ *http.Request) {
+		if m.value == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(t, w, m.bidJSON(t))
+	})
+	mux.HandleFunc("POST /eth/v1/builder/blinded_blocks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Eth-Consensus-Version") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeJSON(t, w, map[string]any{
+			"version": "electra",
+			"data": map[string]any{
+				"execution_payload": payloadJSON(m.payload),
+				"blobs_bundle":      m.blobsBundle,
+			},
+		})
+	})
+	mux.HandleFunc("POST /eth/v1/builder/validators", func(w http.ResponseWriter, r *http.Request) {
+		var regs []map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&regs); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.mu.Lock()
+		m.registrations = append(m.registrations, regs...)
+		m.mu.Unlock()
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func (m *mockRelay) bidJSON(t *testing.T) map[string]any {
+	t.Helper()
+	header, err := m.payload.ToHeader()
+	require.NoError(t, err)
+	bid := &ctypes.BuilderBid{
+		Versionable:        ctypes.NewVersionable(header.GetForkVersion()),
+		Header:             header,
+		BlobKzgCommitments: m.commitments,
+		ExecutionRequests:  &ctypes.ExecutionRequests{},
+		Value:              math.NewU256(m.value),
+		Pubkey:             m.pubkey,
+	}
+	signingRoot := ctypes.ComputeBuilderSigningRoot(
+		bid, m.cs.GenesisForkVersion(), m.cs.DomainTypeApplicationMask(),
+	)
+	signature, err := m.signer.Sign(signingRoot[:])
+	require.NoError(t, err)
+
+	commitments := make([]string, len(m.commitments))
+	for i, c := range m.commitments {
+		commitments[i] = hex.EncodeBytes(c[:])
+	}
+	return map[string]any{
+		"version": "electra",
+		"data": map[string]any{
+			"message": map[string]any{
+				"header":               headerJSON(header),
+				"blob_kzg_commitments": commitments,
+				"execution_requests": map[string]any{
+					"deposits": []any{}, "withdrawals": []any{}, "consolidations": []any{},
+				},
+				"value":  bid.Value.Dec(),
+				"pubkey": m.pubkey.String(),
+			},
+			"signature": hex.EncodeBytes(signature[:]),
+		},
+	}
+}
+
+func TestGetBid(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	low := newMockRelay(t, cs, 1)
+	high := newMockRelay(t, cs, 2)
+	// A bid claiming to be signed by another key.
+	forged := newMockRelay(t, cs, 3)
+	forged.pubkey = newSigner(t).PublicKey()
+	// A correctly signed bid from a relay whose key is not the expected one.
+	unexpected := newMockRelay(t, cs, 4)
+	unexpectedURL := withPubkey(t, unexpected.serve(t).URL, newSigner(t).PublicKey())
+	none := newMockRelay(t, cs, 0)
+
+	highSrv := high.serve(t)
+	highURL := withPubkey(t, highSrv.URL, high.pubkey)
+	relays := newRelays(t, cs, &relay.Config{URLs: []string{
+		low.serve(t).URL, highURL, forged.serve(t).URL, unexpectedURL, none.serve(t).URL,
+	}}, &blobVerifier{})
+	require.True(t, relays.Enabled())
+
+	bid, err := relays.GetBid(context.Background(), testSlot, testParentHash, crypto.BLSPubkey{})
+	require.NoError(t, err)
+	require.Equal(t, highSrv.URL, bid.Relay())
+	require.Equal(t, math.NewU256(2), bid.Message.Value)
+	require.Equal(t, high.payload.GetBlockHash(), bid.Message.Header.GetBlockHash())
+}
+
+func TestGetBidNoBid(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	relays := newRelays(t, cs, &relay.Config{URLs: []string{newMockRelay(t, cs, 0).serve(t).URL}}, &blobVerifier{})
+	_, err = relays.GetBid(context.Background(), testSlot, testParentHash, crypto.BLSPubkey{})
+	require.ErrorIs(t, err, relay.ErrNoBid)
+}
+
+func TestOutbids(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	m := newMockRelay(t, cs, 110)
+	relays := newRelays(t, cs, &relay.Config{URLs: []string{m.serve(t).URL}, LocalValueBoost: 10}, &blobVerifier{})
+	bid, err := relays.GetBid(context.Background(), testSlot, testParentHash, crypto.BLSPubkey{})
+	require.NoError(t, err)
+
+	require.True(t, relays.Outbids(bid, math.NewU256(99)))
+	require.False(t, relays.Outbids(bid, math.NewU256(100)))
+}
+
+func TestGetPayload(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	m := newMockRelay(t, cs, 1)
+	m.commitments = []eip4844.KZGCommitment{{0x01}}
+	m.blobsBundle = &engineprimitives.BlobsBundleV1{
+		Commitments: []eip4844.KZGCommitment{{0x01}},
+		Proofs:      []eip4844.KZGProof{{0x02}},
+		Blobs:       []*eip4844.Blob{{0x03}},
+	}
+	blobs := &blobVerifier{}
+	relays := newRelays(t, cs, &relay.Config{URLs: []string{m.serve(t).URL}}, blobs)
+	bid, err := relays.GetBid(context.Background(), testSlot, testParentHash, crypto.BLSPubkey{})
+	require.NoError(t, err)
+
+	blk := newSignedBlindedBlock(t, bid)
+	payload, blobsBundle, err := relays.GetPayload(context.Background(), bid, blk)
+	require.NoError(t, err)
+	require.Equal(t, m.blobsBundle.GetCommitments(), blobsBundle.GetCommitments())
+
+	signedBlk, err := blk.Unblind(payload)
+	require.NoError(t, err)
+	require.Equal(t, m.payload.GetBlockHash(), signedBlk.GetBody().GetExecutionPayload().GetBlockHash())
+	require.Equal(t, blk.Message.HashTreeRoot(), signedBlk.GetBeaconBlock().HashTreeRoot())
+
+	// The relay reveals blobs other than those committed to in the bid.
+	m.blobsBundle.Commitments = []eip4844.KZGCommitment{{0x04}}
+	_, _, err = relays.GetPayload(context.Background(), bid, blk)
+	require.ErrorIs(t, err, relay.ErrInvalidReveal)
+
+	// The relay reveals blobs which do not match their commitments.
+	m.blobsBundle.Commitments = []eip4844.KZGCommitment{{0x01}}
+	blobs.err = errors.New("invalid proof")
+	_, _, err = relays.GetPayload(context.Background(), bid, blk)
+	require.ErrorIs(t, err, relay.ErrInvalidReveal)
+
+	// The relay reveals a payload other than the one bid.
+	blobs.err = nil
+	m.payload.GasUsed++
+	payload, _, err = relays.GetPayload(context.Background(), bid, blk)
+	require.NoError(t, err)
+	_, err = blk.Unblind(payload)
+	require.ErrorIs(t, err, ctypes.ErrPayloadHeaderMismatch)
+}
+
+func TestRegisterValidator(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	m1, m2 := newMockRelay(t, cs, 0), newMockRelay(t, cs, 0)
+	relays := newRelays(t, cs, &relay.Config{URLs: []string{m1.serve(t).URL, m2.serve(t).URL}}, &blobVerifier{})
+
+	s := newSigner(t)
+	reg, err := ctypes.NewSignedValidatorRegistration(
+		relays.NewRegistration(s.PublicKey(), time.Unix(1_700_000_000, 0)),
+		cs.GenesisForkVersion(),
+		cs.DomainTypeApplicationMask(),
+		s,
+	)
+	require.NoError(t, err)
+	require.NoError(t, relays.RegisterValidator(context.Background(), reg))
+
+	for _, m := range []*mockRelay{m1, m2} {
+		require.Len(t, m.registrations, 1)
+		msg, ok := m.registrations[0]["message"].(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, s.PublicKey().String(), msg["pubkey"])
+		require.Equal(t, "1700000000", msg["timestamp"])
+		require.Equal(t, "30000000", msg["gas_limit"])
+	}
+
+	// Registrations are checked with the same domain as bids.
+	signingRoot := ctypes.ComputeBuilderSigningRoot(
+		reg.Message, cs.GenesisForkVersion(), cs.DomainTypeApplicationMask(),
+	)
+	require.NoError(t, s.VerifySignature(s.PublicKey(), signingRoot[:], reg.Signature))
+}
+
+func TestRegisterValidators(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+
+	m := newMockRelay(t, cs, 0)
+	relays := newRelays(t, cs, &relay.Config{URLs: []string{m.serve(t).URL}}, &blobVerifier{})
+
+	regs := make([]*ctypes.SignedValidatorRegistration, 2)
+	for i := range regs {
+		s := newSigner(t)
+		regs[i], err = ctypes.NewSignedValidatorRegistration(
+			relays.NewRegistration(s.PublicKey(), time.Unix(1_700_000_000, 0)),
+			cs.GenesisForkVersion(),
+			cs.DomainTypeApplicationMask(),
+			s,
+		)
+		require.NoError(t, err)
+	}
+	require.NoError(t, relays.RegisterValidators(context.Background(), regs))
+	require.Len(t, m.registrations, 2)
+
+	// Registrations not signed by their validator are not submitted.
+	forged := *regs[1].Message
+	forged.FeeRecipient = common.ExecutionAddress{0xff}
+	err = relays.RegisterValidators(context.Background(), []*ctypes.SignedValidatorRegistration{
+		regs[0], {Message: &forged, Signature: regs[1].Signature},
+	})
+	require.ErrorIs(t, err, relay.ErrInvalidRegistration)
+	require.Len(t, m.registrations, 2)
+}
+
+func newRelays(
+	t *testing.T, cs chain.Spec, cfg *relay.Config, blobs relay.BlobProofVerifier,
+) *relay.Relays {
+	t.Helper()
+	proposers, err := proposer.NewRegistry(proposer.Settings{FeeRecipient: common.ExecutionAddress{0xfe}}, "")
+	require.NoError(t, err)
+	relays, err := relay.New(cfg, proposers, cs, signer.BLSSigner{}, blobs, noop.NewLogger[any]())
+	require.NoError(t, err)
+	return relays
+}
+
+// blobVerifier is a blob proof verifier rejecting proofs if err is set.
+type blobVerifier struct {
+	err error
+}
+
+func (v *blobVerifier) VerifyBlobProofBatch(args *kzgtypes.BlobProofArgs) error {
+	if len(args.Blobs) != len(args.Proofs) || len(args.Proofs) != len(args.Commitments) {
+		return errors.New("mismatched blob proof args")
+	}
+	return v.err
+}
+
+func newSigner(t *testing.T) *signer.BLSSigner {
+	t.Helper()
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	keyFilePath := filepath.Join(dir, "priv_validator_key.json")
+	stateFilePath := filepath.Join(dir, "priv_validator_state.json")
+	privval.NewFilePV(privKey, keyFilePath, stateFilePath).Save()
+	return signer.NewBLSSigner(keyFilePath, stateFilePath)
+}
+
+// withPubkey sets pubkey as the user of the relay url.
+func withPubkey(t *testing.T, rawURL string, pubkey crypto.BLSPubkey) string {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	u.User = url.User(pubkey.String())
+	return u.String()
+}
+
+func newPayload(cs chain.Spec) *ctypes.ExecutionPayload {
+	return &ctypes.ExecutionPayload{
+		Versionable:   ctypes.NewVersionable(cs.ActiveForkVersionForTimestamp(testTimestamp)),
+		ParentHash:    testParentHash,
+		FeeRecipient:  common.ExecutionAddress{0xfe},
+		Number:        7,
+		GasLimit:      30_000_000,
+		GasUsed:       21_000,
+		Timestamp:     testTimestamp,
+		ExtraData:     []byte("builder"),
+		BaseFeePerGas: math.NewU256(1_000),
+		BlockHash:     common.ExecutionHash{0x02},
+		Transactions:  engineprimitives.Transactions{[]byte{0xaa, 0xbb}},
+		Withdrawals: []*engineprimitives.Withdrawal{{
+			Index: 1, Validator: 2, Address: common.ExecutionAddress{0x03}, Amount: 4,
+		}},
+	}
+}
+
+func newSignedBlindedBlock(t *testing.T, bid *relay.Bid) *ctypes.SignedBlindedBeaconBlock {
+	t.Helper()
+	forkVersion := bid.Message.Header.GetForkVersion()
+	blk, err := ctypes.NewBeaconBlockWithVersion(testSlot, 0, common.Root{0x05}, forkVersion)
+	require.NoError(t, err)
+	blk.GetBody().SetBlobKzgCommitments(bid.Message.BlobKzgCommitments)
+	if version.EqualsOrIsAfter(forkVersion, version.Electra()) {
+		require.NoError(t, blk.GetBody().SetExecutionRequests(bid.Message.ExecutionRequests))
+	}
+	return &ctypes.SignedBlindedBeaconBlock{
+		Message: ctypes.NewBlindedBeaconBlock(blk, bid.Message.Header),
+	}
+}
+
+func headerJSON(h *ctypes.ExecutionPayloadHeader) map[string]any {
+	return map[string]any{
+		"parent_hash":       h.ParentHash.Hex(),
+		"fee_recipient":     h.FeeRecipient.Hex(),
+		"state_root":        hex.EncodeBytes(h.StateRoot[:]),
+		"receipts_root":     hex.EncodeBytes(h.ReceiptsRoot[:]),
+		"logs_bloom":        hex.EncodeBytes(h.LogsBloom[:]),
+		"prev_randao":       hex.EncodeBytes(h.Random[:]),
+		"block_number":      h.Number.Base10(),
+		"gas_limit":         h.GasLimit.Base10(),
+		"gas_used":          h.GasUsed.Base10(),
+		"timestamp":         h.Timestamp.Base10(),
+		"extra_data":        hex.EncodeBytes(h.ExtraData),
+		"base_fee_per_gas":  h.BaseFeePerGas.Dec(),
+		"block_hash":        h.BlockHash.Hex(),
+		"transactions_root": h.TransactionsRoot.Hex(),
+		"withdrawals_root":  h.WithdrawalsRoot.Hex(),
+		"blob_gas_used":     h.BlobGasUsed.Base10(),
+		"excess_blob_gas":   h.ExcessBlobGas.Base10(),
+	}
+}
+
+func payloadJSON(p *ctypes.ExecutionPayload) map[string]any {
+	txs := make([]string, len(p.Transactions))
+	for i, tx := range p.Transactions {
+		txs[i] = hex.EncodeBytes(tx)
+	}
+	withdrawals := make([]map[string]any, len(p.Withdrawals))
+	for i, w := range p.Withdrawals {
+		withdrawals[i] = map[string]any{
+			"index":           w.Index.Base10(),
+			"validator_index": w.Validator.Base10(),
+			"address":         w.Address.Hex(),
+			"amount":          w.Amount.Base10(),
+		}
+	}
+	return map[string]any{
+		"parent_hash":      p.ParentHash.Hex(),
+		"fee_recipient":    p.FeeRecipient.Hex(),
+		"state_root":       hex.EncodeBytes(p.StateRoot[:]),
+		"receipts_root":    hex.EncodeBytes(p.ReceiptsRoot[:]),
+		"logs_bloom":       hex.EncodeBytes(p.LogsBloom[:]),
+		"prev_randao":      hex.EncodeBytes(p.Random[:]),
+		"block_number":     p.Number.Base10(),
+		"gas_limit":        p.GasLimit.Base10(),
+		"gas_used":         p.GasUsed.Base10(),
+		"timestamp":        p.Timestamp.Base10(),
+		"extra_data":       hex.EncodeBytes(p.ExtraData),
+		"base_fee_per_gas": p.BaseFeePerGas.Dec(),
+		"block_hash":       p.BlockHash.Hex(),
+		"transactions":     txs,
+		"withdrawals":      withdrawals,
+		"blob_gas_used":    p.BlobGasUsed.Base10(),
+		"excess_blob_gas":  p.ExcessBlobGas.Base10(),
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	apitypes "github.com/berachain/beacon-kit/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+)
+
+// The types below are the builder API spec representation of the objects
+// exchanged with relays.
+// https://ethereum.github.io/builder-specs/
+
+// versionedResponse is the envelope of versioned builder API responses.
+type versionedResponse[T any] struct {
+	Version string `json:"version"`
+	Data    T      `json:"data"`
+}
+
+type signedBuilderBidJSON struct {
+	Message   *builderBidJSON     `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+type builderBidJSON struct {
+	Header             *apitypes.ExecutionPayloadHeader `json:"header"`
+	BlobKZGCommitments []eip4844.KZGCommitment          `json:"blob_kzg_commitments"`
+	ExecutionRequests  *apitypes.ExecutionRequests      `json:"execution_requests,omitempty"`
+	Value              string                           `json:"value"`
+	Pubkey             crypto.BLSPubkey                 `json:"pubkey"`
+}
+
+type payloadAndBlobsJSON struct {
+	ExecutionPayload *apitypes.ExecutionPayload      `json:"execution_payload"`
+	BlobsBundle      *engineprimitives.BlobsBundleV1 `json:"blobs_bundle"`
+}
+
+type signedValidatorRegistrationJSON struct {
+	Message   *validatorRegistrationJSON `json:"message"`
+	Signature crypto.BLSSignature        `json:"signature"`
+}
+
+type validatorRegistrationJSON struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     string                  `json:"gas_limit"`
+	Timestamp    string                  `json:"timestamp"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
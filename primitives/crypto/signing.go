@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package crypto
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// SigningType identifies the kind of object a signing root was computed for.
+// The values match the signing types understood by Web3Signer.
+type SigningType string
+
+const (
+	// SigningTypeRandaoReveal is the signing type of a randao reveal.
+	SigningTypeRandaoReveal SigningType = "RANDAO_REVEAL"
+	// SigningTypeBlock is the signing type of a beacon block proposal.
+	SigningTypeBlock SigningType = "BLOCK_V2"
+	// SigningTypeValidatorRegistration is the signing type of a registration
+	// with external block builders.
+	SigningTypeValidatorRegistration SigningType = "VALIDATOR_REGISTRATION"
+	// SigningTypeDeposit is the signing type of a deposit message.
+	SigningTypeDeposit SigningType = "DEPOSIT"
+)
+
+// SigningBlockHeader is the header of the beacon block being signed. It lets
+// remote signers inspect the proposal, e.g. for slashing protection.
+type SigningBlockHeader struct {
+	Slot          math.Slot
+	ProposerIndex math.ValidatorIndex
+	ParentRoot    common.Root
+	StateRoot     common.Root
+	BodyRoot      common.Root
+}
+
+// SigningValidatorRegistration is the validator registration being signed.
+type SigningValidatorRegistration struct {
+	FeeRecipient common.ExecutionAddress
+	GasLimit     math.U64
+	Timestamp    math.U64
+	Pubkey       BLSPubkey
+}
+
+// SigningDeposit is the deposit message being signed.
+type SigningDeposit struct {
+	Pubkey                BLSPubkey
+	WithdrawalCredentials common.Bytes32
+	Amount                math.Gwei
+	// GenesisForkVersion is the fork version of the deposit domain.
+	GenesisForkVersion common.Version
+}
+
+// SigningRequest describes an object to be signed along with its signing
+// root, so that signers that do not blindly sign roots can validate it.
+type SigningRequest struct {
+	// Type is the kind of object being signed.
+	Type SigningType
+	// SigningRoot is the root to be signed.
+	SigningRoot common.Root
+	// ForkVersion is the fork version used to compute the signing domain.
+	ForkVersion common.Version
+	// GenesisValidatorsRoot is the genesis validators root used to compute
+	// the signing domain.
+	GenesisValidatorsRoot common.Root
+	// Epoch is the epoch of the randao reveal, for SigningTypeRandaoReveal.
+	Epoch math.Epoch
+	// Block is the header of the proposed block, for SigningTypeBlock.
+	Block *SigningBlockHeader
+	// ValidatorRegistration is the registration being signed, for
+	// SigningTypeValidatorRegistration.
+	ValidatorRegistration *SigningValidatorRegistration
+	// Deposit is the deposit message being signed, for SigningTypeDeposit.
+	Deposit *SigningDeposit
+}
+
+// TypedBLSSigner is a BLSSigner that can sign typed signing requests.
+type TypedBLSSigner interface {
+	BLSSigner
+
+	// SignRequest signs the signing root of the given request.
+	SignRequest(req *SigningRequest) (BLSSignature, error)
+}
+
+// SignRequest signs the given request with signer, passing the typed request
+// through when the signer supports it and signing the bare root otherwise.
+func SignRequest(signer BLSSigner, req *SigningRequest) (BLSSignature, error) {
+	if typed, ok := signer.(TypedBLSSigner); ok {
+		return typed.SignRequest(req)
+	}
+	return signer.Sign(req.SigningRoot[:])
+}
@@ -54,3 +54,14 @@ func (d *ConnectionURL) IsHTTPS() bool {
 func (d *ConnectionURL) IsIPC() bool {
 	return d.Scheme == "ipc"
 }
+
+// IsWebSocket checks if the DialURL scheme is WS or WSS.
+func (d *ConnectionURL) IsWebSocket() bool {
+	return d.Scheme == "ws" || d.Scheme == "wss"
+}
+
+// IPCPath returns the socket path of an IPC DialURL. Both absolute
+// (ipc:///path/geth.ipc) and relative (ipc://geth.ipc) paths are supported.
+func (d *ConnectionURL) IPCPath() string {
+	return d.Host + d.Path
+}
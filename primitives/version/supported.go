@@ -20,7 +20,11 @@
 
 package version
 
-import "github.com/berachain/beacon-kit/primitives/common"
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+)
 
 //nolint:gochecknoglobals // used for testing
 var supportedVersions = []common.Version{
@@ -36,3 +40,9 @@ var supportedVersions = []common.Version{
 func GetSupportedVersions() []common.Version {
 	return supportedVersions
 }
+
+// IsSupported returns true if the given version is supported by beacon-kit,
+// i.e. the state processor knows how to upgrade the state to it.
+func IsSupported(v common.Version) bool {
+	return slices.Contains(supportedVersions, v)
+}
@@ -29,12 +29,14 @@ import (
 	"github.com/berachain/beacon-kit/consensus/cometbft/service/cache"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
+	"github.com/berachain/beacon-kit/observability/tracing"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/primitives/math"
 	"github.com/berachain/beacon-kit/primitives/transition"
 	"github.com/berachain/beacon-kit/state-transition/core/state"
 	"github.com/berachain/beacon-kit/storage/deposit"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // StateProcessor is a basic Processor, which takes care of the
@@ -56,6 +58,9 @@ type StateProcessor struct {
 	ds deposit.StoreManager
 	// metrics is the metrics for the service.
 	metrics *stateProcessorMetrics
+	// forkUpgrades are the hooks preparing the state for each supported fork
+	// version.
+	forkUpgrades map[common.Version]forkUpgrade
 	// logDeneb1Once enforces logging the Deneb1 fork information at most once.
 	logDeneb1Once sync.Once
 }
@@ -70,7 +75,7 @@ func NewStateProcessor(
 	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error),
 	telemetrySink TelemetrySink,
 ) *StateProcessor {
-	return &StateProcessor{
+	sp := &StateProcessor{
 		logger:                logger,
 		cs:                    cs,
 		executionEngine:       executionEngine,
@@ -78,7 +83,10 @@ func NewStateProcessor(
 		fGetAddressFromPubKey: fGetAddressFromPubKey,
 		ds:                    ds,
 		metrics:               newStateProcessorMetrics(telemetrySink),
+		forkUpgrades:          make(map[common.Version]forkUpgrade),
 	}
+	sp.registerForkUpgrades()
+	return sp
 }
 
 // Transition is the main function for processing a state transition.
@@ -86,13 +94,25 @@ func (sp *StateProcessor) Transition(
 	ctx ReadOnlyContext,
 	st *state.StateDB,
 	blk *ctypes.BeaconBlock,
-) (transition.ValidatorUpdates, error) {
+) (_ transition.ValidatorUpdates, err error) {
 	if blk == nil {
 		return nil, nil
 	}
 
+	spanCtx, span := tracing.Start(
+		ctx.ConsensusCtx(), "state.Transition",
+		attribute.Int64("slot", int64(blk.GetSlot().Unwrap())), // #nosec G115
+	)
+	defer func() { tracing.End(span, err) }()
+	ctx = tracedContext{ReadOnlyContext: ctx, ctx: spanCtx}
+
 	// Process the next slot.
-	validatorUpdates, err := sp.ProcessSlots(st, blk.GetSlot())
+	var validatorUpdates transition.ValidatorUpdates
+	err = tracePhase(ctx, "state.ProcessSlots", func(ReadOnlyContext) error {
+		var slotsErr error
+		validatorUpdates, slotsErr = sp.ProcessSlots(st, blk.GetSlot())
+		return slotsErr
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -106,12 +126,16 @@ func (sp *StateProcessor) Transition(
 	if cache.IsStateCachingActive(sp.cs, blk.Slot) {
 		logForkProcessing = ctx.VerifyPayload()
 	}
-	if err = sp.ProcessFork(st, blk.GetTimestamp(), logForkProcessing); err != nil {
+	if err = tracePhase(ctx, "state.ProcessFork", func(ReadOnlyContext) error {
+		return sp.ProcessFork(st, blk.GetTimestamp(), logForkProcessing)
+	}); err != nil {
 		return nil, err
 	}
 
 	// Process the block.
-	if err = sp.ProcessBlock(ctx, st, blk); err != nil {
+	if err = tracePhase(ctx, "state.ProcessBlock", func(ctx ReadOnlyContext) error {
+		return sp.ProcessBlock(ctx, st, blk)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -220,23 +244,33 @@ func (sp *StateProcessor) ProcessBlock(
 		return err
 	}
 
-	if err = sp.processBlockHeader(ctx, st, blk); err != nil {
+	if err = tracePhase(ctx, "state.processBlockHeader", func(ctx ReadOnlyContext) error {
+		return sp.processBlockHeader(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err = sp.processExecutionPayload(ctx, st, blk, parentProposerPubkey); err != nil {
+	if err = tracePhase(ctx, "state.processExecutionPayload", func(ctx ReadOnlyContext) error {
+		return sp.processExecutionPayload(ctx, st, blk, parentProposerPubkey)
+	}); err != nil {
 		return err
 	}
 
-	if err = sp.processWithdrawals(st, blk); err != nil {
+	if err = tracePhase(ctx, "state.processWithdrawals", func(ReadOnlyContext) error {
+		return sp.processWithdrawals(st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err = sp.processRandaoReveal(ctx, st, blk); err != nil {
+	if err = tracePhase(ctx, "state.processRandaoReveal", func(ctx ReadOnlyContext) error {
+		return sp.processRandaoReveal(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
-	if err = sp.processOperations(ctx, st, blk); err != nil {
+	if err = tracePhase(ctx, "state.processOperations", func(ctx ReadOnlyContext) error {
+		return sp.processOperations(ctx, st, blk)
+	}); err != nil {
 		return err
 	}
 
@@ -248,15 +282,16 @@ func (sp *StateProcessor) ProcessBlock(
 
 	// Ensure the calculated state root matches the state root on
 	// the block.
-	stateRoot := st.HashTreeRoot()
-	if blk.GetStateRoot() != stateRoot {
-		return errors.Wrapf(
-			ErrStateRootMismatch, "expected %s, got %s",
-			stateRoot, blk.GetStateRoot(),
-		)
-	}
-
-	return nil
+	return tracePhase(ctx, "state.verifyStateRoot", func(ReadOnlyContext) error {
+		stateRoot := st.HashTreeRoot()
+		if blk.GetStateRoot() != stateRoot {
+			return errors.Wrapf(
+				ErrStateRootMismatch, "expected %s, got %s",
+				stateRoot, blk.GetStateRoot(),
+			)
+		}
+		return nil
+	})
 }
 
 // processEpoch processes the epoch and ensures it matches the local state.
@@ -69,55 +69,79 @@ func (sp *StateProcessor) ProcessFork(
 	}
 
 	// If we are at genesis or moving to a new fork version, upgrade the state.
-	switch forkVersion {
-	case version.Deneb():
-		// Do nothing to the state. NOTE: Deneb is the genesis version of Berachain mainnet and
-		// Bepolia testnet.
-
-		// Log the upgrade to Deneb if requested.
-		if logUpgrade {
-			sp.logDenebFork(timestamp)
-		}
-	case version.Deneb1():
-		// Do nothing to the state. NOTE: Deneb1 is the first hard fork of Berachain mainnet and
-		// Bepolia testnet. In this fork, the Fork struct on BeaconState is NOT updated. In
-		// future hard forks, the Fork struct should be updated.
-
-		// Log the upgrade to Deneb1 if requested.
-		if logUpgrade {
-			sp.logDeneb1Fork(stateFork.PreviousVersion, timestamp, slot)
-		}
-	case version.Electra():
-		if err = sp.upgradeToElectra(st, stateFork, slot); err != nil {
+	upgrade, ok := sp.forkUpgrades[forkVersion]
+	if !ok {
+		panic(fmt.Sprintf("unsupported fork version: %s", forkVersion))
+	}
+	if upgrade.upgrade != nil {
+		if err = upgrade.upgrade(st, stateFork, slot); err != nil {
 			return err
 		}
+	}
 
-		// Log the upgrade to Electra if requested.
-		if logUpgrade {
-			sp.logElectraFork(stateFork.PreviousVersion, timestamp, slot)
-		}
-	case version.Electra1():
-		if err = sp.upgradeToElectra1(st, stateFork, slot); err != nil {
-			return err
-		}
+	// Log the upgrade if requested.
+	if logUpgrade {
+		upgrade.log(stateFork.PreviousVersion, timestamp, slot)
+	}
 
-		if err = sp.processElectra1Fixes(st); err != nil {
-			return err
-		}
+	return nil
+}
+
+// forkUpgrade is the hook preparing the state for a fork version.
+type forkUpgrade struct {
+	// upgrade applies the changes of the fork to the state, if any.
+	upgrade func(st *statedb.StateDB, fork *types.Fork, slot math.Slot) error
+	// log logs information about the fork.
+	log func(previousVersion common.Version, timestamp math.U64, slot math.Slot)
+}
 
-		// Log the upgrade to Electra1 if requested.
-		if logUpgrade {
-			sp.logElectra1Fork(stateFork.PreviousVersion, timestamp, slot)
+// registerForkUpgrades registers the upgrade hooks of the fork versions
+// supported by the state processor. Supporting a new fork version only
+// requires registering its hook here.
+func (sp *StateProcessor) registerForkUpgrades() {
+	// Do nothing to the state. NOTE: Deneb is the genesis version of Berachain mainnet and
+	// Bepolia testnet.
+	sp.registerForkUpgrade(version.Deneb(), forkUpgrade{log: sp.logDenebFork})
+
+	// Do nothing to the state. NOTE: Deneb1 is the first hard fork of Berachain mainnet and
+	// Bepolia testnet. In this fork, the Fork struct on BeaconState is NOT updated. In
+	// future hard forks, the Fork struct should be updated.
+	sp.registerForkUpgrade(version.Deneb1(), forkUpgrade{log: sp.logDeneb1Fork})
+
+	sp.registerForkUpgrade(version.Electra(), forkUpgrade{
+		upgrade: sp.upgradeToElectra,
+		log:     sp.logElectraFork,
+	})
+
+	sp.registerForkUpgrade(version.Electra1(), forkUpgrade{
+		upgrade: func(st *statedb.StateDB, fork *types.Fork, slot math.Slot) error {
+			if err := sp.upgradeToElectra1(st, fork, slot); err != nil {
+				return err
+			}
+			return sp.processElectra1Fixes(st)
+		},
+		log: sp.logElectra1Fork,
+	})
+
+	// The chain spec only accepts supported versions in its fork schedule, so
+	// each of them must have a hook for ProcessFork not to panic.
+	for _, v := range version.GetSupportedVersions() {
+		if _, ok := sp.forkUpgrades[v]; !ok {
+			panic(fmt.Sprintf("no fork upgrade registered for supported version: %s", v))
 		}
-	default:
-		panic(fmt.Sprintf("unsupported fork version: %s", forkVersion))
 	}
+}
 
-	return nil
+// registerForkUpgrade registers the upgrade hook of the given fork version.
+func (sp *StateProcessor) registerForkUpgrade(forkVersion common.Version, upgrade forkUpgrade) {
+	if _, ok := sp.forkUpgrades[forkVersion]; ok {
+		panic(fmt.Sprintf("fork upgrade already registered: %s", forkVersion))
+	}
+	sp.forkUpgrades[forkVersion] = upgrade
 }
 
 // logDenebFork logs information about the Deneb fork.
-func (sp *StateProcessor) logDenebFork(timestamp math.U64) {
+func (sp *StateProcessor) logDenebFork(_ common.Version, timestamp math.U64, _ math.Slot) {
 	// Since Deneb is the earliest fork version supported by beacon-kit, if we are
 	// entering Deneb it must be at genesis, which means the fork time of Deneb is
 	// the timestamp of the genesis block itself.
@@ -167,7 +191,7 @@ func (sp *StateProcessor) logDeneb1Fork(
 
 `,
 			version.Name(previousVersion), previousVersion.String(),
-			sp.cs.Deneb1ForkTime(),
+			sp.cs.ForkTime(version.Deneb1()),
 			slot.Unwrap(), timestamp.Unwrap(),
 			sp.cs.SlotToEpoch(slot).Unwrap(),
 		))
@@ -214,7 +238,7 @@ func (sp *StateProcessor) logElectraFork(
 
 `,
 		version.Name(previousVersion), previousVersion.String(),
-		sp.cs.ElectraForkTime(),
+		sp.cs.ForkTime(version.Electra()),
 		slot.Unwrap(), timestamp.Unwrap(),
 		sp.cs.SlotToEpoch(slot).Unwrap(),
 	))
@@ -263,7 +287,7 @@ func (sp *StateProcessor) logElectra1Fork(
 
 `,
 		version.Name(previousVersion), previousVersion.String(),
-		sp.cs.Electra1ForkTime(),
+		sp.cs.ForkTime(version.Electra1()),
 		slot.Unwrap(), timestamp.Unwrap(),
 		sp.cs.SlotToEpoch(slot).Unwrap(),
 	))
@@ -0,0 +1,118 @@
+//go:build test
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/observability/tracing"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/transition"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// TestTransitionTracing shows that the phases of a state transition are
+// traced as children of the span carried by the consensus context.
+//
+//nolint:paralleltest // sets the global tracer provider
+func TestTransitionTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	prevProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(prevProvider) })
+
+	cs := setupChain(t)
+	sp, st, ds, ctx, _, _ := statetransition.SetupTestState(t, cs)
+
+	genDeposits := types.Deposits{
+		{
+			Pubkey:      [48]byte{0x01},
+			Credentials: types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+			Amount:      cs.MaxEffectiveBalance(),
+			Index:       uint64(0),
+		},
+	}
+	genPayloadHeader := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	require.NoError(t, ds.EnqueueDeposits(ctx.ConsensusCtx(), genDeposits))
+	_, err := sp.InitializeBeaconStateFromEth1(
+		st, genDeposits, genPayloadHeader, cs.GenesisForkVersion(),
+	)
+	require.NoError(t, err)
+
+	_, depRoot, err := ds.GetDepositsByIndex(
+		ctx.ConsensusCtx(), constants.FirstDepositIndex, uint64(len(genDeposits)),
+	)
+	require.NoError(t, err)
+	blk := buildNextBlock(
+		t,
+		cs,
+		st,
+		types.NewEth1Data(depRoot),
+		10,
+		nil,
+		&types.ExecutionRequests{},
+		st.EVMInflationWithdrawal(10),
+	)
+
+	spanCtx, root := tracing.Start(ctx.ConsensusCtx(), "root")
+	txCtx := transition.NewTransitionCtx(spanCtx, ctx.ConsensusTime(), ctx.ProposerAddress()).
+		WithVerifyPayload(false).
+		WithVerifyRandao(false).
+		WithVerifyResult(false).
+		WithMeterGas(false)
+	_, err = sp.Transition(txCtx, st, blk)
+	require.NoError(t, err)
+	root.End()
+
+	// Index the spans of the transition by name and check their parents.
+	spans := make(map[string]tracetest.SpanStub)
+	for _, span := range exporter.GetSpans() {
+		if span.SpanContext.TraceID() == root.SpanContext().TraceID() {
+			spans[span.Name] = span
+		}
+	}
+	parents := map[string]string{
+		"state.Transition":              "root",
+		"state.ProcessSlots":            "state.Transition",
+		"state.ProcessFork":             "state.Transition",
+		"state.ProcessBlock":            "state.Transition",
+		"state.processBlockHeader":      "state.ProcessBlock",
+		"state.processExecutionPayload": "state.ProcessBlock",
+		"state.processWithdrawals":      "state.ProcessBlock",
+		"state.processRandaoReveal":     "state.ProcessBlock",
+		"state.processOperations":       "state.ProcessBlock",
+	}
+	require.Len(t, spans, len(parents)+1)
+	for name, parent := range parents {
+		require.Contains(t, spans, name)
+		require.Equal(t, spans[parent].SpanContext.SpanID(), spans[name].Parent.SpanID(), name)
+	}
+}
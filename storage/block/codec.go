@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+)
+
+// versionSize is the size of the fork version prefixed to each stored block.
+const versionSize = 4
+
+// signedBlockCodec encodes signed beacon blocks as their fork version
+// followed by their SSZ encoding. The fork version is stored along with the
+// block because the SSZ layout of a block depends on it.
+type signedBlockCodec struct{}
+
+// Encode marshals the provided block into its versioned SSZ encoding.
+func (signedBlockCodec) Encode(blk *ctypes.SignedBeaconBlock) ([]byte, error) {
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	forkVersion := blk.GetForkVersion()
+	return append(forkVersion[:], bz...), nil
+}
+
+// Decode unmarshals the provided bytes into a signed beacon block.
+func (signedBlockCodec) Decode(bz []byte) (*ctypes.SignedBeaconBlock, error) {
+	if len(bz) < versionSize {
+		return nil, fmt.Errorf("stored block too short: %d bytes", len(bz))
+	}
+	blk, err := ctypes.NewEmptySignedBeaconBlockWithVersion(
+		common.Version(bz[:versionSize]),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return blk, ssz.Unmarshal(bz[versionSize:], blk)
+}
+
+// EncodeJSON is not implemented and will panic if called.
+func (signedBlockCodec) EncodeJSON(*ctypes.SignedBeaconBlock) ([]byte, error) {
+	panic("not implemented")
+}
+
+// DecodeJSON is not implemented and will panic if called.
+func (signedBlockCodec) DecodeJSON([]byte) (*ctypes.SignedBeaconBlock, error) {
+	panic("not implemented")
+}
+
+// Stringify returns the string representation of the provided block.
+func (signedBlockCodec) Stringify(blk *ctypes.SignedBeaconBlock) string {
+	return fmt.Sprintf("SignedBeaconBlock(slot=%d)", blk.GetSlot())
+}
+
+// ValueType returns the name of the type that this codec is intended for.
+func (signedBlockCodec) ValueType() string {
+	return "SignedBeaconBlock"
+}
@@ -21,88 +21,240 @@
 package block
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
+	sdkcollections "cosmossdk.io/collections"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/errors"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
-	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/berachain/beacon-kit/storage/encoding"
+	dbm "github.com/cosmos/cosmos-db"
 )
 
-// KVStore is a simple memory store based implementation that stores metadata of
-// beacon blocks.
-type KVStore[BeaconBlockT BeaconBlock] struct {
+const (
+	blocksPrefix     = "blocks"
+	blockRootsPrefix = "block_roots"
+	timestampsPrefix = "timestamps"
+	stateRootsPrefix = "state_roots"
+)
+
+// KVStore is a disk backed store of finalized signed beacon blocks. Blocks
+// are keyed by slot, with secondary indexes from block root, timestamp and
+// state root to slot.
+type KVStore struct {
+	// blocks maps a slot to the signed beacon block finalized at that slot.
+	blocks sdkcollections.Map[math.Slot, *ctypes.SignedBeaconBlock]
+
 	// Beacon block root to slot mapping is injective for finalized blocks.
-	blockRoots *lru.Cache[common.Root, math.Slot]
+	blockRoots sdkcollections.Map[[]byte, math.Slot]
 
 	// Timestamp to slot mapping is injective for finalized blocks. This is
 	// guaranteed by CometBFT consensus. So each slot will be associated with a
 	// different timestamp (no overwriting) as we store only finalized blocks.
-	timestamps *lru.Cache[math.U64, math.Slot]
+	timestamps sdkcollections.Map[math.U64, math.Slot]
 
 	// Beacon state root to slot mapping is injective for finalized blocks.
-	stateRoots *lru.Cache[common.Root, math.Slot]
+	stateRoots sdkcollections.Map[[]byte, math.Slot]
+
+	// availabilityWindow is the number of slots to keep in the store.
+	// Ignored in archive mode.
+	availabilityWindow uint64
+	// archiveMode disables pruning, keeping every block in the store.
+	archiveMode bool
+
+	// closeFunc closes the underlying database, at most once.
+	closeFunc func() error
+	once      sync.Once
 
 	// Logger for the store.
 	logger log.Logger
 }
 
-// NewStore creates a new block store.
-func NewStore[BeaconBlockT BeaconBlock](
+// NewStore creates a new block store backed by the given database.
+func NewStore(
+	db dbm.DB,
 	logger log.Logger,
 	availabilityWindow int,
-) *KVStore[BeaconBlockT] {
-	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
+	archiveMode bool,
+) *KVStore {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(newKVStoreProvider(db))
+	kv := &KVStore{
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(blocksPrefix)),
+			blocksPrefix,
+			encoding.U64Key,
+			signedBlockCodec{},
+		),
+		blockRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(blockRootsPrefix)),
+			blockRootsPrefix,
+			sdkcollections.BytesKey,
+			encoding.U64Value,
+		),
+		timestamps: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(timestampsPrefix)),
+			timestampsPrefix,
+			encoding.U64Key,
+			encoding.U64Value,
+		),
+		stateRoots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(stateRootsPrefix)),
+			stateRootsPrefix,
+			sdkcollections.BytesKey,
+			encoding.U64Value,
+		),
+		//#nosec: G115 // the availability window is never negative.
+		availabilityWindow: uint64(availabilityWindow),
+		archiveMode:        archiveMode,
+		closeFunc:          db.Close,
+		logger:             logger,
+	}
+	if _, err := schemaBuilder.Build(); err != nil {
+		panic(errors.Wrap(err, "failed building block store schema"))
+	}
+	return kv
+}
+
+// Close closes the underlying database. It is safe to call multiple times.
+func (kv *KVStore) Close() error {
+	var err error
+	kv.once.Do(func() { err = kv.closeFunc() })
+	return err
+}
+
+// Set stores the signed block along with its block root, timestamp, and
+// state root indexes. Unless the store is in archive mode, only this function
+// may prune blocks falling out of the availability window.
+func (kv *KVStore) Set(blk *ctypes.SignedBeaconBlock) error {
+	var (
+		ctx  = context.Background()
+		slot = blk.GetSlot()
+	)
+	if err := kv.blocks.Set(ctx, slot, blk); err != nil {
+		return errors.Wrapf(err, "failed storing block at slot %d", slot)
+	}
+
+	blockRoot := blk.GetBeaconBlock().HashTreeRoot()
+	if err := kv.blockRoots.Set(ctx, blockRoot[:], slot); err != nil {
+		return errors.Wrapf(err, "failed indexing block root at slot %d", slot)
+	}
+	if err := kv.timestamps.Set(ctx, blk.GetTimestamp(), slot); err != nil {
+		return errors.Wrapf(err, "failed indexing timestamp at slot %d", slot)
+	}
+	stateRoot := blk.GetStateRoot()
+	if err := kv.stateRoots.Set(ctx, stateRoot[:], slot); err != nil {
+		return errors.Wrapf(err, "failed indexing state root at slot %d", slot)
+	}
+
+	if kv.archiveMode || slot.Unwrap() < kv.availabilityWindow {
+		return nil
+	}
+	return kv.prune(ctx, slot-math.Slot(kv.availabilityWindow))
+}
+
+// prune removes all blocks, and their indexes, up to and including the
+// given slot.
+func (kv *KVStore) prune(ctx context.Context, upTo math.Slot) error {
+	removed, err := kv.remove(ctx, new(sdkcollections.Range[math.Slot]).EndInclusive(upTo))
 	if err != nil {
-		panic(err)
+		return errors.Wrapf(err, "failed pruning blocks up to slot %d", upTo)
+	}
+	if removed > 0 {
+		kv.logger.Debug("Pruned blocks", "up_to", upTo.Base10(), "count", removed)
 	}
-	timestamps, err := lru.New[math.U64, math.Slot](availabilityWindow)
+	return nil
+}
+
+// SlotsAfter returns the slots of the stored blocks after the given slot, in
+// ascending order.
+func (kv *KVStore) SlotsAfter(slot math.Slot) ([]math.Slot, error) {
+	iter, err := kv.blocks.Iterate(
+		context.Background(), new(sdkcollections.Range[math.Slot]).StartExclusive(slot),
+	)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrapf(err, "failed iterating blocks after slot %d", slot)
 	}
-	stateRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
+	return iter.Keys()
+}
+
+// DeleteAfter removes all blocks, and their indexes, after the given slot. It
+// is used to roll the store back along with the beacon state.
+func (kv *KVStore) DeleteAfter(slot math.Slot) error {
+	removed, err := kv.remove(
+		context.Background(), new(sdkcollections.Range[math.Slot]).StartExclusive(slot),
+	)
 	if err != nil {
-		panic(err)
+		return errors.Wrapf(err, "failed deleting blocks after slot %d", slot)
 	}
-	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+	kv.logger.Debug("Deleted blocks", "after", slot.Base10(), "count", removed)
+	return nil
+}
+
+// remove removes the blocks in the given range along with their indexes and
+// returns how many were removed.
+func (kv *KVStore) remove(ctx context.Context, rng sdkcollections.Ranger[math.Slot]) (int, error) {
+	iter, err := kv.blocks.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	// Collect before removing so that the iterator is not invalidated.
+	blks, err := iter.Values()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, blk := range blks {
+		slot := blk.GetSlot()
+		blockRoot := blk.GetBeaconBlock().HashTreeRoot()
+		stateRoot := blk.GetStateRoot()
+		if err = errors.Join(
+			kv.blockRoots.Remove(ctx, blockRoot[:]),
+			kv.timestamps.Remove(ctx, blk.GetTimestamp()),
+			kv.stateRoots.Remove(ctx, stateRoot[:]),
+			kv.blocks.Remove(ctx, slot),
+		); err != nil {
+			return 0, errors.Wrapf(err, "failed removing block at slot %d", slot)
+		}
 	}
+	return len(blks), nil
 }
 
-// Set sets the block by a given index in the store, storing the block root,
-// timestamp, and state root. Only this function may potentially evict
-// entries from the store if the availability window is reached.
-func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
-	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
-	kv.timestamps.Add(blk.GetTimestamp(), slot)
-	kv.stateRoots.Add(blk.GetStateRoot(), slot)
-	return nil
+// GetBlockBySlot retrieves the signed block finalized at the given slot.
+func (kv *KVStore) GetBlockBySlot(slot math.Slot) (*ctypes.SignedBeaconBlock, error) {
+	blk, err := kv.blocks.Get(context.Background(), slot)
+	if err != nil {
+		return nil, fmt.Errorf("block not found at slot %d: %w", slot, err)
+	}
+	return blk, nil
 }
 
 // GetSlotByBlockRoot retrieves the slot by a given block root from the store.
-func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
+func (kv *KVStore) GetSlotByBlockRoot(
 	blockRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.blockRoots.Peek(blockRoot)
-	if !ok {
-		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
+	slot, err := kv.blockRoots.Get(context.Background(), blockRoot[:])
+	if err != nil {
+		return 0, fmt.Errorf("slot not found at block root: %s: %w", blockRoot, err)
 	}
 	return slot, nil
 }
 
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
 // the store.
-func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
+func (kv *KVStore) GetParentSlotByTimestamp(
 	timestamp math.U64,
 ) (math.Slot, error) {
-	slot, ok := kv.timestamps.Peek(timestamp)
-	if !ok {
-		return slot, fmt.Errorf("slot not found at timestamp: %d", timestamp)
+	slot, err := kv.timestamps.Get(context.Background(), timestamp)
+	if err != nil {
+		return slot, fmt.Errorf("slot not found at timestamp: %d: %w", timestamp, err)
 	}
 	if slot == 0 {
 		return slot, errors.New("parent slot not supported for genesis slot 0")
@@ -111,13 +263,46 @@ func (kv *KVStore[BeaconBlockT]) GetParentSlotByTimestamp(
 	return slot - 1, nil
 }
 
+// GetFirstSlotFromTimestamp retrieves the slot of the first block of the chain
+// whose timestamp is at or after the given timestamp. It fails if that block
+// may have been pruned, i.e. if the store lacks the block preceding the
+// earliest stored block at or after the timestamp.
+func (kv *KVStore) GetFirstSlotFromTimestamp(timestamp math.U64) (math.Slot, error) {
+	ctx := context.Background()
+	iter, err := kv.timestamps.Iterate(ctx, new(sdkcollections.Range[math.U64]).StartInclusive(timestamp))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed iterating timestamps from %d", timestamp)
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, fmt.Errorf("slot not found from timestamp: %d", timestamp)
+	}
+	slot, err := iter.Value()
+	if err != nil {
+		return 0, err
+	}
+
+	// The genesis slot has no block.
+	if slot <= 1 {
+		return slot, nil
+	}
+	found, err := kv.blocks.Has(ctx, slot-1)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("slot not found from timestamp: %d: block of slot %d pruned", timestamp, slot-1)
+	}
+	return slot, nil
+}
+
 // GetSlotByStateRoot retrieves the slot by a given state root from the store.
-func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
+func (kv *KVStore) GetSlotByStateRoot(
 	stateRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.stateRoots.Peek(stateRoot)
-	if !ok {
-		return 0, fmt.Errorf("slot not found at state root: %s", stateRoot)
+	slot, err := kv.stateRoots.Get(context.Background(), stateRoot[:])
+	if err != nil {
+		return 0, fmt.Errorf("slot not found at state root: %s: %w", stateRoot, err)
 	}
 	return slot, nil
 }
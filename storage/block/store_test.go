@@ -23,36 +23,30 @@ package block_test
 import (
 	"testing"
 
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log/noop"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	"github.com/berachain/beacon-kit/storage/block"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 )
 
-type MockBeaconBlock struct {
-	slot math.Slot
-}
-
-func (m MockBeaconBlock) GetSlot() math.Slot {
-	return m.slot
-}
-
-func (m MockBeaconBlock) HashTreeRoot() common.Root {
-	return [32]byte{byte(m.slot)}
-}
-
-func (m MockBeaconBlock) GetTimestamp() math.U64 {
-	return m.slot
-}
-
-func (m MockBeaconBlock) GetStateRoot() common.Root {
-	return [32]byte{byte(m.slot)}
+func newSignedBlock(t *testing.T, slot math.Slot) *ctypes.SignedBeaconBlock {
+	t.Helper()
+	blk, err := ctypes.NewBeaconBlockWithVersion(
+		slot, 0, common.Root{byte(slot - 1)}, version.Deneb1(),
+	)
+	require.NoError(t, err)
+	blk.StateRoot = common.Root{byte(slot)}
+	blk.Body.ExecutionPayload.Timestamp = slot
+	return &ctypes.SignedBeaconBlock{BeaconBlock: blk}
 }
 
 func TestBlockStore(t *testing.T) {
 	t.Parallel()
-	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	blockStore := block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 5, false)
 
 	var (
 		slot math.Slot
@@ -61,14 +55,21 @@ func TestBlockStore(t *testing.T) {
 
 	// Set 7 blocks.
 	// The latest block is 7 and should hold the last 5 blocks in the window.
-	for i := 1; i <= 7; i++ {
-		err = blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)})
+	blks := make(map[math.Slot]*ctypes.SignedBeaconBlock)
+	for i := math.Slot(1); i <= 7; i++ {
+		blks[i] = newSignedBlock(t, i)
+		err = blockStore.Set(blks[i])
 		require.NoError(t, err)
 	}
 
-	// Get the slots by roots & timestamps.
+	// Get the blocks and slots by roots & timestamps.
 	for i := math.Slot(3); i <= 7; i++ {
-		slot, err = blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
+		var blk *ctypes.SignedBeaconBlock
+		blk, err = blockStore.GetBlockBySlot(i)
+		require.NoError(t, err)
+		require.Equal(t, blks[i].HashTreeRoot(), blk.HashTreeRoot())
+
+		slot, err = blockStore.GetSlotByBlockRoot(blks[i].GetBeaconBlock().HashTreeRoot())
 		require.NoError(t, err)
 		require.Equal(t, i, slot)
 
@@ -80,10 +81,80 @@ func TestBlockStore(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, i, slot)
 	}
+	slot, err = blockStore.GetFirstSlotFromTimestamp(4)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(4), slot)
+	_, err = blockStore.GetFirstSlotFromTimestamp(8)
+	require.ErrorContains(t, err, "not found")
+
+	// Blocks out of the window have been pruned.
+	_, err = blockStore.GetBlockBySlot(2)
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSlotByBlockRoot(blks[2].GetBeaconBlock().HashTreeRoot())
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetParentSlotByTimestamp(2)
+	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSlotByStateRoot([32]byte{byte(2)})
+	require.ErrorContains(t, err, "not found")
+
+	// The first block from a timestamp is unknown when the blocks before the
+	// earliest stored block have been pruned.
+	_, err = blockStore.GetFirstSlotFromTimestamp(1)
+	require.ErrorContains(t, err, "pruned")
+	_, err = blockStore.GetFirstSlotFromTimestamp(3)
+	require.ErrorContains(t, err, "pruned")
 
 	// Try getting a slot that doesn't exist.
 	_, err = blockStore.GetSlotByBlockRoot([32]byte{byte(8)})
 	require.ErrorContains(t, err, "not found")
-	_, err = blockStore.GetParentSlotByTimestamp(2)
+	require.NoError(t, blockStore.Close())
+}
+
+func TestBlockStoreArchiveMode(t *testing.T) {
+	t.Parallel()
+	blockStore := block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 5, true)
+
+	for i := math.Slot(1); i <= 7; i++ {
+		require.NoError(t, blockStore.Set(newSignedBlock(t, i)))
+	}
+
+	// No block is pruned in archive mode.
+	for i := math.Slot(1); i <= 7; i++ {
+		blk, err := blockStore.GetBlockBySlot(i)
+		require.NoError(t, err)
+		require.Equal(t, i, blk.GetSlot())
+		require.Equal(t, version.Deneb1(), blk.GetForkVersion())
+	}
+	slot, err := blockStore.GetFirstSlotFromTimestamp(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), slot)
+}
+
+func TestBlockStoreDeleteAfter(t *testing.T) {
+	t.Parallel()
+	blockStore := block.NewStore(dbm.NewMemDB(), noop.NewLogger[any](), 10, false)
+
+	blks := make(map[math.Slot]*ctypes.SignedBeaconBlock)
+	for i := math.Slot(1); i <= 5; i++ {
+		blks[i] = newSignedBlock(t, i)
+		require.NoError(t, blockStore.Set(blks[i]))
+	}
+
+	slots, err := blockStore.SlotsAfter(3)
+	require.NoError(t, err)
+	require.Equal(t, []math.Slot{4, 5}, slots)
+
+	// Blocks after slot 3 are removed along with their indexes.
+	require.NoError(t, blockStore.DeleteAfter(3))
+	slots, err = blockStore.SlotsAfter(0)
+	require.NoError(t, err)
+	require.Equal(t, []math.Slot{1, 2, 3}, slots)
+	_, err = blockStore.GetSlotByBlockRoot(blks[4].GetBeaconBlock().HashTreeRoot())
 	require.ErrorContains(t, err, "not found")
+	_, err = blockStore.GetSlotByStateRoot([32]byte{byte(5)})
+	require.ErrorContains(t, err, "not found")
+
+	_, err = blockStore.GetBlockBySlot(3)
+	require.NoError(t, err)
+	require.NoError(t, blockStore.Close())
 }
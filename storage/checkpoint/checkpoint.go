@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package checkpoint
+
+import (
+	"fmt"
+	"os"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/karalabe/ssz"
+)
+
+const (
+	// forkVersionOffset is the offset of the fork version in an SSZ encoded
+	// checkpoint, after the height and app hash.
+	forkVersionOffset = 8 + 32
+
+	// fixedSize is the size of the fixed part of an SSZ encoded checkpoint:
+	// height (8) + app hash (32) + fork version (4) + block header (112) +
+	// state offset (4).
+	fixedSize = forkVersionOffset + 4 + ctypes.BeaconBlockHeaderSize + 4
+)
+
+// Checkpoint is a beacon state at a given height together with the metadata
+// needed to verify it.
+type Checkpoint struct {
+	// Height is the CometBFT height the state was committed at.
+	Height uint64
+	// AppHash is the app hash committed at Height.
+	AppHash common.Root
+	// ForkVersion is the fork version of the state, which determines how the
+	// state is encoded.
+	ForkVersion common.Version
+	// BlockHeader is the header of the block at Height, including the root
+	// of State.
+	BlockHeader *ctypes.BeaconBlockHeader
+	// State is the beacon state after the block at Height.
+	State *ctypes.BeaconState
+}
+
+// New creates a checkpoint of the given beacon state committed at the given
+// height. The block header is the latest block header of the state with its
+// state root filled in, so its root is the root of the latest block.
+func New(height uint64, appHash common.Root, st *ctypes.BeaconState) *Checkpoint {
+	header := *st.LatestBlockHeader
+	if header.GetStateRoot() == (common.Root{}) {
+		header.SetStateRoot(st.HashTreeRoot())
+	}
+	return &Checkpoint{
+		Height:      height,
+		AppHash:     appHash,
+		ForkVersion: st.Fork.CurrentVersion,
+		BlockHeader: &header,
+		State:       st,
+	}
+}
+
+// BlockRoot returns the root of the block at the checkpoint height.
+func (c *Checkpoint) BlockRoot() common.Root {
+	return c.BlockHeader.HashTreeRoot()
+}
+
+// Verify checks that the block header and fork version of the checkpoint
+// match its beacon state.
+func (c *Checkpoint) Verify() error {
+	if c.State.Fork.CurrentVersion != c.ForkVersion {
+		return fmt.Errorf(
+			"%w: expected %s, state has %s",
+			ErrForkVersionMismatch, c.ForkVersion, c.State.Fork.CurrentVersion,
+		)
+	}
+	if c.State.LatestBlockHeader.GetSlot() != c.BlockHeader.GetSlot() {
+		return fmt.Errorf(
+			"%w: header has %d, state has %d",
+			ErrSlotMismatch, c.BlockHeader.GetSlot(), c.State.LatestBlockHeader.GetSlot(),
+		)
+	}
+	if root := c.State.HashTreeRoot(); root != c.BlockHeader.GetStateRoot() {
+		return fmt.Errorf(
+			"%w: header has %s, state has %s",
+			ErrStateRootMismatch, c.BlockHeader.GetStateRoot(), root,
+		)
+	}
+	return nil
+}
+
+// WriteFile writes the SSZ encoded checkpoint to the given path.
+func (c *Checkpoint) WriteFile(path string) error {
+	bz, err := c.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// ReadFile reads an SSZ encoded checkpoint from the given path and verifies
+// it.
+func ReadFile(path string) (*Checkpoint, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := new(Checkpoint)
+	if err = c.UnmarshalSSZ(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint %s: %w", path, err)
+	}
+	if err = c.Verify(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the ssz encoded size in bytes for the Checkpoint object.
+func (c *Checkpoint) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	if fixed {
+		return fixedSize
+	}
+	return fixedSize + ssz.SizeDynamicObject(siz, c.State)
+}
+
+// DefineSSZ defines the SSZ encoding for the Checkpoint object.
+func (c *Checkpoint) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &c.Height)
+	ssz.DefineStaticBytes(codec, &c.AppHash)
+	ssz.DefineStaticBytes(codec, &c.ForkVersion)
+	ssz.DefineStaticObject(codec, &c.BlockHeader)
+	ssz.DefineDynamicObjectOffset(codec, &c.State)
+
+	ssz.DefineDynamicObjectContent(codec, &c.State)
+}
+
+// MarshalSSZ marshals the Checkpoint into SSZ format.
+func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the Checkpoint from SSZ format. The fork version is
+// read first since it determines the encoding of the state and the version of
+// its execution payload header.
+func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
+	if len(buf) < fixedSize {
+		return ErrTruncated
+	}
+	var forkVersion common.Version
+	copy(forkVersion[:], buf[forkVersionOffset:])
+	c.State = ctypes.NewEmptyBeaconStateWithVersion(forkVersion)
+	c.State.LatestExecutionPayloadHeader = ctypes.NewEmptyExecutionPayloadHeaderWithVersion(forkVersion)
+	return ssz.DecodeFromBytes(buf, c)
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package checkpoint_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	"github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/checkpoint"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/stretchr/testify/require"
+)
+
+// genesisState returns the marshallable genesis state of a devnet chain and
+// its deposits.
+func genesisState(t *testing.T) (*types.BeaconState, types.Deposits) {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	sp, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+
+	deposits := make(types.Deposits, 0, 4)
+	for i := range 4 {
+		deposits = append(deposits, &types.Deposit{
+			Pubkey: [48]byte{byte(i + 1)},
+			Amount: cs.MaxEffectiveBalance(),
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{byte(i + 1)},
+			),
+			Index: uint64(i),
+		})
+	}
+	header := &types.ExecutionPayloadHeader{
+		Versionable: types.NewVersionable(cs.GenesisForkVersion()),
+	}
+	_, err = sp.InitializeBeaconStateFromEth1(st, deposits, header, cs.GenesisForkVersion())
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlashingAtIndex(0, math.Gwei(1)))
+
+	bs, err := st.GetMarshallable()
+	require.NoError(t, err)
+	return bs, deposits
+}
+
+func TestCheckpointRoundTrip(t *testing.T) {
+	t.Parallel()
+	bs, _ := genesisState(t)
+	cp := checkpoint.New(7, common.Root{0xaa}, bs)
+	require.NoError(t, cp.Verify())
+	require.Equal(t, bs.HashTreeRoot(), cp.BlockHeader.GetStateRoot())
+	require.Equal(t, bs.Fork.CurrentVersion, cp.ForkVersion)
+	// The header of the state itself is left untouched.
+	require.Equal(t, common.Root{}, bs.LatestBlockHeader.GetStateRoot())
+
+	path := filepath.Join(t.TempDir(), "state.ssz")
+	require.NoError(t, cp.WriteFile(path))
+	got, err := checkpoint.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), got.Height)
+	require.Equal(t, common.Root{0xaa}, got.AppHash)
+	require.Equal(t, cp.ForkVersion, got.ForkVersion)
+	require.Equal(t, cp.BlockHeader, got.BlockHeader)
+	require.Equal(t, cp.BlockRoot(), got.BlockRoot())
+	require.Equal(t, bs.HashTreeRoot(), got.State.HashTreeRoot())
+	require.Equal(t, cp.ForkVersion, got.State.LatestExecutionPayloadHeader.GetForkVersion())
+}
+
+func TestCheckpointVerify(t *testing.T) {
+	t.Parallel()
+	bs, _ := genesisState(t)
+
+	cp := checkpoint.New(1, common.Root{}, bs)
+	cp.ForkVersion = common.Version{0xff}
+	require.ErrorIs(t, cp.Verify(), checkpoint.ErrForkVersionMismatch)
+
+	cp = checkpoint.New(1, common.Root{}, bs)
+	cp.BlockHeader.SetSlot(cp.BlockHeader.GetSlot() + 1)
+	require.ErrorIs(t, cp.Verify(), checkpoint.ErrSlotMismatch)
+
+	cp = checkpoint.New(1, common.Root{}, bs)
+	cp.BlockHeader.SetStateRoot(common.Root{0x01})
+	require.ErrorIs(t, cp.Verify(), checkpoint.ErrStateRootMismatch)
+
+	bz, err := checkpoint.New(1, common.Root{}, bs).MarshalSSZ()
+	require.NoError(t, err)
+	require.ErrorIs(t, new(checkpoint.Checkpoint).UnmarshalSSZ(bz[:100]), checkpoint.ErrTruncated)
+}
+
+func TestSetMarshallable(t *testing.T) {
+	t.Parallel()
+	bs, _ := genesisState(t)
+
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	_, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+	require.NoError(t, st.SetMarshallable(bs))
+
+	got, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, bs.HashTreeRoot(), got.HashTreeRoot())
+	require.Equal(t, bs.Validators, got.Validators)
+	require.Equal(t, bs.Balances, got.Balances)
+	require.Equal(t, bs.Slashings, got.Slashings)
+}
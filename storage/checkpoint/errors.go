@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package checkpoint
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrTruncated is returned when a checkpoint is too short to hold its
+	// metadata.
+	ErrTruncated = errors.New("checkpoint truncated")
+
+	// ErrForkVersionMismatch is returned when the fork version of a checkpoint
+	// does not match the fork of its beacon state.
+	ErrForkVersionMismatch = errors.New("checkpoint fork version mismatch")
+
+	// ErrSlotMismatch is returned when the block header of a checkpoint is not
+	// the latest block header of its beacon state.
+	ErrSlotMismatch = errors.New("checkpoint block header slot mismatch")
+
+	// ErrStateRootMismatch is returned when the block header of a checkpoint
+	// does not commit to its beacon state.
+	ErrStateRootMismatch = errors.New("checkpoint state root mismatch")
+)
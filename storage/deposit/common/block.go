@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// blockSize is the size of an encoded Block.
+const blockSize = 8 + 32
+
+// ErrInvalidBlockEncoding is returned when decoding a Block of the wrong size.
+var ErrInvalidBlockEncoding = errors.New("invalid deposit block encoding")
+
+// Block identifies the EL block that emitted a deposit.
+type Block struct {
+	// Number is the number of the EL block.
+	Number math.U64
+	// Hash is the hash of the EL block.
+	Hash common.ExecutionHash
+}
+
+// MarshalBinary encodes the block as its big endian number followed by its
+// hash.
+func (b Block) MarshalBinary() ([]byte, error) {
+	bz := make([]byte, blockSize)
+	binary.BigEndian.PutUint64(bz, b.Number.Unwrap())
+	copy(bz[8:], b.Hash[:])
+	return bz, nil
+}
+
+// UnmarshalBinary decodes a block encoded by MarshalBinary.
+func (b *Block) UnmarshalBinary(bz []byte) error {
+	if len(bz) != blockSize {
+		return ErrInvalidBlockEncoding
+	}
+	b.Number = math.U64(binary.BigEndian.Uint64(bz))
+	copy(b.Hash[:], bz[8:])
+	return nil
+}
@@ -29,6 +29,7 @@ import (
 	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	"github.com/berachain/beacon-kit/log"
 	"github.com/berachain/beacon-kit/primitives/common"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
 	depositstorev1 "github.com/berachain/beacon-kit/storage/deposit/v1"
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -41,7 +42,12 @@ const (
 type Store interface {
 	GetDepositsByIndex(ctx context.Context, startIndex uint64, depRange uint64) (ctypes.Deposits, common.Root, error)
 	EnqueueDeposits(ctx context.Context, deposits []*ctypes.Deposit) error
+	EnqueueBlockDeposits(ctx context.Context, block depositstorecommon.Block, deposits []*ctypes.Deposit) error
+	GetDepositBlock(ctx context.Context, index uint64) (depositstorecommon.Block, bool, error)
+	RollbackDeposits(ctx context.Context, fromIndex uint64, lastIndexedBlock uint64) error
 	Prune(ctx context.Context, start, end uint64) error
+	GetLastIndexedBlock(ctx context.Context) (uint64, bool, error)
+	SetLastIndexedBlock(ctx context.Context, blockNum uint64) error
 	Close() error
 }
 
@@ -105,6 +111,49 @@ func (gs *generalStore) EnqueueDeposits(ctx context.Context, deposits []*ctypes.
 	}
 }
 
+func (gs *generalStore) EnqueueBlockDeposits(
+	ctx context.Context,
+	block depositstorecommon.Block,
+	deposits []*ctypes.Deposit,
+) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.EnqueueBlockDeposits(ctx, block, deposits)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) GetDepositBlock(
+	ctx context.Context,
+	index uint64,
+) (depositstorecommon.Block, bool, error) {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.GetDepositBlock(ctx, index)
+	default:
+		return depositstorecommon.Block{}, false, fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) RollbackDeposits(ctx context.Context, fromIndex uint64, lastIndexedBlock uint64) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.RollbackDeposits(ctx, fromIndex, lastIndexedBlock)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
 func (gs *generalStore) Close() error {
 	gs.mu.Lock()
 	defer gs.mu.Unlock()
@@ -123,3 +172,27 @@ func (gs *generalStore) Prune(ctx context.Context, start, end uint64) error {
 		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
 	}
 }
+
+func (gs *generalStore) GetLastIndexedBlock(ctx context.Context) (uint64, bool, error) {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.GetLastIndexedBlock(ctx)
+	default:
+		return 0, false, fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
+
+func (gs *generalStore) SetLastIndexedBlock(ctx context.Context, blockNum uint64) error {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	switch gs.currentVersion {
+	case v1:
+		return gs.storeV1.SetLastIndexedBlock(ctx, blockNum)
+	default:
+		return fmt.Errorf("%w, version %d", ErrUnknownStoreVersion, gs.currentVersion)
+	}
+}
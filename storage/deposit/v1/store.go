@@ -35,13 +35,30 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix = "deposit"
+
+	// KeyLastIndexedBlockPrefix is the key of the last EL block whose deposits
+	// have all been stored.
+	KeyLastIndexedBlockPrefix = "last_indexed_block"
+
+	// KeyDepositBlockPrefix is the prefix of the EL block that emitted each
+	// deposit, keyed by deposit index.
+	KeyDepositBlockPrefix = "el_block"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore struct {
 	store sdkcollections.Map[uint64, *ctypes.Deposit]
 
+	// lastIndexedBlock is the checkpoint of deposit fetching from the EL.
+	lastIndexedBlock sdkcollections.Item[uint64]
+
+	// blocks maps deposit indexes to the encoded EL block that emitted them,
+	// so that deposits can be re-validated against the canonical EL chain.
+	blocks sdkcollections.Map[uint64, []byte]
+
 	// closeFunc is a closure that closes the underlying database
 	// used by store to ensure that all writes are flushed to disk.
 	// We guarantee that closeFunc is called at maximum only once.
@@ -75,6 +92,19 @@ func NewStore(
 				NewEmptyF: ctypes.NewEmptyDeposit,
 			},
 		),
+		lastIndexedBlock: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyLastIndexedBlockPrefix)),
+			KeyLastIndexedBlockPrefix,
+			sdkcollections.Uint64Value,
+		),
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyDepositBlockPrefix)),
+			KeyDepositBlockPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
 		closeFunc: closeFunc,
 		logger:    logger,
 	}
@@ -144,6 +174,85 @@ func (kv *KVStore) EnqueueDeposits(ctx context.Context, deposits []*ctypes.Depos
 	return nil
 }
 
+// EnqueueBlockDeposits pushes the deposits emitted by the given EL block to the
+// queue, recording the block alongside each deposit.
+func (kv *KVStore) EnqueueBlockDeposits(
+	ctx context.Context,
+	block depositstorecommon.Block,
+	deposits []*ctypes.Deposit,
+) error {
+	bz, err := block.MarshalBinary()
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode deposit block %d", block.Number)
+	}
+	for _, deposit := range deposits {
+		idx := deposit.GetIndex().Unwrap()
+		if err = kv.blocks.Set(ctx, idx, bz); err != nil {
+			return errors.Wrapf(err, "failed to record block of deposit %d", idx)
+		}
+	}
+	return kv.EnqueueDeposits(ctx, deposits)
+}
+
+// GetDepositBlock returns the EL block that emitted the deposit at the given
+// index. The boolean is false if the block is unknown, as for genesis deposits.
+func (kv *KVStore) GetDepositBlock(
+	ctx context.Context,
+	index uint64,
+) (depositstorecommon.Block, bool, error) {
+	var block depositstorecommon.Block
+	bz, err := kv.blocks.Get(ctx, index)
+	switch {
+	case err == nil:
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return block, false, nil
+	default:
+		return block, false, errors.Wrapf(err, "failed to get block of deposit %d", index)
+	}
+	if err = block.UnmarshalBinary(bz); err != nil {
+		return block, false, errors.Wrapf(err, "failed to decode block of deposit %d", index)
+	}
+	return block, true, nil
+}
+
+// RollbackDeposits removes all deposits from the given index onward and
+// rewinds the last indexed block to lastIndexedBlock, so that the removed
+// deposits are fetched again. The checkpoint is rewound first, so that an
+// interrupted rollback is retried rather than leaving deposits unfetched.
+func (kv *KVStore) RollbackDeposits(
+	ctx context.Context,
+	fromIndex uint64,
+	lastIndexedBlock uint64,
+) error {
+	if err := kv.SetLastIndexedBlock(ctx, lastIndexedBlock); err != nil {
+		return err
+	}
+
+	iter, err := kv.store.Iterate(ctx, new(sdkcollections.Range[uint64]).StartInclusive(fromIndex))
+	if err != nil {
+		return errors.Wrapf(err, "failed to iterate deposits from %d", fromIndex)
+	}
+	// Keys exhausts and closes the iterator.
+	indexes, err := iter.Keys()
+	if err != nil {
+		return errors.Wrapf(err, "failed to list deposits from %d", fromIndex)
+	}
+	for _, idx := range indexes {
+		if err = kv.store.Remove(ctx, idx); err != nil {
+			return errors.Wrapf(err, "failed to roll back deposit %d", idx)
+		}
+		if err = kv.blocks.Remove(ctx, idx); err != nil {
+			return errors.Wrapf(err, "failed to roll back block of deposit %d", idx)
+		}
+	}
+
+	kv.logger.Debug(
+		"Rolled back deposits", "from", fromIndex, "count", len(indexes),
+		"last_indexed_block", lastIndexedBlock,
+	)
+	return nil
+}
+
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 	if start > end {
@@ -156,8 +265,34 @@ func (kv *KVStore) Prune(ctx context.Context, start, end uint64) error {
 		if err := kv.store.Remove(ctx, start+i); err != nil {
 			return errors.Wrapf(err, "failed to prune deposit %d", start+i)
 		}
+		if err := kv.blocks.Remove(ctx, start+i); err != nil {
+			return errors.Wrapf(err, "failed to prune block of deposit %d", start+i)
+		}
 	}
 
 	kv.logger.Debug("Pruned deposits", "start", start, "end", end)
 	return nil
 }
+
+// GetLastIndexedBlock returns the last EL block whose deposits have all been
+// stored. The boolean is false if no block has been indexed yet.
+func (kv *KVStore) GetLastIndexedBlock(ctx context.Context) (uint64, bool, error) {
+	blockNum, err := kv.lastIndexedBlock.Get(ctx)
+	switch {
+	case err == nil:
+		return blockNum, true, nil
+	case errors.Is(err, sdkcollections.ErrNotFound):
+		return 0, false, nil
+	default:
+		return 0, false, errors.Wrap(err, "failed to get last indexed block")
+	}
+}
+
+// SetLastIndexedBlock records that the deposits of all EL blocks up to
+// blockNum have been stored.
+func (kv *KVStore) SetLastIndexedBlock(ctx context.Context, blockNum uint64) error {
+	if err := kv.lastIndexedBlock.Set(ctx, blockNum); err != nil {
+		return errors.Wrapf(err, "failed to set last indexed block %d", blockNum)
+	}
+	return nil
+}
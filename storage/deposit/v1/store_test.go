@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/primitives/constants"
 	"github.com/berachain/beacon-kit/primitives/crypto"
 	"github.com/berachain/beacon-kit/storage/db"
+	depositstorecommon "github.com/berachain/beacon-kit/storage/deposit/common"
 	"github.com/berachain/beacon-kit/storage/deposit/v1"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
@@ -78,3 +79,70 @@ func BenchmarkDepositsInsertion(b *testing.B) {
 		}
 	}
 }
+
+func TestLastIndexedBlock(t *testing.T) {
+	t.Parallel()
+
+	baseDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	store := deposit.NewStore(baseDB, log.NewNopLogger())
+	ctx := context.Background()
+
+	_, found, err := store.GetLastIndexedBlock(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, store.SetLastIndexedBlock(ctx, 42))
+	last, found, err := store.GetLastIndexedBlock(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(42), last)
+
+	// The checkpoint does not interfere with stored deposits.
+	require.NoError(t, store.EnqueueDeposits(ctx, []*types.Deposit{{Index: 0}}))
+	deposits, _, err := store.GetDepositsByIndex(ctx, constants.FirstDepositIndex, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+}
+
+func TestRollbackDeposits(t *testing.T) {
+	t.Parallel()
+
+	baseDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+	store := deposit.NewStore(baseDB, log.NewNopLogger())
+	ctx := context.Background()
+
+	// A genesis deposit has no EL block.
+	require.NoError(t, store.EnqueueDeposits(ctx, []*types.Deposit{{Index: 0}}))
+	_, found, err := store.GetDepositBlock(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	blockA := depositstorecommon.Block{Number: 10, Hash: common.ExecutionHash{0x0a}}
+	blockB := depositstorecommon.Block{Number: 12, Hash: common.ExecutionHash{0x0b}}
+	require.NoError(t, store.EnqueueBlockDeposits(ctx, blockA, []*types.Deposit{{Index: 1}, {Index: 2}}))
+	require.NoError(t, store.EnqueueBlockDeposits(ctx, blockB, []*types.Deposit{{Index: 3}}))
+	require.NoError(t, store.SetLastIndexedBlock(ctx, 20))
+
+	block, found, err := store.GetDepositBlock(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, blockA, block)
+
+	// Rolling back from deposit 2 drops it along with the later deposits and
+	// rewinds the checkpoint.
+	require.NoError(t, store.RollbackDeposits(ctx, 2, 9))
+	deposits, _, err := store.GetDepositsByIndex(ctx, constants.FirstDepositIndex, 10)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+	for _, idx := range []uint64{2, 3} {
+		_, found, err = store.GetDepositBlock(ctx, idx)
+		require.NoError(t, err)
+		require.False(t, found)
+	}
+	last, found, err := store.GetLastIndexedBlock(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(9), last)
+}
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/golang/snappy"
+)
+
+// Era files are e2store files: a sequence of records, each made of a header,
+// holding the type (2), the little endian length (4) and two reserved bytes,
+// followed by the data of the record.
+const (
+	recordHeaderSize = 8
+
+	// slotIndexFixedSize is the size of the data of a slot index besides its
+	// offsets: starting slot (8) and count (8).
+	slotIndexFixedSize = 16
+
+	// offsetSize is the size of an offset of a slot index.
+	offsetSize = 8
+)
+
+// recordType is the type of an e2store record.
+type recordType [2]byte
+
+//nolint:gochecknoglobals // record types.
+var (
+	// typeVersion is the type of the empty record starting every file.
+	typeVersion = recordType{0x65, 0x32}
+	// typeCompressedSignedBeaconBlock is the type of a snappy framed signed
+	// beacon block, prefixed with its fork version.
+	typeCompressedSignedBeaconBlock = recordType{0x01, 0x00}
+	// typeCompressedBeaconState is the type of a snappy framed SSZ
+	// checkpoint of a beacon state.
+	typeCompressedBeaconState = recordType{0x02, 0x00}
+	// typeCompressedBlobSidecars is the type of the snappy framed SSZ list of
+	// the blob sidecars of a slot.
+	typeCompressedBlobSidecars = recordType{0x03, 0x00}
+	// typeHistoricalBatch is the type of the SSZ historical batch of an era.
+	typeHistoricalBatch = recordType{0x04, 0x00}
+	// typeSlotIndex is the type of a slot index: the starting slot (8), the
+	// offset of the record of each slot relative to the start of the index
+	// record, or 0 if the slot has none (8 each), and the number of slots (8).
+	typeSlotIndex = recordType{0x69, 0x32}
+)
+
+// e2Writer writes e2store records, keeping track of their offsets.
+type e2Writer struct {
+	w      *bufio.Writer
+	offset int64
+}
+
+// newE2Writer returns an e2Writer writing to w.
+func newE2Writer(w io.Writer) *e2Writer {
+	return &e2Writer{w: bufio.NewWriter(w)}
+}
+
+// write writes a record and returns its offset.
+func (w *e2Writer) write(typ recordType, data []byte) (int64, error) {
+	header := make([]byte, recordHeaderSize)
+	copy(header, typ[:])
+	//#nosec:G115 // records are far smaller than 4GiB.
+	binary.LittleEndian.PutUint32(header[2:], uint32(len(data)))
+	offset := w.offset
+	if _, err := w.w.Write(header); err != nil {
+		return 0, err
+	}
+	if _, err := w.w.Write(data); err != nil {
+		return 0, err
+	}
+	w.offset += int64(recordHeaderSize + len(data))
+	return offset, nil
+}
+
+// writeCompressed writes a record of snappy framed data and returns its
+// offset.
+func (w *e2Writer) writeCompressed(typ recordType, data []byte) (int64, error) {
+	var buf bytes.Buffer
+	sw := snappy.NewBufferedWriter(&buf)
+	if _, err := sw.Write(data); err != nil {
+		return 0, err
+	}
+	if err := sw.Close(); err != nil {
+		return 0, err
+	}
+	return w.write(typ, buf.Bytes())
+}
+
+// writeSlotIndex writes the slot index of the records at the given offsets,
+// 0 for the slots without a record.
+func (w *e2Writer) writeSlotIndex(start math.Slot, offsets []int64) error {
+	data := make([]byte, 0, slotIndexFixedSize+offsetSize*len(offsets))
+	data = binary.LittleEndian.AppendUint64(data, start.Unwrap())
+	for _, offset := range offsets {
+		if offset != 0 {
+			offset -= w.offset
+		}
+		//#nosec:G115 // two's complement of a negative offset.
+		data = binary.LittleEndian.AppendUint64(data, uint64(offset))
+	}
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(offsets)))
+	_, err := w.write(typeSlotIndex, data)
+	return err
+}
+
+// flush flushes the records written so far.
+func (w *e2Writer) flush() error {
+	return w.w.Flush()
+}
+
+// e2Reader reads e2store records.
+type e2Reader struct {
+	r    io.ReaderAt
+	size int64
+}
+
+// read reads the data of the record of the given type at offset.
+func (r *e2Reader) read(offset int64, typ recordType) ([]byte, error) {
+	if offset < 0 || offset > r.size-recordHeaderSize {
+		return nil, fmt.Errorf("%w: offset %d out of bounds", ErrMalformedRecord, offset)
+	}
+	header := make([]byte, recordHeaderSize)
+	if _, err := r.r.ReadAt(header, offset); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:2], typ[:]) {
+		return nil, fmt.Errorf(
+			"%w: expected type %x at offset %d, got %x", ErrMalformedRecord, typ, offset, header[:2],
+		)
+	}
+	length := int64(binary.LittleEndian.Uint32(header[2:]))
+	if length > r.size-offset-recordHeaderSize {
+		return nil, fmt.Errorf("%w: record at offset %d is truncated", ErrMalformedRecord, offset)
+	}
+	data := make([]byte, length)
+	if _, err := r.r.ReadAt(data, offset+recordHeaderSize); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// readCompressed reads and decompresses the data of the snappy framed record
+// of the given type at offset.
+func (r *e2Reader) readCompressed(offset int64, typ recordType) ([]byte, error) {
+	data, err := r.read(offset, typ)
+	if err != nil {
+		return nil, err
+	}
+	data, err = io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: record at offset %d: %w", ErrMalformedRecord, offset, err)
+	}
+	return data, nil
+}
+
+// readSlotIndex reads the slot index ending at end. It returns the offset of
+// the index, its starting slot and the absolute offsets of its records, 0 for
+// the slots without a record.
+func (r *e2Reader) readSlotIndex(end int64) (int64, math.Slot, []int64, error) {
+	if end < recordHeaderSize+slotIndexFixedSize || end > r.size {
+		return 0, 0, nil, fmt.Errorf("%w: index ending at %d out of bounds", ErrMalformedIndex, end)
+	}
+	countBz := make([]byte, offsetSize)
+	if _, err := r.r.ReadAt(countBz, end-offsetSize); err != nil {
+		return 0, 0, nil, err
+	}
+	count := binary.LittleEndian.Uint64(countBz)
+	//#nosec:G115 // bounded by the size of the file below.
+	if count > uint64(end)/offsetSize {
+		return 0, 0, nil, fmt.Errorf("%w: invalid count %d", ErrMalformedIndex, count)
+	}
+	//#nosec:G115 // bounded above.
+	begin := end - recordHeaderSize - slotIndexFixedSize - int64(count)*offsetSize
+	data, err := r.read(begin, typeSlotIndex)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if int64(len(data)) != end-begin-recordHeaderSize {
+		return 0, 0, nil, fmt.Errorf("%w: index at offset %d has invalid length", ErrMalformedIndex, begin)
+	}
+
+	start := math.Slot(binary.LittleEndian.Uint64(data))
+	offsets := make([]int64, count)
+	for i := range offsets {
+		//#nosec:G115 // two's complement of a negative offset.
+		offset := int64(binary.LittleEndian.Uint64(data[offsetSize+i*offsetSize:]))
+		if offset != 0 {
+			offset += begin
+		}
+		offsets[i] = offset
+	}
+	return begin, start, offsets, nil
+}
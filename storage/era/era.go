@@ -0,0 +1,430 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/encoding/ssz"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/checkpoint"
+)
+
+// An era file holds the history of the SlotsPerHistoricalRoot slots of an
+// era, era n spanning the slots [n*SlotsPerHistoricalRoot,
+// (n+1)*SlotsPerHistoricalRoot). It is modelled on the era files of Ethereum
+// and laid out as the e2store records:
+//
+//	Version | HistoricalBatch | CompressedSignedBeaconBlock* |
+//	CompressedBlobSidecars* | CompressedBeaconState? |
+//	SlotIndex (blocks) | SlotIndex (blob sidecars) | SlotIndex (state)
+//
+// The block and blob sidecar indexes have an entry per slot of the era. The
+// state index has a single entry, for the last slot of the era, which is 0 if
+// the file holds no state. Indexes are read from the end of the file.
+const (
+	// Extension is the file extension of era files.
+	Extension = ".era"
+
+	// versionSize is the size of the fork version prefixed to each block.
+	versionSize = 4
+)
+
+// Era is the history of the slots of an era.
+type Era struct {
+	// Number is the number of the era.
+	Number uint64
+	// Batch is the historical batch of the era, which has an entry for each
+	// of its slots.
+	Batch *HistoricalBatch
+	// Blocks are the signed blocks of the era, in slot order. Every slot has
+	// a block, except for the genesis slot.
+	Blocks []*ctypes.SignedBeaconBlock
+	// Sidecars are the blob sidecars of the blocks of the era, by slot. They
+	// may be missing for blocks whose sidecars have been pruned.
+	Sidecars map[math.Slot]datypes.BlobSidecars
+	// State is the checkpoint of the state after the last slot of the era, if
+	// the era holds a state snapshot.
+	State *checkpoint.Checkpoint
+}
+
+// SlotsPerHistoricalRoot returns the number of slots of the era.
+func (e *Era) SlotsPerHistoricalRoot() uint64 {
+	return uint64(len(e.Batch.BlockRoots))
+}
+
+// StartSlot returns the first slot of the era.
+func (e *Era) StartSlot() math.Slot {
+	return math.Slot(e.Number * e.SlotsPerHistoricalRoot())
+}
+
+// LastSlot returns the last slot of the era.
+func (e *Era) LastSlot() math.Slot {
+	return e.StartSlot() + math.Slot(e.SlotsPerHistoricalRoot()) - 1
+}
+
+// HistoricalRoot returns the root of the historical batch of the era.
+func (e *Era) HistoricalRoot() common.Root {
+	return e.Batch.HashTreeRoot()
+}
+
+// FileName returns the name of the file of the era, made of the network, the
+// number of the era and the first bytes of its historical root.
+func (e *Era) FileName(network string) string {
+	root := e.HistoricalRoot()
+	return fmt.Sprintf("%s-%05d-%x%s", network, e.Number, root[:4], Extension)
+}
+
+// Verify checks that the blocks, blob sidecars and state of the era match
+// the block and state roots of its historical batch.
+func (e *Era) Verify() error {
+	period := e.SlotsPerHistoricalRoot()
+	if period == 0 || uint64(len(e.Batch.StateRoots)) != period {
+		return fmt.Errorf(
+			"%w: historical batch has %d block roots and %d state roots",
+			ErrMalformedRecord, len(e.Batch.BlockRoots), len(e.Batch.StateRoots),
+		)
+	}
+
+	start, last := e.StartSlot(), e.LastSlot()
+	blocks := make(map[math.Slot]*ctypes.BeaconBlock, len(e.Blocks))
+	for _, blk := range e.Blocks {
+		slot := blk.GetBeaconBlock().GetSlot()
+		if slot < start || slot > last || blocks[slot] != nil {
+			return fmt.Errorf("%w: unexpected block at slot %d", ErrMalformedIndex, slot)
+		}
+		blocks[slot] = blk.GetBeaconBlock()
+	}
+	for i := range period {
+		slot := start + math.Slot(i)
+		blk, ok := blocks[slot]
+		if !ok {
+			if slot == 0 {
+				continue
+			}
+			return fmt.Errorf("%w: slot %d", ErrMissingBlock, slot)
+		}
+		if root := blk.HashTreeRoot(); root != e.Batch.BlockRoots[i] {
+			return fmt.Errorf(
+				"%w: block at slot %d has root %s, expected %s",
+				ErrBlockRootMismatch, slot, root, e.Batch.BlockRoots[i],
+			)
+		}
+		if i > 0 && blk.GetParentBlockRoot() != e.Batch.BlockRoots[i-1] {
+			return fmt.Errorf(
+				"%w: block at slot %d has parent %s, expected %s",
+				ErrBlockRootMismatch, slot, blk.GetParentBlockRoot(), e.Batch.BlockRoots[i-1],
+			)
+		}
+		if blk.GetStateRoot() != e.Batch.StateRoots[i] {
+			return fmt.Errorf(
+				"%w: block at slot %d has state root %s, expected %s",
+				ErrStateRootMismatch, slot, blk.GetStateRoot(), e.Batch.StateRoots[i],
+			)
+		}
+	}
+
+	for slot, sidecars := range e.Sidecars {
+		if blocks[slot] == nil {
+			return fmt.Errorf("%w: no block at slot %d", ErrInvalidSidecar, slot)
+		}
+		blockRoot := e.Batch.BlockRoots[slot-start]
+		for _, sidecar := range sidecars {
+			if sidecar.GetBeaconBlockHeader().HashTreeRoot() != blockRoot ||
+				!sidecar.HasValidInclusionProof() {
+				return fmt.Errorf(
+					"%w: sidecar %d of slot %d", ErrInvalidSidecar, sidecar.GetIndex(), slot,
+				)
+			}
+		}
+	}
+
+	if e.State != nil {
+		return e.verifyState()
+	}
+	return nil
+}
+
+// verifyState checks that the state of the era is the state after its last
+// slot, whose block and state roots are those of the historical batch.
+func (e *Era) verifyState() error {
+	cp := e.State
+	if err := cp.Verify(); err != nil {
+		return err
+	}
+	if cp.State.Slot != e.LastSlot() {
+		return fmt.Errorf(
+			"%w: state is at slot %d, expected %d", ErrMalformedIndex, cp.State.Slot, e.LastSlot(),
+		)
+	}
+
+	// The roots of the last slot are only set in the state by the next slot.
+	lastIdx := e.SlotsPerHistoricalRoot() - 1
+	if cp.BlockRoot() != e.Batch.BlockRoots[lastIdx] {
+		return fmt.Errorf(
+			"%w: state has latest block root %s, expected %s",
+			ErrBlockRootMismatch, cp.BlockRoot(), e.Batch.BlockRoots[lastIdx],
+		)
+	}
+	if cp.BlockHeader.GetStateRoot() != e.Batch.StateRoots[lastIdx] {
+		return fmt.Errorf(
+			"%w: state has root %s, expected %s",
+			ErrStateRootMismatch, cp.BlockHeader.GetStateRoot(), e.Batch.StateRoots[lastIdx],
+		)
+	}
+	st := cp.State
+	if uint64(len(st.BlockRoots)) != e.SlotsPerHistoricalRoot() ||
+		!slices.Equal(st.BlockRoots[:lastIdx], e.Batch.BlockRoots[:lastIdx]) {
+		return fmt.Errorf("%w: state block roots differ from the era", ErrBlockRootMismatch)
+	}
+	if uint64(len(st.StateRoots)) != e.SlotsPerHistoricalRoot() ||
+		!slices.Equal(st.StateRoots[:lastIdx], e.Batch.StateRoots[:lastIdx]) {
+		return fmt.Errorf("%w: state state roots differ from the era", ErrStateRootMismatch)
+	}
+	return nil
+}
+
+// VerifyParent checks that the era directly follows parent.
+func (e *Era) VerifyParent(parent *Era) error {
+	if e.Number != parent.Number+1 ||
+		e.SlotsPerHistoricalRoot() != parent.SlotsPerHistoricalRoot() {
+		return fmt.Errorf("%w: era %d does not follow era %d", ErrNotContiguous, e.Number, parent.Number)
+	}
+	parentRoot := parent.lastBlockRoot()
+	if len(e.Blocks) > 0 && e.Blocks[0].GetBeaconBlock().GetParentBlockRoot() != parentRoot {
+		return fmt.Errorf(
+			"%w: era %d does not build on block %s of era %d",
+			ErrNotContiguous, e.Number, parentRoot, parent.Number,
+		)
+	}
+	return nil
+}
+
+// WriteFile writes the era file of the era to path. The file is written to a
+// temporary file which is renamed to path once complete.
+func (e *Era) WriteFile(path string) error {
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create era file: %w", err)
+	}
+	err = e.write(newE2Writer(f))
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+	}
+	return err
+}
+
+// write writes the records of the era.
+func (e *Era) write(w *e2Writer) error {
+	if _, err := w.write(typeVersion, nil); err != nil {
+		return err
+	}
+	bz, err := e.Batch.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	if _, err = w.write(typeHistoricalBatch, bz); err != nil {
+		return err
+	}
+
+	start := e.StartSlot()
+	blockOffsets := make([]int64, e.SlotsPerHistoricalRoot())
+	for _, blk := range e.Blocks {
+		if bz, err = blk.MarshalSSZ(); err != nil {
+			return err
+		}
+		forkVersion := blk.GetForkVersion()
+		blockOffsets[blk.GetBeaconBlock().GetSlot()-start], err = w.writeCompressed(
+			typeCompressedSignedBeaconBlock, append(forkVersion[:], bz...),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	sidecarOffsets := make([]int64, e.SlotsPerHistoricalRoot())
+	for i := range sidecarOffsets {
+		sidecars, ok := e.Sidecars[start+math.Slot(i)]
+		if !ok {
+			continue
+		}
+		if bz, err = sidecars.MarshalSSZ(); err != nil {
+			return err
+		}
+		if sidecarOffsets[i], err = w.writeCompressed(typeCompressedBlobSidecars, bz); err != nil {
+			return err
+		}
+	}
+
+	stateOffsets := make([]int64, 1)
+	if e.State != nil {
+		if bz, err = e.State.MarshalSSZ(); err != nil {
+			return err
+		}
+		if stateOffsets[0], err = w.writeCompressed(typeCompressedBeaconState, bz); err != nil {
+			return err
+		}
+	}
+
+	if err = w.writeSlotIndex(start, blockOffsets); err != nil {
+		return err
+	}
+	if err = w.writeSlotIndex(start, sidecarOffsets); err != nil {
+		return err
+	}
+	if err = w.writeSlotIndex(e.LastSlot(), stateOffsets); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+// ReadFile reads the era file at path and verifies it.
+func ReadFile(path string) (*Era, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	e, err := read(&e2Reader{r: f, size: info.Size()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read era file %s: %w", path, err)
+	}
+	if err = e.Verify(); err != nil {
+		return nil, fmt.Errorf("invalid era file %s: %w", path, err)
+	}
+	return e, nil
+}
+
+// read reads the records of an era.
+func read(r *e2Reader) (*Era, error) {
+	if _, err := r.read(0, typeVersion); err != nil {
+		return nil, err
+	}
+	bz, err := r.read(recordHeaderSize, typeHistoricalBatch)
+	if err != nil {
+		return nil, err
+	}
+	e := &Era{Batch: new(HistoricalBatch), Sidecars: make(map[math.Slot]datypes.BlobSidecars)}
+	if err = e.Batch.UnmarshalSSZ(bz); err != nil {
+		return nil, fmt.Errorf("%w: historical batch: %w", ErrMalformedRecord, err)
+	}
+	period := e.SlotsPerHistoricalRoot()
+	if period == 0 {
+		return nil, fmt.Errorf("%w: empty historical batch", ErrMalformedRecord)
+	}
+
+	stateBegin, stateSlot, stateOffsets, err := r.readSlotIndex(r.size)
+	if err != nil {
+		return nil, err
+	}
+	sidecarBegin, sidecarStart, sidecarOffsets, err := r.readSlotIndex(stateBegin)
+	if err != nil {
+		return nil, err
+	}
+	_, start, blockOffsets, err := r.readSlotIndex(sidecarBegin)
+	if err != nil {
+		return nil, err
+	}
+	e.Number = start.Unwrap() / period
+	if start.Unwrap()%period != 0 || uint64(len(blockOffsets)) != period ||
+		sidecarStart != start || uint64(len(sidecarOffsets)) != period ||
+		stateSlot != e.LastSlot() || len(stateOffsets) != 1 {
+		return nil, fmt.Errorf("%w: indexes do not match an era of %d slots", ErrMalformedIndex, period)
+	}
+
+	for i, offset := range blockOffsets {
+		if offset == 0 {
+			continue
+		}
+		var blk *ctypes.SignedBeaconBlock
+		if blk, err = readBlock(r, offset); err != nil {
+			return nil, err
+		}
+		if slot := start + math.Slot(i); blk.GetBeaconBlock().GetSlot() != slot {
+			return nil, fmt.Errorf(
+				"%w: block of slot %d indexed at slot %d",
+				ErrMalformedIndex, blk.GetBeaconBlock().GetSlot(), slot,
+			)
+		}
+		e.Blocks = append(e.Blocks, blk)
+	}
+	for i, offset := range sidecarOffsets {
+		if offset == 0 {
+			continue
+		}
+		if bz, err = r.readCompressed(offset, typeCompressedBlobSidecars); err != nil {
+			return nil, err
+		}
+		var sidecars datypes.BlobSidecars
+		if err = ssz.Unmarshal(bz, &sidecars); err != nil {
+			return nil, fmt.Errorf("%w: blob sidecars: %w", ErrMalformedRecord, err)
+		}
+		e.Sidecars[start+math.Slot(i)] = sidecars
+	}
+	if stateOffsets[0] != 0 {
+		if bz, err = r.readCompressed(stateOffsets[0], typeCompressedBeaconState); err != nil {
+			return nil, err
+		}
+		e.State = new(checkpoint.Checkpoint)
+		if err = e.State.UnmarshalSSZ(bz); err != nil {
+			return nil, fmt.Errorf("%w: beacon state: %w", ErrMalformedRecord, err)
+		}
+	}
+	return e, nil
+}
+
+// readBlock reads the signed block recorded at offset.
+func readBlock(r *e2Reader, offset int64) (*ctypes.SignedBeaconBlock, error) {
+	bz, err := r.readCompressed(offset, typeCompressedSignedBeaconBlock)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) < versionSize {
+		return nil, fmt.Errorf("%w: block at offset %d is truncated", ErrMalformedRecord, offset)
+	}
+	blk, err := ctypes.NewEmptySignedBeaconBlockWithVersion(common.Version(bz[:versionSize]))
+	if err != nil {
+		return nil, err
+	}
+	if err = ssz.Unmarshal(bz[versionSize:], blk); err != nil {
+		return nil, fmt.Errorf("%w: block at offset %d: %w", ErrMalformedRecord, offset, err)
+	}
+	return blk, nil
+}
@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build test
+// +build test
+
+package era_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/config/spec"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/da/blob"
+	datypes "github.com/berachain/beacon-kit/da/types"
+	engineprimitives "github.com/berachain/beacon-kit/engine-primitives/engine-primitives"
+	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/node-core/components/signer"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/eip4844"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
+	"github.com/berachain/beacon-kit/storage/checkpoint"
+	"github.com/berachain/beacon-kit/storage/era"
+	statetransition "github.com/berachain/beacon-kit/testing/state-transition"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/stretchr/testify/require"
+)
+
+// blobSlot is the slot of the block of the test era which has a blob.
+const blobSlot = 10
+
+// testEra returns era 1 of a devnet chain, whose last block is the latest
+// block of the state snapshot of the era.
+func testEra(t *testing.T) *era.Era {
+	t.Helper()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	sp, st, _, _, _, _ := statetransition.SetupTestState(t, cs)
+	deposits := ctypes.Deposits{{
+		Pubkey: [48]byte{0x01},
+		Amount: cs.MaxEffectiveBalance(),
+		Credentials: ctypes.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		),
+	}}
+	header := &ctypes.ExecutionPayloadHeader{
+		Versionable: ctypes.NewVersionable(cs.GenesisForkVersion()),
+	}
+	_, err = sp.InitializeBeaconStateFromEth1(st, deposits, header, cs.GenesisForkVersion())
+	require.NoError(t, err)
+	bs, err := st.GetMarshallable()
+	require.NoError(t, err)
+
+	var (
+		period = cs.SlotsPerHistoricalRoot()
+		e      = &era.Era{
+			Number: 1,
+			Batch: &era.HistoricalBatch{
+				BlockRoots: make([]common.Root, period),
+				StateRoots: make([]common.Root, period),
+			},
+			Sidecars: make(map[math.Slot]datypes.BlobSidecars),
+		}
+		parent = common.Root{0xaa}
+	)
+	for i := range period {
+		slot := e.StartSlot() + math.Slot(i)
+		blk, blkErr := ctypes.NewBeaconBlockWithVersion(slot, 0, parent, version.Deneb1())
+		require.NoError(t, blkErr)
+		blk.Body.ExecutionPayload.Timestamp = slot
+		if slot == blobSlot {
+			blk.Body.BlobKzgCommitments = []eip4844.KZGCommitment{{0x01}}
+		}
+
+		if i < period-1 {
+			blk.StateRoot = common.Root{byte(slot)}
+		} else {
+			// The state snapshot is the state after the last block.
+			bs.Slot = slot
+			bs.BlockRoots = append(e.Batch.BlockRoots[:i:i], common.Root{0xbb})
+			bs.StateRoots = append(e.Batch.StateRoots[:i:i], common.Root{0xbb})
+			bs.LatestBlockHeader = &ctypes.BeaconBlockHeader{
+				Slot:            slot,
+				ParentBlockRoot: parent,
+				BodyRoot:        blk.Body.HashTreeRoot(),
+			}
+			blk.StateRoot = bs.HashTreeRoot()
+			e.State = checkpoint.New(slot.Unwrap(), common.Root{}, bs)
+		}
+
+		signed := &ctypes.SignedBeaconBlock{BeaconBlock: blk}
+		e.Blocks = append(e.Blocks, signed)
+		e.Batch.BlockRoots[i] = blk.HashTreeRoot()
+		e.Batch.StateRoots[i] = blk.StateRoot
+		parent = e.Batch.BlockRoots[i]
+
+		if slot == blobSlot {
+			sidecars, scErr := blob.NewSidecarFactory(metrics.NewNoOpTelemetrySink()).BuildSidecars(
+				signed, &engineprimitives.BlobsBundleV1{
+					Commitments: blk.Body.BlobKzgCommitments,
+					Proofs:      []eip4844.KZGProof{{}},
+					Blobs:       []*eip4844.Blob{{}},
+				},
+			)
+			require.NoError(t, scErr)
+			e.Sidecars[slot] = sidecars
+		}
+	}
+	require.NoError(t, e.Verify())
+	return e
+}
+
+func TestEraRoundTrip(t *testing.T) {
+	t.Parallel()
+	e := testEra(t)
+	root := e.HistoricalRoot()
+	name := e.FileName("80087")
+	require.Regexp(t, `^80087-00001-[0-9a-f]{8}\.era$`, name)
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, e.WriteFile(path))
+	got, err := era.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), got.Number)
+	require.Equal(t, root, got.HistoricalRoot())
+	require.Len(t, got.Blocks, len(e.Blocks))
+	for i, blk := range e.Blocks {
+		require.Equal(t, blk.HashTreeRoot(), got.Blocks[i].HashTreeRoot())
+	}
+	require.Len(t, got.Sidecars, 1)
+	require.Equal(t, e.Sidecars[blobSlot], got.Sidecars[blobSlot])
+	require.Equal(t, e.State.State.HashTreeRoot(), got.State.State.HashTreeRoot())
+
+	// Eras without state snapshot have an empty state index.
+	e.State = nil
+	require.NoError(t, e.WriteFile(path))
+	got, err = era.ReadFile(path)
+	require.NoError(t, err)
+	require.Nil(t, got.State)
+	require.Equal(t, root, got.HistoricalRoot())
+
+	// Corrupt files are rejected.
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)-1], 0600))
+	_, err = era.ReadFile(path)
+	require.Error(t, err)
+}
+
+func TestEraVerify(t *testing.T) {
+	t.Parallel()
+
+	e := testEra(t)
+	e.Batch.StateRoots[2] = common.Root{0xff}
+	require.ErrorIs(t, e.Verify(), era.ErrStateRootMismatch)
+
+	e = testEra(t)
+	e.Blocks = append(e.Blocks[:3], e.Blocks[4:]...)
+	require.ErrorIs(t, e.Verify(), era.ErrMissingBlock)
+
+	e = testEra(t)
+	e.Blocks[3].GetBeaconBlock().SetParentBlockRoot(common.Root{0xff})
+	require.ErrorIs(t, e.Verify(), era.ErrBlockRootMismatch)
+
+	e = testEra(t)
+	e.Sidecars[blobSlot+1] = e.Sidecars[blobSlot]
+	require.ErrorIs(t, e.Verify(), era.ErrInvalidSidecar)
+
+	// A state other than the one after the last block of the era.
+	e = testEra(t)
+	e.State.State.Balances[0]++
+	e.State = checkpoint.New(e.State.Height, common.Root{}, e.State.State)
+	require.ErrorIs(t, e.Verify(), era.ErrBlockRootMismatch)
+}
+
+func TestEraVerifyParent(t *testing.T) {
+	t.Parallel()
+	parent := testEra(t)
+
+	child := &era.Era{Number: 2, Batch: parent.Batch}
+	blk, err := ctypes.NewBeaconBlockWithVersion(
+		child.StartSlot(), 0, parent.Batch.BlockRoots[len(parent.Batch.BlockRoots)-1], version.Deneb1(),
+	)
+	require.NoError(t, err)
+	child.Blocks = []*ctypes.SignedBeaconBlock{{BeaconBlock: blk}}
+	require.NoError(t, child.VerifyParent(parent))
+
+	blk.SetParentBlockRoot(common.Root{0xff})
+	require.ErrorIs(t, child.VerifyParent(parent), era.ErrNotContiguous)
+
+	child.Number = 3
+	require.ErrorIs(t, child.VerifyParent(parent), era.ErrNotContiguous)
+}
+
+func TestEraVerifyAnchor(t *testing.T) {
+	t.Parallel()
+	e := testEra(t)
+	last := e.Batch.BlockRoots[len(e.Batch.BlockRoots)-1]
+	require.NoError(t, e.VerifyAnchor(last))
+	require.ErrorIs(t, e.VerifyAnchor(common.Root{0xff}), era.ErrUntrusted)
+
+	// A later state holds the root of the last block of the era while it is
+	// within its block roots.
+	st := *e.State.State
+	st.BlockRoots = make([]common.Root, len(e.Batch.BlockRoots))
+	st.BlockRoots[e.LastSlot().Unwrap()%e.SlotsPerHistoricalRoot()] = last
+	for _, slot := range []math.Slot{e.LastSlot() + 1, e.LastSlot() + math.Slot(e.SlotsPerHistoricalRoot())} {
+		st.Slot = slot
+		require.NoError(t, e.VerifyAnchorState(&st))
+	}
+	for _, slot := range []math.Slot{e.LastSlot(), e.LastSlot() + math.Slot(e.SlotsPerHistoricalRoot()) + 1} {
+		st.Slot = slot
+		require.ErrorIs(t, e.VerifyAnchorState(&st), era.ErrUntrusted)
+	}
+	st.Slot = e.LastSlot() + 1
+	st.BlockRoots[e.LastSlot().Unwrap()%e.SlotsPerHistoricalRoot()] = common.Root{0xff}
+	require.ErrorIs(t, e.VerifyAnchorState(&st), era.ErrUntrusted)
+}
+
+func TestEraVerifySignatures(t *testing.T) {
+	t.Parallel()
+	cs, err := spec.DevnetChainSpec()
+	require.NoError(t, err)
+	e := testEra(t)
+	proposer := newSigner(t)
+
+	// The blocks of the test era are all proposed by validator 0.
+	st := *e.State.State
+	st.Validators = []*ctypes.Validator{{Pubkey: proposer.PublicKey()}}
+	for _, signed := range e.Blocks {
+		blk := signed.GetBeaconBlock()
+		fd := ctypes.NewForkData(cs.ActiveForkVersionForTimestamp(blk.GetTimestamp()), st.GenesisValidatorsRoot)
+		signingRoot := ctypes.ComputeSigningRoot(blk, fd.ComputeDomain(cs.DomainTypeProposer()))
+		signed.Signature, err = proposer.Sign(signingRoot[:])
+		require.NoError(t, err)
+	}
+	require.NoError(t, e.VerifySignatures(cs, &st, signer.BLSSigner{}))
+
+	// Signatures of another key are rejected.
+	st.Validators = []*ctypes.Validator{{Pubkey: newSigner(t).PublicKey()}}
+	require.ErrorIs(t, e.VerifySignatures(cs, &st, signer.BLSSigner{}), era.ErrInvalidSignature)
+
+	// So are blocks of proposers the state does not know.
+	st.Validators = nil
+	require.ErrorIs(t, e.VerifySignatures(cs, &st, signer.BLSSigner{}), era.ErrInvalidSignature)
+}
+
+func newSigner(t *testing.T) *signer.BLSSigner {
+	t.Helper()
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	keyFilePath := filepath.Join(dir, "priv_validator_key.json")
+	stateFilePath := filepath.Join(dir, "priv_validator_state.json")
+	privval.NewFilePV(privKey, keyFilePath, stateFilePath).Save()
+	return signer.NewBLSSigner(keyFilePath, stateFilePath)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import "github.com/berachain/beacon-kit/errors"
+
+var (
+	// ErrMalformedRecord is returned when an era file holds a record which
+	// cannot be read.
+	ErrMalformedRecord = errors.New("malformed era record")
+
+	// ErrMalformedIndex is returned when a slot index of an era file does
+	// not match the era.
+	ErrMalformedIndex = errors.New("malformed era slot index")
+
+	// ErrBlockRootMismatch is returned when a block of an era does not match
+	// the block roots of its historical batch.
+	ErrBlockRootMismatch = errors.New("era block root mismatch")
+
+	// ErrStateRootMismatch is returned when a block or the state of an era
+	// does not match the state roots of its historical batch.
+	ErrStateRootMismatch = errors.New("era state root mismatch")
+
+	// ErrMissingBlock is returned when an era lacks the block of one of its
+	// slots.
+	ErrMissingBlock = errors.New("era block missing")
+
+	// ErrInvalidSidecar is returned when a blob sidecar of an era does not
+	// belong to the block of its slot.
+	ErrInvalidSidecar = errors.New("era blob sidecar invalid")
+
+	// ErrNotContiguous is returned when consecutive eras do not chain.
+	ErrNotContiguous = errors.New("eras not contiguous")
+
+	// ErrUntrusted is returned when an era does not match the trusted root it
+	// is anchored to.
+	ErrUntrusted = errors.New("era not trusted")
+
+	// ErrInvalidSignature is returned when a block of an era does not carry
+	// a valid signature of its proposer.
+	ErrInvalidSignature = errors.New("era block signature invalid")
+)
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/karalabe/ssz"
+)
+
+// maxSlotsPerHistoricalRoot is the maximum number of block and state roots
+// of a historical batch, matching the limit of the beacon state.
+const maxSlotsPerHistoricalRoot = 8192
+
+// HistoricalBatch is the block and state roots of the slots of an era, in
+// slot order. Beacon states do not accumulate historical roots, so the root
+// of the batch, the historical root of the era, identifies the era instead.
+type HistoricalBatch struct {
+	// BlockRoots are the roots of the blocks of the slots of the era.
+	BlockRoots []common.Root
+	// StateRoots are the roots of the states after the blocks of the slots
+	// of the era.
+	StateRoots []common.Root
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the HistoricalBatch.
+func (b *HistoricalBatch) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	size := uint32(8) //nolint:mnd // two offsets.
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticBytes(siz, b.BlockRoots)
+	size += ssz.SizeSliceOfStaticBytes(siz, b.StateRoots)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the HistoricalBatch.
+func (b *HistoricalBatch) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlockRoots, maxSlotsPerHistoricalRoot)
+	ssz.DefineSliceOfStaticBytesOffset(codec, &b.StateRoots, maxSlotsPerHistoricalRoot)
+
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlockRoots, maxSlotsPerHistoricalRoot)
+	ssz.DefineSliceOfStaticBytesContent(codec, &b.StateRoots, maxSlotsPerHistoricalRoot)
+}
+
+// MarshalSSZ marshals the HistoricalBatch into SSZ format.
+func (b *HistoricalBatch) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(b))
+	return buf, ssz.EncodeToBytes(buf, b)
+}
+
+// UnmarshalSSZ unmarshals the HistoricalBatch from SSZ format.
+func (b *HistoricalBatch) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, b)
+}
+
+// HashTreeRoot returns the historical root of the era of the batch.
+func (b *HistoricalBatch) HashTreeRoot() common.Root {
+	return ssz.HashSequential(b)
+}
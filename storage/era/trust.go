@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package era
+
+import (
+	"fmt"
+
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// ChainSpec is the chain spec the proposer signatures of eras are verified
+// with.
+type ChainSpec interface {
+	// ActiveForkVersionForTimestamp returns the fork version active at the
+	// given timestamp.
+	ActiveForkVersionForTimestamp(timestamp math.U64) common.Version
+	// DomainTypeProposer returns the domain type of block proposals.
+	DomainTypeProposer() common.DomainType
+}
+
+// SignatureVerifier verifies BLS signatures.
+type SignatureVerifier interface {
+	// VerifySignature verifies a signature against a message and a public
+	// key.
+	VerifySignature(pubKey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature) error
+}
+
+// lastBlockRoot returns the root of the block of the last slot of the era.
+func (e *Era) lastBlockRoot() common.Root {
+	return e.Batch.BlockRoots[e.SlotsPerHistoricalRoot()-1]
+}
+
+// VerifyAnchor checks that the block of the last slot of the era has the
+// trusted root. Since blocks commit to their parents, a verified era is then
+// trusted as a whole, and so are the eras it follows.
+func (e *Era) VerifyAnchor(root common.Root) error {
+	if last := e.lastBlockRoot(); last != root {
+		return fmt.Errorf(
+			"%w: era %d ends with block %s, expected %s", ErrUntrusted, e.Number, last, root,
+		)
+	}
+	return nil
+}
+
+// VerifyAnchorState checks that the block of the last slot of the era is in
+// the block roots of the trusted state st, which holds the roots of the
+// SlotsPerHistoricalRoot slots before its own.
+func (e *Era) VerifyAnchorState(st *ctypes.BeaconState) error {
+	period := e.SlotsPerHistoricalRoot()
+	last := e.LastSlot()
+	if uint64(len(st.BlockRoots)) != period || st.Slot <= last || st.Slot > last+math.Slot(period) {
+		return fmt.Errorf(
+			"%w: state at slot %d does not hold the root of slot %d", ErrUntrusted, st.Slot, last,
+		)
+	}
+	return e.VerifyAnchor(st.BlockRoots[last.Unwrap()%period])
+}
+
+// VerifySignatures checks the proposer signatures of the blocks of the era,
+// with the validators of the trusted state st. Since the validator registry is
+// only ever appended to, st may be any state later than the blocks.
+func (e *Era) VerifySignatures(cs ChainSpec, st *ctypes.BeaconState, verifier SignatureVerifier) error {
+	for _, signed := range e.Blocks {
+		blk := signed.GetBeaconBlock()
+		idx := blk.GetProposerIndex()
+		if idx.Unwrap() >= uint64(len(st.Validators)) {
+			return fmt.Errorf(
+				"%w: block at slot %d has unknown proposer %d", ErrInvalidSignature, blk.GetSlot(), idx,
+			)
+		}
+		fd := ctypes.NewForkData(cs.ActiveForkVersionForTimestamp(blk.GetTimestamp()), st.GenesisValidatorsRoot)
+		signingRoot := ctypes.ComputeSigningRoot(blk, fd.ComputeDomain(cs.DomainTypeProposer()))
+		err := verifier.VerifySignature(st.Validators[idx].GetPubkey(), signingRoot[:], signed.GetSignature())
+		if err != nil {
+			return fmt.Errorf("%w: block at slot %d: %w", ErrInvalidSignature, blk.GetSlot(), err)
+		}
+	}
+	return nil
+}
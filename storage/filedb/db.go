@@ -21,6 +21,9 @@
 package filedb
 
 import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
 	"os"
 	"path/filepath"
 
@@ -29,9 +32,38 @@ import (
 	"github.com/spf13/afero"
 )
 
+const (
+	// tmpExtension is appended to the path of a value while it is written,
+	// until it is renamed to the path of its key.
+	tmpExtension = ".tmp"
+
+	// quarantineDir is the directory, relative to the root directory, corrupt
+	// files are moved to.
+	quarantineDir = "quarantine"
+
+	// headerSize is the size of the header prepended to each stored value,
+	// made of valueMagic and the CRC-32C checksum of the value.
+	headerSize = 8
+)
+
+//nolint:gochecknoglobals // read-only.
+var (
+	// valueMagic marks values stored with a checksum. Values stored before
+	// checksums were introduced do not start with it and are not verified.
+	valueMagic = []byte{0xbe, 0xac, 0xf1, 0x1e}
+
+	// crcTable is the Castagnoli table of value checksums.
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
+//
+// Values are written atomically and durably: they are synced to a temporary
+// file which is then renamed to the path of their key, so a crash never leaves
+// a partially written value behind. Each value is stored with a checksum which
+// is verified when it is read.
 type DB struct {
 	fs        afero.Fs
 	logger    log.Logger
@@ -53,9 +85,10 @@ func NewDB(opts ...Option) *DB {
 	return db
 }
 
-// Get retrieves the value for a key.
+// Get retrieves the value for a key. It returns ErrCorruptValue if the value
+// does not match its checksum.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	return db.readFile(db.pathForKey(key))
 }
 
 // Has returns true if the key exists in the database.
@@ -69,29 +102,24 @@ func (db *DB) Has(key []byte) (bool, error) {
 
 // Set stores the value for a key.
 func (db *DB) Set(key []byte, value []byte) error {
-	if exists, err := afero.Exists(db.fs, db.pathForKey(key)); err != nil {
+	path := db.pathForKey(key)
+	if exists, err := afero.Exists(db.fs, path); err != nil {
 		return err
 	} else if exists {
+		// Rewriting the same value is a no-op. A corrupt value is replaced.
+		if stored, getErr := db.readFile(path); getErr == nil && bytes.Equal(stored, value) {
+			return nil
+		}
 		db.logger.Warn("Overriding existing key", "key", key)
 	}
 
-	if err := db.fs.MkdirAll(
-		filepath.Dir(db.pathForKey(key)), db.dirPerms,
-	); err != nil {
+	if err := db.fs.MkdirAll(filepath.Dir(path), db.dirPerms); err != nil {
 		return err
 	}
-
-	file, err := db.fs.Create(db.pathForKey(key))
-	if err != nil {
-		return errors.Wrap(err, "failed to create file")
-	}
-	defer file.Close()
-
-	n, err := file.Write(value)
-	if err != nil {
-		return errors.Wrap(err, "failed to write to file")
+	if err := db.writeFile(path, encodeValue(value)); err != nil {
+		return err
 	}
-	db.logger.Debug("wrote %d bytes to %s", n, db.pathForKey(key))
+	db.logger.Debug("wrote %d bytes to %s", len(value), path)
 
 	return nil
 }
@@ -106,3 +134,111 @@ func (db *DB) Delete(key []byte) error {
 func (db *DB) pathForKey(key []byte) string {
 	return string(key) + "." + db.extension
 }
+
+// readFile reads the value stored at path, verifying its checksum.
+func (db *DB) readFile(path string) ([]byte, error) {
+	data, err := afero.ReadFile(db.fs, path)
+	if err != nil {
+		return nil, err
+	}
+	value, err := decodeValue(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s", path)
+	}
+	return value, nil
+}
+
+// writeFile writes data to a temporary file, syncs it to disk, and atomically
+// renames it to path. The parent directory is synced so the rename is durable.
+func (db *DB) writeFile(path string, data []byte) (err error) {
+	tmpPath := path + tmpExtension
+	file, err := db.fs.Create(tmpPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to create file")
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = db.fs.Remove(tmpPath)
+		}
+	}()
+
+	if _, err = file.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write to file")
+	}
+	if err = file.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync file")
+	}
+	if err = file.Close(); err != nil {
+		return errors.Wrap(err, "failed to close file")
+	}
+	if err = db.fs.Rename(tmpPath, path); err != nil {
+		return errors.Wrap(err, "failed to rename file")
+	}
+	return db.syncDir(filepath.Dir(path))
+}
+
+// syncDir syncs the directory at path to disk.
+func (db *DB) syncDir(path string) error {
+	dir, err := db.fs.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	if err = dir.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync directory")
+	}
+	return nil
+}
+
+// verifyValue returns an error if data does not match its checksum or, for
+// values stored without a checksum, is rejected by validate.
+func verifyValue(data []byte, validate func(value []byte) error) error {
+	value, err := decodeValue(data)
+	if err != nil {
+		return err
+	}
+	if validate != nil && !bytes.HasPrefix(data, valueMagic) {
+		return validate(value)
+	}
+	return nil
+}
+
+// quarantine moves the file at path to the quarantine directory, keeping its
+// path relative to the root directory.
+func (db *DB) quarantine(path string, reason error) error {
+	target := filepath.Join(quarantineDir, path)
+	if err := db.fs.MkdirAll(filepath.Dir(target), db.dirPerms); err != nil {
+		return err
+	}
+	if err := db.fs.Rename(path, target); err != nil {
+		return errors.Wrap(err, "failed to quarantine file")
+	}
+	db.logger.Warn("Quarantined corrupt file", "path", path, "target", target, "reason", reason)
+	return nil
+}
+
+// encodeValue prepends valueMagic and the checksum of value to value.
+func encodeValue(value []byte) []byte {
+	data := make([]byte, headerSize+len(value))
+	copy(data, valueMagic)
+	binary.LittleEndian.PutUint32(data[len(valueMagic):], crc32.Checksum(value, crcTable))
+	copy(data[headerSize:], value)
+	return data
+}
+
+// decodeValue returns the value stored in data, verifying its checksum. Data
+// not starting with valueMagic is returned as is.
+func decodeValue(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, valueMagic) {
+		return data, nil
+	}
+	if len(data) < headerSize {
+		return nil, ErrCorruptValue
+	}
+	value := data[headerSize:]
+	if binary.LittleEndian.Uint32(data[len(valueMagic):]) != crc32.Checksum(value, crcTable) {
+		return nil, ErrCorruptValue
+	}
+	return value, nil
+}
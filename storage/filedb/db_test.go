@@ -21,6 +21,8 @@
 package filedb_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -300,3 +302,47 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+// Test that values are verified against their checksum when read.
+func TestDB_Checksum(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	db := file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	)
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+
+	// Only the fully written value is left on disk.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "key.txt", entries[0].Name())
+
+	path := filepath.Join(dir, "key.txt")
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	corrupt := append([]byte{}, bz...)
+	corrupt[len(corrupt)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, corrupt, 0600))
+	_, err = db.Get([]byte("key"))
+	require.ErrorIs(t, err, file.ErrCorruptValue)
+
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)-2], 0600))
+	_, err = db.Get([]byte("key"))
+	require.ErrorIs(t, err, file.ErrCorruptValue)
+
+	// Setting the key again replaces the corrupt value.
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	// Values stored without a checksum are returned as is.
+	require.NoError(t, os.WriteFile(path, []byte("legacy"), 0600))
+	value, err = db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("legacy"), value)
+}
@@ -25,6 +25,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -153,6 +155,120 @@ func (db *RangeDB) Prune(start, end uint64) error {
 	return err
 }
 
+// IndexesAfter returns the populated indexes greater than index, in ascending
+// order.
+func (db *RangeDB) IndexesAfter(index uint64) ([]uint64, error) {
+	db.rwMu.RLock()
+	defer db.rwMu.RUnlock()
+	return db.indexesAfter(index)
+}
+
+// DeleteAfter removes all values associated with the indexes greater than
+// index from the filesystem.
+func (db *RangeDB) DeleteAfter(index uint64) error {
+	db.rwMu.Lock()
+	defer db.rwMu.Unlock()
+	indexes, err := db.indexesAfter(index)
+	if err != nil {
+		return err
+	}
+	for _, i := range indexes {
+		path := fmt.Sprintf(pathFormat, i)
+		if err = db.coreDB.fs.RemoveAll(path); err != nil {
+			return fmt.Errorf(
+				"RangeDB DeleteAfter failed RemoveAll index %d: %w",
+				i, err,
+			)
+		}
+	}
+	return nil
+}
+
+// indexesAfter lists the index directories greater than index.
+func (db *RangeDB) indexesAfter(index uint64) ([]uint64, error) {
+	indexes, err := db.indexes()
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(indexes, func(i uint64) bool { return i <= index }), nil
+}
+
+// indexes lists the index directories, in ascending order.
+func (db *RangeDB) indexes() ([]uint64, error) {
+	entries, err := afero.ReadDir(db.coreDB.fs, ".")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	indexes := make([]uint64, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		i, parseErr := strconv.ParseUint(entry.Name(), 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		indexes = append(indexes, i)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+// Quarantine moves the leftovers of interrupted writes, and the corrupt
+// entries of the recent highest indexes, to the quarantine directory of the
+// database, where they are no longer visible. Since values are written
+// atomically, only the values written last before a crash of a release without
+// atomic writes can be partial, so the values of older indexes are not read;
+// they are still verified against their checksum on Get. Entries stored
+// without a checksum are checked with validate, if not nil. It returns the
+// number of quarantined files.
+func (db *RangeDB) Quarantine(recent int, validate func(value []byte) error) (int, error) {
+	db.rwMu.Lock()
+	defer db.rwMu.Unlock()
+	indexes, err := db.indexes()
+	if err != nil {
+		return 0, err
+	}
+	firstRecent := max(len(indexes)-recent, 0)
+
+	var quarantined int
+	for i, index := range indexes {
+		indexDir := fmt.Sprintf(pathFormat, index)
+		entries, readErr := afero.ReadDir(db.coreDB.fs, indexDir)
+		if readErr != nil {
+			return quarantined, readErr
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			path := filepath.Join(indexDir, entry.Name())
+			var reason error
+			switch {
+			case strings.HasSuffix(path, tmpExtension):
+				reason = errors.New("partially written file")
+			case i >= firstRecent && strings.HasSuffix(path, db.coreDB.extension):
+				var data []byte
+				if data, err = afero.ReadFile(db.coreDB.fs, path); err != nil {
+					return quarantined, err
+				}
+				reason = verifyValue(data, validate)
+			}
+			if reason == nil {
+				continue
+			}
+			if err = db.coreDB.quarantine(path, reason); err != nil {
+				return quarantined, err
+			}
+			quarantined++
+		}
+	}
+	return quarantined, nil
+}
+
 // GetByIndex takes the database index and returns all associated entries,
 // expecting database keys to follow the prefix() format. If index does not
 // exist in the DB for any reason (pruned, invalid index), an empty list is
@@ -178,7 +294,7 @@ func (db *RangeDB) GetByIndex(index uint64) ([][]byte, error) {
 			continue
 		}
 		var sidecarBz []byte
-		sidecarBz, err = afero.ReadFile(db.coreDB.fs, filepath.Join(indexDir, filename))
+		sidecarBz, err = db.coreDB.readFile(filepath.Join(indexDir, filename))
 		if err != nil {
 			return keys, err
 		}
@@ -21,6 +21,8 @@
 package filedb_test
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -273,6 +275,122 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_DeleteAfter(t *testing.T) {
+	t.Parallel()
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+	require.NoError(t, populateTestDB(rdb, 0, 12))
+
+	indexes, err := rdb.IndexesAfter(9)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 11, 12}, indexes)
+
+	require.NoError(t, rdb.DeleteAfter(9))
+	requireExist(t, rdb, 0, 9)
+	requireNotExist(t, rdb, 10, 12)
+	indexes, err = rdb.IndexesAfter(0)
+	require.NoError(t, err)
+	require.Len(t, indexes, 9)
+}
+
+func TestRangeDB_Quarantine(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	rdb := file.NewRangeDB(file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	))
+	require.NoError(t, populateTestDB(rdb, 1, 3))
+
+	// Corrupt the value of index 1.
+	paths, err := filepath.Glob(filepath.Join(dir, "1", "*.txt"))
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	bz, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(paths[0], bz, 0600))
+
+	// Values of index 2 stored without a checksum are checked by validate.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2", "bad.txt"), []byte("bad"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2", "good.txt"), []byte("good"), 0600))
+	validate := func(value []byte) error {
+		if string(value) == "bad" {
+			return errors.New("invalid value")
+		}
+		return nil
+	}
+
+	// Leftover of an interrupted write at index 3.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "3", "partial.txt.tmp"), []byte("val"), 0600))
+
+	quarantined, err := rdb.Quarantine(3, validate)
+	require.NoError(t, err)
+	require.Equal(t, 3, quarantined)
+
+	exists, err := rdb.Has(1, []byte("key"))
+	require.NoError(t, err)
+	require.False(t, exists)
+	requireExist(t, rdb, 2, 3)
+	values, err := rdb.GetByIndex(2)
+	require.NoError(t, err)
+	require.ElementsMatch(t, [][]byte{[]byte("good"), []byte("value")}, values)
+
+	quarantinedPaths, err := filepath.Glob(filepath.Join(dir, "quarantine", "*", "*"))
+	require.NoError(t, err)
+	require.Len(t, quarantinedPaths, 3)
+
+	// The quarantine directory is not an index.
+	indexes, err := rdb.IndexesAfter(0)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, indexes)
+
+	quarantined, err = rdb.Quarantine(3, validate)
+	require.NoError(t, err)
+	require.Zero(t, quarantined)
+}
+
+func TestRangeDB_QuarantineRecent(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	rdb := file.NewRangeDB(file.NewDB(
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	))
+	require.NoError(t, populateTestDB(rdb, 1, 5))
+
+	// Corrupt the values of indexes 1 and 5.
+	for _, index := range []string{"1", "5"} {
+		paths, err := filepath.Glob(filepath.Join(dir, index, "*.txt"))
+		require.NoError(t, err)
+		require.Len(t, paths, 1)
+		bz, err := os.ReadFile(paths[0])
+		require.NoError(t, err)
+		bz[len(bz)-1] ^= 0xff
+		require.NoError(t, os.WriteFile(paths[0], bz, 0600))
+	}
+	// Leftover of an interrupted write at index 1.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1", "partial.txt.tmp"), []byte("val"), 0600))
+
+	// Only the values of the 2 highest indexes are read, while leftovers are
+	// quarantined from every index.
+	quarantined, err := rdb.Quarantine(2, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, quarantined)
+	exists, err := rdb.Has(5, []byte("key"))
+	require.NoError(t, err)
+	require.False(t, exists)
+	_, err = os.Stat(filepath.Join(dir, "1", "partial.txt.tmp"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// The corrupt value of an older index is still caught on Get.
+	_, err = rdb.Get(1, []byte("key"))
+	require.ErrorIs(t, err, file.ErrCorruptValue)
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+)
+
+// InterchangeFormatVersion is the EIP-3076 interchange format version.
+const InterchangeFormatVersion = "5"
+
+// Interchange is the EIP-3076 slashing protection interchange format.
+// See https://eips.ethereum.org/EIPS/eip-3076.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []*InterchangeData  `json:"data"`
+}
+
+// InterchangeMetadata identifies the format version and the chain the
+// interchange data belongs to.
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string      `json:"interchange_format_version"`
+	GenesisValidatorsRoot    common.Root `json:"genesis_validators_root"`
+}
+
+// InterchangeData is the signing history of a single key.
+type InterchangeData struct {
+	Pubkey       crypto.BLSPubkey `json:"pubkey"`
+	SignedBlocks []*SignedBlock   `json:"signed_blocks"`
+	// SignedAttestations are accepted for compatibility but ignored, as
+	// beacon-kit validators do not sign attestations.
+	SignedAttestations []json.RawMessage `json:"signed_attestations"`
+}
+
+// SignedBlock is a block signed by a key. Slot is a decimal string and
+// SigningRoot is omitted when unknown.
+type SignedBlock struct {
+	Slot        string       `json:"slot"`
+	SigningRoot *common.Root `json:"signing_root,omitempty"`
+}
@@ -0,0 +1,439 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/berachain/beacon-kit/errors"
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/constants"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// DBName is the name of the slashing protection database in the data
+// directory.
+const DBName = "slashing_protection"
+
+// sessionLength is the length of the identifier of a store session.
+const sessionLength = 16
+
+var (
+	// keyGenesisValidatorsRoot is the key of the genesis validators root of
+	// the chain the signing history belongs to.
+	keyGenesisValidatorsRoot = []byte("genesis_validators_root")
+	// prefixSignedBlock prefixes signed blocks, keyed by pubkey and
+	// big-endian slot so that a key's history iterates in slot order. Values
+	// are the signing root, empty if unknown, followed by the session and
+	// the round of the signature for blocks signed through the store.
+	prefixSignedBlock = []byte("signed_block/")
+
+	// ErrSlashableBlock is returned when signing a block could lead to a
+	// double proposal.
+	ErrSlashableBlock = errors.New("refusing to sign slashable block")
+	// ErrGenesisValidatorsRootMismatch is returned when the signing history
+	// belongs to a different chain.
+	ErrGenesisValidatorsRootMismatch = errors.New(
+		"genesis validators root does not match slashing protection history",
+	)
+	// ErrUnsupportedInterchange is returned when importing an interchange
+	// file of an unsupported version.
+	ErrUnsupportedInterchange = errors.New("unsupported interchange format version")
+)
+
+// Store is a slashing protection database recording the blocks signed by
+// validator keys.
+type Store struct {
+	mu sync.Mutex
+	db dbm.DB
+	// session identifies the blocks signed since the store was created.
+	session [sessionLength]byte
+}
+
+// NewStore creates a new slashing protection store backed by db, opening a
+// new session.
+func NewStore(db dbm.DB) *Store {
+	s := &Store{db: db}
+	_, _ = rand.Read(s.session[:])
+	return s
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// CheckAndRecordBlock records that pubkey is about to sign the block with the
+// given signing root at slot. The record is persisted before returning.
+//
+// Signing the same block again is allowed. ErrSlashableBlock is returned for
+// a block at a slot below the highest slot signed by pubkey, and for a
+// different block at a slot already signed, unless it is a re-proposal.
+//
+// CometBFT proposes a height again in later rounds until it is committed,
+// and height equals slot. As ABCI does not expose the round of a proposal,
+// the store counts the rounds in which it signs a slot: a different block may
+// be signed at a slot last signed in an earlier round of the same session,
+// which is then recorded with the next round. Blocks signed by another
+// session, i.e. before a restart, by another instance or from a restored or
+// imported history, and sealed blocks are never replaced.
+func (s *Store) CheckAndRecordBlock(
+	pubkey crypto.BLSPubkey,
+	slot math.Slot,
+	signingRoot common.Root,
+	genesisValidatorsRoot common.Root,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if err := s.checkGenesisValidatorsRoot(batch, genesisValidatorsRoot); err != nil {
+		return err
+	}
+
+	key := signedBlockKey(pubkey, slot)
+	bz, err := s.db.Get(key)
+	if err != nil {
+		return err
+	}
+	prev := decodeSignedBlock(bz)
+	if bz != nil && bytes.Equal(prev.root, signingRoot[:]) {
+		return nil
+	}
+
+	highest, found, err := s.highestSignedSlot(pubkey)
+	if err != nil {
+		return err
+	}
+	if found && slot < highest {
+		return errors.Wrapf(
+			ErrSlashableBlock, "slot %d precedes the last slot %d signed by pubkey %s",
+			slot, highest, pubkey,
+		)
+	}
+
+	var round uint32
+	if bz != nil {
+		if prev.session != s.session {
+			return errors.Wrapf(
+				ErrSlashableBlock, "pubkey %s already signed a different block at slot %d",
+				pubkey, slot,
+			)
+		}
+		round = prev.round + 1
+	}
+
+	record := signedBlock{root: signingRoot[:], session: s.session, round: round}
+	if err = batch.Set(key, record.encode()); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// SealBlock makes the record of the block with the given signing root signed
+// by pubkey at slot final, so that no different block is signed at slot even
+// in a later round. It is used once a signed block has left the node other
+// than through a CometBFT proposal, such as a blinded block submitted to the
+// relay of an external builder. Records of other blocks are left untouched.
+func (s *Store) SealBlock(
+	pubkey crypto.BLSPubkey,
+	slot math.Slot,
+	signingRoot common.Root,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := signedBlockKey(pubkey, slot)
+	bz, err := s.db.Get(key)
+	if err != nil {
+		return err
+	}
+	prev := decodeSignedBlock(bz)
+	if bz == nil || !bytes.Equal(prev.root, signingRoot[:]) {
+		return errors.Wrapf(
+			ErrSlashableBlock, "pubkey %s did not sign the block to seal at slot %d",
+			pubkey, slot,
+		)
+	}
+	// Blocks recorded without a session are never replaced.
+	return s.db.SetSync(key, (&signedBlock{root: prev.root}).encode())
+}
+
+// CountAbove returns the number of records of blocks signed after slot by
+// any key.
+func (s *Store) CountAbove(slot math.Slot) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it, err := dbm.IteratePrefix(s.db, prefixSignedBlock)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	count := 0
+	for ; it.Valid(); it.Next() {
+		if _, signed := parseSignedBlockKey(it.Key()); signed > slot {
+			count++
+		}
+	}
+	return count, it.Error()
+}
+
+// PruneAbove removes the records of all blocks signed after slot by any key,
+// so that they can be signed again once the chain is rolled back to slot.
+func (s *Store) PruneAbove(slot math.Slot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it, err := dbm.IteratePrefix(s.db, prefixSignedBlock)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for ; it.Valid(); it.Next() {
+		if _, signed := parseSignedBlockKey(it.Key()); signed > slot {
+			if err = batch.Delete(it.Key()); err != nil {
+				return err
+			}
+		}
+	}
+	if err = it.Error(); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// Import merges the signing history of an EIP-3076 interchange into the
+// store. Blocks conflicting with the recorded history are kept with an
+// unknown signing root, and no block is signed at the slot of a block with
+// an unknown signing root. An interchange without a genesis validators root
+// is merged without checking or recording the chain it belongs to.
+func (s *Store) Import(interchange *Interchange) error {
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return errors.Wrapf(
+			ErrUnsupportedInterchange, "got %q, expected %q",
+			interchange.Metadata.InterchangeFormatVersion, InterchangeFormatVersion,
+		)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if gvr := interchange.Metadata.GenesisValidatorsRoot; gvr != (common.Root{}) {
+		if err := s.checkGenesisValidatorsRoot(batch, gvr); err != nil {
+			return err
+		}
+	}
+
+	// Track the values written by the batch, as they are not visible to the
+	// database until it is written.
+	pending := make(map[string][]byte)
+	for _, data := range interchange.Data {
+		for _, blk := range data.SignedBlocks {
+			slot, err := strconv.ParseUint(blk.Slot, 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid slot %q for pubkey %s", blk.Slot, data.Pubkey)
+			}
+			// An empty root marks a slot whose signed block is unknown.
+			root := []byte{}
+			if blk.SigningRoot != nil {
+				root = blk.SigningRoot[:]
+			}
+
+			key := signedBlockKey(data.Pubkey, math.Slot(slot))
+			prev, recorded := pending[string(key)]
+			if !recorded {
+				var bz []byte
+				if bz, err = s.db.Get(key); err != nil {
+					return err
+				}
+				prev, recorded = decodeSignedBlock(bz).root, bz != nil
+			}
+			switch {
+			case recorded && bytes.Equal(prev, root):
+				continue
+			case recorded:
+				// Conflicting roots are recorded as unknown.
+				root = []byte{}
+			}
+			// Imported blocks belong to no session, so they are never
+			// replaced.
+			if err = batch.Set(key, (&signedBlock{root: root}).encode()); err != nil {
+				return err
+			}
+			pending[string(key)] = root
+		}
+	}
+	return batch.WriteSync()
+}
+
+// Export returns the signing history of all keys as an EIP-3076
+// interchange.
+func (s *Store) Export() (*Interchange, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	gvr, err := s.db.Get(keyGenesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+		},
+		Data: make([]*InterchangeData, 0),
+	}
+	if gvr != nil {
+		interchange.Metadata.GenesisValidatorsRoot = common.Root(gvr)
+	}
+
+	it, err := dbm.IteratePrefix(s.db, prefixSignedBlock)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var data *InterchangeData
+	for ; it.Valid(); it.Next() {
+		pubkey, slot := parseSignedBlockKey(it.Key())
+		if data == nil || data.Pubkey != pubkey {
+			data = &InterchangeData{
+				Pubkey:             pubkey,
+				SignedBlocks:       make([]*SignedBlock, 0),
+				SignedAttestations: make([]json.RawMessage, 0),
+			}
+			interchange.Data = append(interchange.Data, data)
+		}
+		blk := &SignedBlock{Slot: slot.Base10()}
+		if root := decodeSignedBlock(it.Value()).root; len(root) == len(common.Root{}) {
+			signingRoot := common.Root(root)
+			blk.SigningRoot = &signingRoot
+		}
+		data.SignedBlocks = append(data.SignedBlocks, blk)
+	}
+	return interchange, it.Error()
+}
+
+// checkGenesisValidatorsRoot ensures the store's history belongs to the chain
+// with the given genesis validators root, recording it in batch on first use.
+func (s *Store) checkGenesisValidatorsRoot(batch dbm.Batch, gvr common.Root) error {
+	stored, err := s.db.Get(keyGenesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	if stored == nil {
+		return batch.Set(keyGenesisValidatorsRoot, gvr[:])
+	}
+	if !bytes.Equal(stored, gvr[:]) {
+		return errors.Wrapf(
+			ErrGenesisValidatorsRootMismatch, "expected %s, got %s", common.Root(stored), gvr,
+		)
+	}
+	return nil
+}
+
+// highestSignedSlot returns the highest slot signed by pubkey.
+func (s *Store) highestSignedSlot(pubkey crypto.BLSPubkey) (math.Slot, bool, error) {
+	prefix := signedBlockPrefix(pubkey)
+	it, err := s.db.ReverseIterator(prefix, prefixEnd(prefix))
+	if err != nil {
+		return 0, false, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return 0, false, it.Error()
+	}
+	_, slot := parseSignedBlockKey(it.Key())
+	return slot, true, nil
+}
+
+// signedBlock is the record of a signed block.
+type signedBlock struct {
+	// root is the signing root of the block, empty if unknown.
+	root []byte
+	// session and round are the session and the round in which the block
+	// was signed, zero for imported and sealed blocks.
+	session [sessionLength]byte
+	round   uint32
+}
+
+func (b *signedBlock) encode() []byte {
+	if b.session == ([sessionLength]byte{}) {
+		return bytes.Clone(b.root)
+	}
+	bz := make([]byte, 0, len(b.root)+sessionLength+4)
+	bz = append(bz, b.root...)
+	bz = append(bz, b.session[:]...)
+	return binary.BigEndian.AppendUint32(bz, b.round)
+}
+
+func decodeSignedBlock(bz []byte) *signedBlock {
+	rootLen := len(common.Root{})
+	if len(bz) != rootLen+sessionLength+4 {
+		return &signedBlock{root: bz}
+	}
+	b := &signedBlock{root: bz[:rootLen]}
+	copy(b.session[:], bz[rootLen:rootLen+sessionLength])
+	b.round = binary.BigEndian.Uint32(bz[rootLen+sessionLength:])
+	return b
+}
+
+// prefixEnd returns the smallest key greater than all keys with prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func signedBlockPrefix(pubkey crypto.BLSPubkey) []byte {
+	prefix := make([]byte, 0, len(prefixSignedBlock)+constants.BLSPubkeyLength)
+	prefix = append(prefix, prefixSignedBlock...)
+	return append(prefix, pubkey[:]...)
+}
+
+func signedBlockKey(pubkey crypto.BLSPubkey, slot math.Slot) []byte {
+	return binary.BigEndian.AppendUint64(signedBlockPrefix(pubkey), slot.Unwrap())
+}
+
+func parseSignedBlockKey(key []byte) (crypto.BLSPubkey, math.Slot) {
+	key = key[len(prefixSignedBlock):]
+	return crypto.BLSPubkey(key[:constants.BLSPubkeyLength]),
+		math.Slot(binary.BigEndian.Uint64(key[constants.BLSPubkeyLength:]))
+}
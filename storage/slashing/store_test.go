@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package slashing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/crypto"
+	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/storage/slashing"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAndRecordBlock(t *testing.T) {
+	t.Parallel()
+	db := dbm.NewMemDB()
+	store := slashing.NewStore(db)
+	pubkey := crypto.BLSPubkey{0x01}
+	gvr := common.Root{0x0a}
+
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr))
+
+	// Signing the same block again is allowed.
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr))
+
+	// A different block may be proposed in a later round of the same session.
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 10, common.Root{0x02}, gvr))
+
+	// Another session, e.g. a restarted or second instance, refuses a
+	// different block at a slot already signed.
+	other := slashing.NewStore(db)
+	require.NoError(t, other.CheckAndRecordBlock(pubkey, 10, common.Root{0x02}, gvr))
+	err := other.CheckAndRecordBlock(pubkey, 10, common.Root{0x03}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+	err = other.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+
+	// Blocks before the last signed slot are refused.
+	err = store.CheckAndRecordBlock(pubkey, 9, common.Root{0x03}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+
+	// Once a later block is signed, the slot is final.
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 11, common.Root{0x04}, gvr))
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 10, common.Root{0x02}, gvr))
+	err = store.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+
+	// Other keys are unaffected.
+	require.NoError(t, store.CheckAndRecordBlock(crypto.BLSPubkey{0x02}, 9, common.Root{0x05}, gvr))
+
+	// History of another chain is refused.
+	err = store.CheckAndRecordBlock(pubkey, 12, common.Root{0x06}, common.Root{0x0b})
+	require.ErrorIs(t, err, slashing.ErrGenesisValidatorsRootMismatch)
+}
+
+func TestPruneAbove(t *testing.T) {
+	t.Parallel()
+	store := slashing.NewStore(dbm.NewMemDB())
+	pubkeys := []crypto.BLSPubkey{{0x01}, {0x02}}
+	gvr := common.Root{0x0a}
+
+	for _, pubkey := range pubkeys {
+		for slot := math.Slot(1); slot <= 5; slot++ {
+			require.NoError(t, store.CheckAndRecordBlock(pubkey, slot, common.Root{byte(slot)}, gvr))
+		}
+	}
+	count, err := store.CountAbove(2)
+	require.NoError(t, err)
+	require.Equal(t, 6, count)
+	require.NoError(t, store.PruneAbove(2))
+	count, err = store.CountAbove(2)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// Blocks after slot 2 can be signed again by every key, while earlier
+	// blocks are still protected.
+	for _, pubkey := range pubkeys {
+		require.NoError(t, store.CheckAndRecordBlock(pubkey, 3, common.Root{0xff}, gvr))
+		err := store.CheckAndRecordBlock(pubkey, 2, common.Root{0xff}, gvr)
+		require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+	}
+}
+
+func TestSealBlock(t *testing.T) {
+	t.Parallel()
+	store := slashing.NewStore(dbm.NewMemDB())
+	pubkey := crypto.BLSPubkey{0x01}
+	gvr := common.Root{0x0a}
+
+	// Only signed blocks can be sealed.
+	err := store.SealBlock(pubkey, 10, common.Root{0x01})
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr))
+	err = store.SealBlock(pubkey, 10, common.Root{0x02})
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+	require.NoError(t, store.SealBlock(pubkey, 10, common.Root{0x01}))
+
+	// A sealed block is not replaced in a later round.
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr))
+	err = store.CheckAndRecordBlock(pubkey, 10, common.Root{0x02}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+}
+
+func TestImport_WithoutGenesisValidatorsRoot(t *testing.T) {
+	t.Parallel()
+	store := slashing.NewStore(dbm.NewMemDB())
+	pubkey := crypto.BLSPubkey{0x01}
+	gvr := common.Root{0x0a}
+	signingRoot := common.Root{0x01}
+
+	require.NoError(t, store.Import(&slashing.Interchange{
+		Metadata: slashing.InterchangeMetadata{
+			InterchangeFormatVersion: slashing.InterchangeFormatVersion,
+		},
+		Data: []*slashing.InterchangeData{{
+			Pubkey:       pubkey,
+			SignedBlocks: []*slashing.SignedBlock{{Slot: "10", SigningRoot: &signingRoot}},
+		}},
+	}))
+
+	// The imported history is kept without binding the store to a chain.
+	exported, err := store.Export()
+	require.NoError(t, err)
+	require.Equal(t, common.Root{}, exported.Metadata.GenesisValidatorsRoot)
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 11, common.Root{0x02}, gvr))
+	err = store.CheckAndRecordBlock(pubkey, 10, common.Root{0x03}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+}
+
+func TestImport_UnknownSigningRoot(t *testing.T) {
+	t.Parallel()
+	store := slashing.NewStore(dbm.NewMemDB())
+	pubkey := crypto.BLSPubkey{0x01}
+	gvr := common.Root{0x0a}
+
+	require.NoError(t, store.Import(&slashing.Interchange{
+		Metadata: slashing.InterchangeMetadata{
+			InterchangeFormatVersion: slashing.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    gvr,
+		},
+		Data: []*slashing.InterchangeData{{
+			Pubkey:       pubkey,
+			SignedBlocks: []*slashing.SignedBlock{{Slot: "10"}},
+		}},
+	}))
+
+	// No block is signed at or below the highest imported slot when its
+	// signing root is unknown.
+	err := store.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+	err = store.CheckAndRecordBlock(pubkey, 9, common.Root{0x01}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+	require.NoError(t, store.CheckAndRecordBlock(pubkey, 11, common.Root{0x01}, gvr))
+}
+
+func TestInterchange(t *testing.T) {
+	t.Parallel()
+	pubkey := crypto.BLSPubkey{0x01}
+	gvr := common.Root{0x0a}
+
+	src := slashing.NewStore(dbm.NewMemDB())
+	require.NoError(t, src.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr))
+	require.NoError(t, src.CheckAndRecordBlock(pubkey, 12, common.Root{0x02}, gvr))
+
+	exported, err := src.Export()
+	require.NoError(t, err)
+	bz, err := json.Marshal(exported)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"metadata": {
+			"interchange_format_version": "5",
+			"genesis_validators_root": "`+gvr.String()+`"
+		},
+		"data": [{
+			"pubkey": "`+pubkey.String()+`",
+			"signed_blocks": [
+				{"slot": "10", "signing_root": "`+common.Root{0x01}.String()+`"},
+				{"slot": "12", "signing_root": "`+common.Root{0x02}.String()+`"}
+			],
+			"signed_attestations": []
+		}]
+	}`, string(bz))
+
+	// Import into a store holding a conflicting block at slot 12.
+	dst := slashing.NewStore(dbm.NewMemDB())
+	require.NoError(t, dst.CheckAndRecordBlock(pubkey, 12, common.Root{0x03}, gvr))
+	interchange := &slashing.Interchange{}
+	require.NoError(t, json.Unmarshal(bz, interchange))
+	require.NoError(t, dst.Import(interchange))
+
+	// Imported blocks are protected, and conflicting slots are kept with an
+	// unknown root once final.
+	require.NoError(t, dst.CheckAndRecordBlock(pubkey, 13, common.Root{0x05}, gvr))
+	require.NoError(t, dst.CheckAndRecordBlock(pubkey, 10, common.Root{0x01}, gvr))
+	err = dst.CheckAndRecordBlock(pubkey, 10, common.Root{0x04}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+	err = dst.CheckAndRecordBlock(pubkey, 12, common.Root{0x02}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+	err = dst.CheckAndRecordBlock(pubkey, 12, common.Root{0x03}, gvr)
+	require.ErrorIs(t, err, slashing.ErrSlashableBlock)
+
+	exported, err = dst.Export()
+	require.NoError(t, err)
+	require.Len(t, exported.Data, 1)
+	require.Len(t, exported.Data[0].SignedBlocks, 3)
+	require.Nil(t, exported.Data[0].SignedBlocks[1].SigningRoot)
+
+	// Interchanges of other chains or versions are refused.
+	interchange.Metadata.GenesisValidatorsRoot = common.Root{0x0b}
+	require.ErrorIs(t, dst.Import(interchange), slashing.ErrGenesisValidatorsRootMismatch)
+	interchange.Metadata.InterchangeFormatVersion = "4"
+	require.ErrorIs(t, dst.Import(interchange), slashing.ErrUnsupportedInterchange)
+}
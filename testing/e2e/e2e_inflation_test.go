@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/primitives/common"
 	"github.com/berachain/beacon-kit/primitives/math"
+	"github.com/berachain/beacon-kit/primitives/version"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -59,11 +60,11 @@ func (s *BeaconKitE2ESuite) TestEVMInflation() {
 		payloadTime := payload.Time()
 		inflationPerBlock = chainspec.EVMInflationPerBlock(math.U64(payloadTime)).Unwrap()
 		inflationAddress = chainspec.EVMInflationAddress(math.U64(payloadTime))
-		if chainspec.Deneb1ForkTime() > 0 && payloadTime >= chainspec.Deneb1ForkTime() {
+		if chainspec.ForkTime(version.Deneb1()) > 0 && payloadTime >= chainspec.ForkTime(version.Deneb1()) {
 			// If we have passed the Deneb1 fork, do some verifications and update inflation values.
 			onceOnFork.Do(func() {
-				oldInflationPerBlock := chainspec.EVMInflationPerBlock(math.U64(chainspec.Deneb1ForkTime() - 1))
-				oldInflationAddress = chainspec.EVMInflationAddress(math.U64(chainspec.Deneb1ForkTime() - 1))
+				oldInflationPerBlock := chainspec.EVMInflationPerBlock(math.U64(chainspec.ForkTime(version.Deneb1()) - 1))
+				oldInflationAddress = chainspec.EVMInflationAddress(math.U64(chainspec.ForkTime(version.Deneb1()) - 1))
 
 				// Verify the post fork inflation changes
 				s.Require().NotEqual(oldInflationPerBlock, inflationPerBlock)
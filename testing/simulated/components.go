@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config/spec"
 	"github.com/berachain/beacon-kit/node-core/components"
+	"github.com/berachain/beacon-kit/primitives/version"
 )
 
 func FixedComponents(t *testing.T) []any {
@@ -45,18 +46,24 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideConfig,
 		components.ProvideServerConfig,
 		components.ProvideDepositStore,
+		components.ProvideEventBroker,
+		components.ProvideEventPublisher,
 		components.ProvideEngineClient,
 		components.ProvideExecutionEngine,
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder,
+		components.ProvideRelays,
+		components.ProvideProposerRegistry,
 		components.ProvideReportingService,
 		components.ProvideServiceRegistry,
 		components.ProvideSidecarFactory,
+		components.ProvideSlashingProtectionStore,
 		components.ProvideStateProcessor,
 		components.ProvideKVStore,
 		components.ProvideStorageBackend,
 		components.ProvideTelemetrySink,
 		components.ProvideTelemetryService,
+		components.ProvideTracingService,
 		components.ProvideTrustedSetup,
 		components.ProvideValidatorService,
 		components.ProvideShutDownService,
@@ -74,6 +81,7 @@ func FixedComponents(t *testing.T) []any {
 		components.ProvideNodeAPIEventsHandler,
 		components.ProvideNodeAPINodeHandler,
 		components.ProvideNodeAPIProofHandler,
+		components.ProvideNodeAPIValidatorHandler,
 	)
 	return c
 }
@@ -83,9 +91,9 @@ func ProvideElectraGenesisChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
 	// Both Deneb1 and Electra happen in genesis.
 	specData.GenesisTime = 0
-	specData.Deneb1ForkTime = 0
-	specData.ElectraForkTime = 0
-	specData.Electra1ForkTime = 9223372036854775807
+	specData.Fork(version.Deneb1()).Time = 0
+	specData.Fork(version.Electra()).Time = 0
+	specData.Fork(version.Electra1()).Time = 9223372036854775807
 	// We set slots per epoch to 2 for faster observation of withdrawal behaviour
 	specData.SlotsPerEpoch = 2
 	// We set this to 4 so tests are faster
@@ -104,10 +112,10 @@ func ProvideSimulationChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
 	specData.GenesisTime = 0
 	// Arbitrary number
-	specData.Deneb1ForkTime = 30
+	specData.Fork(version.Deneb1()).Time = 30
 	// High number as we don't want to activate electra.
-	specData.ElectraForkTime = 9999999999999999
-	specData.Electra1ForkTime = 9999999999999999
+	specData.Fork(version.Electra()).Time = 9999999999999999
+	specData.Fork(version.Electra1()).Time = 9999999999999999
 	chainSpec, err := chain.NewSpec(specData)
 	if err != nil {
 		return nil, err
@@ -119,9 +127,9 @@ func ProvideSimulationChainSpec() (chain.Spec, error) {
 func ProvidePectraForkTestChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
 	specData.GenesisTime = 0
-	specData.Deneb1ForkTime = 0
-	specData.ElectraForkTime = 10
-	specData.Electra1ForkTime = 9223372036854775807
+	specData.Fork(version.Deneb1()).Time = 0
+	specData.Fork(version.Electra()).Time = 10
+	specData.Fork(version.Electra1()).Time = 9223372036854775807
 	chainSpec, err := chain.NewSpec(specData)
 	if err != nil {
 		return nil, err
@@ -133,9 +141,9 @@ func ProvidePectraForkTestChainSpec() (chain.Spec, error) {
 func ProvidePectraWithdrawalTestChainSpec() (chain.Spec, error) {
 	specData := spec.TestnetChainSpecData()
 	specData.GenesisTime = 0
-	specData.Deneb1ForkTime = 0
-	specData.ElectraForkTime = 10
-	specData.Electra1ForkTime = 9223372036854775807
+	specData.Fork(version.Deneb1()).Time = 0
+	specData.Fork(version.Electra()).Time = 10
+	specData.Fork(version.Electra1()).Time = 9223372036854775807
 	// We set slots per epoch to 1 for faster observation of withdrawal behaviour
 	specData.SlotsPerEpoch = 1
 	// We set this to 4 so tests are faster
@@ -29,10 +29,12 @@ import (
 	"github.com/berachain/beacon-kit/beacon/validator"
 	"github.com/berachain/beacon-kit/chain"
 	"github.com/berachain/beacon-kit/config"
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
 	cometbft "github.com/berachain/beacon-kit/consensus/cometbft/service"
 	"github.com/berachain/beacon-kit/log/phuslu"
 	"github.com/berachain/beacon-kit/node-core/builder"
 	"github.com/berachain/beacon-kit/node-core/components/metrics"
+	"github.com/berachain/beacon-kit/primitives/crypto"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -87,3 +89,28 @@ func (s *SimComet) CreateQueryContext(height int64, prove bool) (sdk.Context, er
 func (s *SimComet) LastBlockHeight() int64 {
 	panic("unimplemented")
 }
+
+func (s *SimComet) IsSyncing() bool {
+	// The simulated node is never started, so it is never catching up.
+	return false
+}
+
+func (s *SimComet) SyncingToHeight() int64 {
+	return s.Comet.SyncingToHeight()
+}
+
+func (s *SimComet) UpcomingProposers(count int) (int64, []crypto.BLSPubkey, error) {
+	return s.Comet.UpcomingProposers(count)
+}
+
+func (s *SimComet) LightClientCommit(height int64) (*ctypes.LightClientCommit, error) {
+	return s.Comet.LightClientCommit(height)
+}
+
+func (s *SimComet) LightClientValidators(height int64) ([]*ctypes.LightClientValidator, error) {
+	return s.Comet.LightClientValidators(height)
+}
+
+func (s *SimComet) LightClientCometBFTHeader(height int64) (*ctypes.LightClientCometBFTHeader, error) {
+	return s.Comet.LightClientCometBFTHeader(height)
+}
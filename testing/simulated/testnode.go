@@ -191,12 +191,14 @@ func getAppOptions(t *testing.T, appOpts *viper.Viper, beaconKitConfig *config.C
 	appOpts.Set(flags.RPCStartupCheckInterval, beaconKitConfig.GetEngine().RPCStartupCheckInterval.String())
 	appOpts.Set(flags.RPCDialURL, beaconKitConfig.GetEngine().RPCDialURL.String())
 	appOpts.Set(flags.RPCTimeout, beaconKitConfig.GetEngine().RPCTimeout.String())
+	appOpts.Set(flags.DepositFetchMaxSpan, beaconKitConfig.GetEngine().DepositFetchMaxSpan)
 
 	appOpts.Set(flags.LogLevel, "debug")
 
 	// BLS Config
 	appOpts.Set(flags.PrivValidatorKeyFile, "./config/priv_validator_key.json")
 	appOpts.Set(flags.PrivValidatorStateFile, "./data/priv_validator_state.json")
+	appOpts.Set(flags.SignerBackend, beaconKitConfig.Signer.Backend)
 
 	// Beacon Config
 	appOpts.Set(flags.BlockStoreServiceAvailabilityWindow, beaconKitConfig.GetBlockStoreService().AvailabilityWindow)